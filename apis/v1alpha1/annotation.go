@@ -42,4 +42,17 @@ var (
 	// will be deleted. The default value is "false" - meaning that when the annotation is not present, automated
 	// backups will not be deleted.
 	DeleteAutomatedBackupsAnnotation = fmt.Sprintf("%s/delete-automated-backups", GroupVersion.Group)
+	// RebootOnPendingParametersAnnotation is the annotation key used to opt a DBInstance or DBCluster
+	// into being rebooted by the rds-controller when changes to its parameter group are pending a
+	// reboot. If this annotation is set to "true", the controller reboots the DB instance, or the
+	// pending members of the DB cluster, once there are no other modifications to make. The default
+	// value is "false" - meaning that pending parameter changes are only reported in the resource's
+	// conditions.
+	RebootOnPendingParametersAnnotation = fmt.Sprintf("%s/reboot-on-pending-parameters", GroupVersion.Group)
+	// IgnoredTagKeysAnnotation is the annotation key used to set, as a comma-separated list, patterns
+	// of the keys of the tags that the rds-controller never adds, changes or removes on the resource,
+	// like the tags added by AWS Backup or cost allocation tooling. A '*' in a pattern matches any
+	// sequence of characters, e.g. "awsbackup:*". These patterns are ignored in addition to the ones
+	// of the --ignored-tag-keys flag of the controller.
+	IgnoredTagKeysAnnotation = fmt.Sprintf("%s/ignored-tag-keys", GroupVersion.Group)
 )
@@ -48,12 +48,28 @@ type DBClusterEndpointSpec struct {
 	DBClusterIdentifierRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"dbClusterIdentifierRef,omitempty"`
 	// The type of the endpoint, one of: READER, WRITER, ANY.
 	EndpointType *string `json:"endpointType,omitempty"`
+	// Labels of the DBInstance resources whose DB instances aren't part of the
+	// custom endpoint group. The DB instances of the DBInstance resources in the
+	// namespace of the endpoint that have all of these labels and that belong to
+	// its DB cluster are added to ExcludedMembers, whatever their status.
+	ExcludedMemberSelector map[string]*string `json:"excludedMemberSelector,omitempty"`
 	// List of DB instance identifiers that aren't part of the custom endpoint group.
 	// All other eligible instances are reachable through the custom endpoint. This
 	// parameter is relevant only if the list of static members is empty.
-	ExcludedMembers []*string `json:"excludedMembers,omitempty"`
+	ExcludedMembers    []*string                                  `json:"excludedMembers,omitempty"`
+	ExcludedMemberRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"excludedMemberRefs,omitempty"`
+	// Labels of the DBInstance resources whose DB instances are part of the custom
+	// endpoint group. The DB instances of the DBInstance resources in the namespace
+	// of the endpoint that have all of these labels, that belong to its DB cluster
+	// and that are available are added to StaticMembers. The controller checks the
+	// DBInstance resources again every minute, so that DB instances are added to
+	// the endpoint once they are available and removed when they are deleted.
+	//
+	// The endpoint isn't created or modified while no DB instance matches.
+	StaticMemberSelector map[string]*string `json:"staticMemberSelector,omitempty"`
 	// List of DB instance identifiers that are part of the custom endpoint group.
-	StaticMembers []*string `json:"staticMembers,omitempty"`
+	StaticMembers    []*string                                  `json:"staticMembers,omitempty"`
+	StaticMemberRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"staticMemberRefs,omitempty"`
 	// The tags to be assigned to the Amazon RDS resource.
 	Tags []*Tag `json:"tags,omitempty"`
 }
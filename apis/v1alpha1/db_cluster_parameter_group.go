@@ -117,6 +117,15 @@ type DBClusterParameterGroupStatus struct {
 	// Provides a list of parameters for the DB cluster parameter group.
 	// +kubebuilder:validation:Optional
 	ParameterOverrideStatuses []*Parameter `json:"parameterOverrideStatuses,omitempty"`
+	// The names of the parameter overrides that RDS applies with the pending-reboot
+	// apply method.
+	//
+	// Changes to these static parameters only take effect on the DB instances and
+	// DB clusters that use this parameter group after they are rebooted. Whether a
+	// DB instance or DB cluster is waiting for that reboot is reported by its
+	// PendingReboot condition.
+	// +kubebuilder:validation:Optional
+	StaticParameterOverrides []*string `json:"staticParameterOverrides,omitempty"`
 }
 
 // DBClusterParameterGroup is the Schema for the DBClusterParameterGroups API
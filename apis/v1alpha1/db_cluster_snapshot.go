@@ -28,6 +28,9 @@ import (
 // action.
 type DBClusterSnapshotSpec struct {
 
+	// Specifies whether to copy all tags from the source DB cluster snapshot to
+	// the target DB cluster snapshot. By default, tags are not copied.
+	CopyTags *bool `json:"copyTags,omitempty"`
 	// The identifier of the DB cluster to create a snapshot for. This parameter
 	// isn't case-sensitive.
 	//
@@ -52,6 +55,68 @@ type DBClusterSnapshotSpec struct {
 	// Example: my-cluster1-snapshot1
 	// +kubebuilder:validation:Required
 	DBClusterSnapshotIdentifier *string `json:"dbClusterSnapshotIdentifier"`
+	// The Amazon Web Services KMS key identifier for an encrypted DB cluster snapshot.
+	// The Amazon Web Services KMS key identifier is the key ARN, key ID, alias
+	// ARN, or alias name for the Amazon Web Services KMS key.
+	//
+	// If you copy an encrypted DB cluster snapshot from your Amazon Web Services
+	// account, you can specify a value for KmsKeyId to encrypt the copy with a
+	// new KMS key. If you don't specify a value for KmsKeyId, then the copy of
+	// the DB cluster snapshot is encrypted with the same KMS key as the source
+	// DB cluster snapshot.
+	//
+	// If you copy an encrypted DB cluster snapshot that is shared from another
+	// Amazon Web Services account, then you must specify a value for KmsKeyId.
+	//
+	// To copy an encrypted DB cluster snapshot to another Amazon Web Services Region,
+	// you must set KmsKeyId to the Amazon Web Services KMS key identifier you want
+	// to use to encrypt the copy of the DB cluster snapshot in the destination
+	// Amazon Web Services Region. KMS keys are specific to the Amazon Web Services
+	// Region that they are created in, and you can't use KMS keys from one Amazon
+	// Web Services Region in another Amazon Web Services Region.
+	//
+	// If you copy an unencrypted DB cluster snapshot and specify a value for the
+	// KmsKeyId parameter, an error is returned.
+	KMSKeyID  *string                                  `json:"kmsKeyID,omitempty"`
+	KMSKeyRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"kmsKeyRef,omitempty"`
+	// When you are copying a DB cluster snapshot from one Amazon Web Services GovCloud
+	// (US) Region to another, the URL that contains a Signature Version 4 signed
+	// request for the CopyDBClusterSnapshot API operation in the Amazon Web Services
+	// Region that contains the source DB cluster snapshot to copy. Use the PreSignedUrl
+	// parameter when copying an encrypted DB cluster snapshot from another Amazon
+	// Web Services Region. Don't specify PreSignedUrl when copying an encrypted
+	// DB cluster snapshot in the same Amazon Web Services Region.
+	//
+	// This setting applies only to Amazon Web Services GovCloud (US) Regions.
+	// It's ignored in other Amazon Web Services Regions.
+	//
+	// If you are using an Amazon Web Services SDK tool or the CLI, you can specify
+	// SourceRegion (or --source-region for the CLI) instead of specifying PreSignedUrl
+	// manually. Specifying SourceRegion autogenerates a presigned URL that is a
+	// valid request for the operation that can run in the source Amazon Web Services
+	// Region.
+	PreSignedURL *string `json:"preSignedURL,omitempty"`
+	// The Amazon Web Services account IDs that are allowed to copy or restore the
+	// manual DB cluster snapshot, or all to make the DB cluster snapshot public.
+	// The controller shares the DB cluster snapshot with the accounts in the list
+	// and stops sharing it with any other account, using
+	// ModifyDBClusterSnapshotAttribute.
+	SharedAccounts []*string `json:"sharedAccounts,omitempty"`
+	// The Amazon Resource Name (ARN) of the DB cluster snapshot to copy. When set,
+	// the snapshot is created with CopyDBClusterSnapshot instead of CreateDBClusterSnapshot,
+	// and DBClusterIdentifier must not be set.
+	//
+	// The source DB cluster snapshot can be in another Amazon Web Services Region,
+	// or shared from another Amazon Web Services account. You can't copy an encrypted,
+	// shared DB cluster snapshot from one Amazon Web Services Region to another.
+	//
+	// Example: arn:aws:rds:us-west-2:123456789012:cluster-snapshot:aurora-cluster1-snapshot-20161115
+	SourceDBClusterSnapshotARN *string `json:"sourceDBClusterSnapshotARN,omitempty"`
+	// The Amazon Web Services Region of the source DB cluster snapshot. It's used
+	// to generate the presigned URL of a cross-Region copy and isn't sent to RDS.
+	// Defaults to the Amazon Web Services Region in SourceDBClusterSnapshotARN.
+	// Ignored if PreSignedURL is set.
+	SourceDBClusterSnapshotRegion *string `json:"sourceDBClusterSnapshotRegion,omitempty"`
 	// The tags to be assigned to the DB cluster snapshot.
 	Tags []*Tag `json:"tags,omitempty"`
 }
@@ -95,13 +160,6 @@ type DBClusterSnapshotStatus struct {
 	// (IAM) accounts to database accounts is enabled.
 	// +kubebuilder:validation:Optional
 	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
-	// If StorageEncrypted is true, the Amazon Web Services KMS key identifier for
-	// the encrypted DB cluster snapshot.
-	//
-	// The Amazon Web Services KMS key identifier is the key ARN, key ID, alias
-	// ARN, or alias name for the KMS key.
-	// +kubebuilder:validation:Optional
-	KMSKeyID *string `json:"kmsKeyID,omitempty"`
 	// The license model information for this DB cluster snapshot.
 	// +kubebuilder:validation:Optional
 	LicenseModel *string `json:"licenseModel,omitempty"`
@@ -120,11 +178,6 @@ type DBClusterSnapshotStatus struct {
 	// The type of the DB cluster snapshot.
 	// +kubebuilder:validation:Optional
 	SnapshotType *string `json:"snapshotType,omitempty"`
-	// If the DB cluster snapshot was copied from a source DB cluster snapshot,
-	// the Amazon Resource Name (ARN) for the source DB cluster snapshot, otherwise,
-	// a null value.
-	// +kubebuilder:validation:Optional
-	SourceDBClusterSnapshotARN *string `json:"sourceDBClusterSnapshotARN,omitempty"`
 	// The status of this DB cluster snapshot. Valid statuses are the following:
 	//
 	//    * available
@@ -121,6 +121,15 @@ type DBParameterGroupStatus struct {
 	// A list of Parameter values.
 	// +kubebuilder:validation:Optional
 	ParameterOverrideStatuses []*Parameter `json:"parameterOverrideStatuses,omitempty"`
+	// The names of the parameter overrides that RDS applies with the pending-reboot
+	// apply method.
+	//
+	// Changes to these static parameters only take effect on the DB instances and
+	// DB clusters that use this parameter group after they are rebooted. Whether a
+	// DB instance or DB cluster is waiting for that reboot is reported by its
+	// PendingReboot condition.
+	// +kubebuilder:validation:Optional
+	StaticParameterOverrides []*string `json:"staticParameterOverrides,omitempty"`
 }
 
 // DBParameterGroup is the Schema for the DBParameterGroups API
@@ -30,6 +30,19 @@ type DBProxySpec struct {
 	// The authorization mechanism that the proxy uses.
 	// +kubebuilder:validation:Required
 	Auth []*UserAuthConfig `json:"auth"`
+	// The settings that determine the size and behavior of the connection pool
+	// for the default target group of the proxy.
+	ConnectionPoolConfig *ConnectionPoolConfiguration `json:"connectionPoolConfig,omitempty"`
+	// One or more DB cluster identifiers to register as targets of the default
+	// target group of the proxy. When set, DB clusters registered with the proxy
+	// that are not in this list are deregistered.
+	DBClusterIdentifiers    []*string                                  `json:"dbClusterIdentifiers,omitempty"`
+	DBClusterIdentifierRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"dbClusterIdentifierRefs,omitempty"`
+	// One or more DB instance identifiers to register as targets of the default
+	// target group of the proxy. When set, DB instances registered with the proxy
+	// that are not in this list are deregistered.
+	DBInstanceIdentifiers    []*string                                  `json:"dbInstanceIdentifiers,omitempty"`
+	DBInstanceIdentifierRefs []*ackv1alpha1.AWSResourceReferenceWrapper `json:"dbInstanceIdentifierRefs,omitempty"`
 	// Specifies whether the proxy includes detailed information about SQL statements
 	// in its logs. This information helps you to debug issues involving SQL behavior
 	// or the performance and scalability of the proxy connections. The debug information
@@ -100,6 +113,10 @@ type DBProxyStatus struct {
 	// proxy to be ready, or take some action to resolve an issue.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
+	// The DB instances and DB clusters registered with the default target group
+	// of the proxy, including the health of each target.
+	// +kubebuilder:validation:Optional
+	Targets []*DBProxyTarget `json:"targets,omitempty"`
 	// The date and time when the proxy was last updated.
 	// +kubebuilder:validation:Optional
 	UpdatedDate *metav1.Time `json:"updatedDate,omitempty"`
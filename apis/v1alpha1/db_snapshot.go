@@ -27,6 +27,14 @@ import (
 // This data type is used as a response element in the DescribeDBSnapshots action.
 type DBSnapshotSpec struct {
 
+	// Specifies whether to copy the DB option group associated with the source
+	// DB snapshot to the target Amazon Web Services account and associate with
+	// the target DB snapshot. The associated option group can be copied only with
+	// cross-account snapshot copy calls.
+	CopyOptionGroup *bool `json:"copyOptionGroup,omitempty"`
+	// Specifies whether to copy all tags from the source DB snapshot to the target
+	// DB snapshot. By default, tags aren't copied.
+	CopyTags *bool `json:"copyTags,omitempty"`
 	// The identifier of the DB instance that you want to create the snapshot of.
 	//
 	// Constraints:
@@ -49,7 +57,79 @@ type DBSnapshotSpec struct {
 	// Example: my-snapshot-id
 	// +kubebuilder:validation:Required
 	DBSnapshotIdentifier *string `json:"dbSnapshotIdentifier"`
-	Tags                 []*Tag  `json:"tags,omitempty"`
+	// The Amazon Web Services KMS key identifier for an encrypted DB snapshot.
+	// The Amazon Web Services KMS key identifier is the key ARN, key ID, alias
+	// ARN, or alias name for the KMS key.
+	//
+	// If you copy an encrypted DB snapshot from your Amazon Web Services account,
+	// you can specify a value for this parameter to encrypt the copy with a new
+	// KMS key. If you don't specify a value for this parameter, then the copy of
+	// the DB snapshot is encrypted with the same Amazon Web Services KMS key as
+	// the source DB snapshot.
+	//
+	// If you copy an encrypted DB snapshot that is shared from another Amazon Web
+	// Services account, then you must specify a value for this parameter.
+	//
+	// If you specify this parameter when you copy an unencrypted snapshot, the
+	// copy is encrypted.
+	//
+	// If you copy an encrypted snapshot to a different Amazon Web Services Region,
+	// then you must specify an Amazon Web Services KMS key identifier for the destination
+	// Amazon Web Services Region. KMS keys are specific to the Amazon Web Services
+	// Region that they are created in, and you can't use KMS keys from one Amazon
+	// Web Services Region in another Amazon Web Services Region.
+	KMSKeyID  *string                                  `json:"kmsKeyID,omitempty"`
+	KMSKeyRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"kmsKeyRef,omitempty"`
+	// The name of an option group to associate with the copy of the snapshot.
+	//
+	// Specify this option if you are copying a snapshot from one Amazon Web Services
+	// Region to another, and your DB instance uses a nondefault option group. If
+	// your source DB instance uses Transparent Data Encryption for Oracle or Microsoft
+	// SQL Server, you must specify this option when copying across Amazon Web Services
+	// Regions. For more information, see Option group considerations (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_CopySnapshot.html#USER_CopySnapshot.Options)
+	// in the Amazon RDS User Guide.
+	OptionGroupName *string `json:"optionGroupName,omitempty"`
+	// When you are copying a snapshot from one Amazon Web Services GovCloud (US)
+	// Region to another, the URL that contains a Signature Version 4 signed request
+	// for the CopyDBSnapshot API operation in the source Amazon Web Services Region
+	// that contains the source DB snapshot to copy.
+	//
+	// This setting applies only to Amazon Web Services GovCloud (US) Regions.
+	// It's ignored in other Amazon Web Services Regions.
+	//
+	// You must specify this parameter when you copy an encrypted DB snapshot from
+	// another Amazon Web Services Region by using the Amazon RDS API. Don't specify
+	// PreSignedUrl when you are copying an encrypted DB snapshot in the same Amazon
+	// Web Services Region.
+	//
+	// If you are using an Amazon Web Services SDK tool or the CLI, you can specify
+	// SourceRegion (or --source-region for the CLI) instead of specifying PreSignedUrl
+	// manually. Specifying SourceRegion autogenerates a presigned URL that is a
+	// valid request for the operation that can run in the source Amazon Web Services
+	// Region.
+	PreSignedURL *string `json:"preSignedURL,omitempty"`
+	// The Amazon Web Services account IDs that are allowed to copy or restore the
+	// manual DB snapshot, or all to make the DB snapshot public. The controller
+	// shares the DB snapshot with the accounts in the list and stops sharing it
+	// with any other account, using ModifyDBSnapshotAttribute.
+	SharedAccounts []*string `json:"sharedAccounts,omitempty"`
+	// The Amazon Resource Name (ARN) of the DB snapshot to copy. When set, the
+	// snapshot is created with CopyDBSnapshot instead of CreateDBSnapshot, and
+	// DBInstanceIdentifier must not be set.
+	//
+	// The source DB snapshot can be in another Amazon Web Services Region, or shared
+	// from another Amazon Web Services account. If the source DB snapshot is encrypted
+	// and in another Amazon Web Services Region, KMSKeyID must be a KMS key in
+	// the Amazon Web Services Region of the copy.
+	//
+	// Example: arn:aws:rds:us-west-2:123456789012:snapshot:mysql-instance1-snapshot-20130805
+	SourceDBSnapshotARN *string `json:"sourceDBSnapshotARN,omitempty"`
+	// The Amazon Web Services Region of the source DB snapshot. It's used to generate
+	// the presigned URL of a cross-Region copy and isn't sent to RDS. Defaults
+	// to the Amazon Web Services Region in SourceDBSnapshotARN. Ignored if PreSignedURL
+	// is set.
+	SourceDBSnapshotRegion *string `json:"sourceDBSnapshotRegion,omitempty"`
+	Tags                   []*Tag  `json:"tags,omitempty"`
 }
 
 // DBSnapshotStatus defines the observed state of DBSnapshot
@@ -94,13 +174,6 @@ type DBSnapshotStatus struct {
 	// instance at the time of the snapshot.
 	// +kubebuilder:validation:Optional
 	IOPS *int64 `json:"iops,omitempty"`
-	// If Encrypted is true, the Amazon Web Services KMS key identifier for the
-	// encrypted DB snapshot.
-	//
-	// The Amazon Web Services KMS key identifier is the key ARN, key ID, alias
-	// ARN, or alias name for the KMS key.
-	// +kubebuilder:validation:Optional
-	KMSKeyID *string `json:"kmsKeyID,omitempty"`
 	// License model information for the restored DB instance.
 	// +kubebuilder:validation:Optional
 	LicenseModel *string `json:"licenseModel,omitempty"`
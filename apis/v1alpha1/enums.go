@@ -92,15 +92,15 @@ const (
 	CustomEngineVersionStatus_inactive_except_restore CustomEngineVersionStatus = "inactive-except-restore"
 )
 
-type DBProxyEndpointStatus string
+type DBProxyEndpointStatus_SDK string
 
 const (
-	DBProxyEndpointStatus_available                    DBProxyEndpointStatus = "available"
-	DBProxyEndpointStatus_creating                     DBProxyEndpointStatus = "creating"
-	DBProxyEndpointStatus_deleting                     DBProxyEndpointStatus = "deleting"
-	DBProxyEndpointStatus_incompatible_network         DBProxyEndpointStatus = "incompatible-network"
-	DBProxyEndpointStatus_insufficient_resource_limits DBProxyEndpointStatus = "insufficient-resource-limits"
-	DBProxyEndpointStatus_modifying                    DBProxyEndpointStatus = "modifying"
+	DBProxyEndpointStatus_SDK_available                    DBProxyEndpointStatus_SDK = "available"
+	DBProxyEndpointStatus_SDK_creating                     DBProxyEndpointStatus_SDK = "creating"
+	DBProxyEndpointStatus_SDK_deleting                     DBProxyEndpointStatus_SDK = "deleting"
+	DBProxyEndpointStatus_SDK_incompatible_network         DBProxyEndpointStatus_SDK = "incompatible-network"
+	DBProxyEndpointStatus_SDK_insufficient_resource_limits DBProxyEndpointStatus_SDK = "insufficient-resource-limits"
+	DBProxyEndpointStatus_SDK_modifying                    DBProxyEndpointStatus_SDK = "modifying"
 )
 
 type DBProxyEndpointTargetRole string
@@ -57,6 +57,36 @@ type GlobalClusterSpec struct {
 	// The cluster identifier for this global database cluster. This parameter is
 	// stored as a lowercase string.
 	GlobalClusterIdentifier *string `json:"globalClusterIdentifier,omitempty"`
+	// How the controller makes PrimaryDBClusterARN the primary cluster of the
+	// global database, either "switchover" or "failover".
+	//
+	// With "switchover", the default, the controller calls SwitchoverGlobalCluster,
+	// which waits for the secondary cluster to be fully synchronized with the
+	// primary cluster and doesn't lose data. Use it for planned operations such
+	// as regional rotations and disaster recovery drills.
+	//
+	// With "failover", the controller calls FailoverGlobalCluster with AllowDataLoss,
+	// which promotes the secondary cluster without waiting for replication. Use
+	// it to recover from an outage of the primary Region. Writes that weren't
+	// replicated to the secondary cluster yet are lost.
+	PrimaryChangeMode *string `json:"primaryChangeMode,omitempty"`
+	// The Amazon Resource Name (ARN) of the DB cluster that should be the primary
+	// cluster of the global database. It must be a secondary cluster of the global
+	// database. When another DB cluster is the writer of the global database, the
+	// controller switches over or fails over to this DB cluster according to
+	// PrimaryChangeMode. The progress is reported in the ACK.ResourceSynced condition
+	// until status.failoverState is cleared.
+	//
+	// When not set, the controller doesn't change the primary cluster.
+	PrimaryDBClusterARN *string `json:"primaryDBClusterARN,omitempty"`
+	// The Amazon Resource Names (ARNs) of DB clusters to detach from the global
+	// database. The controller removes each DB cluster in the list that is still
+	// a member of the global database with RemoveFromGlobalCluster. A detached
+	// DB cluster becomes a standalone DB cluster and keeps its data.
+	//
+	// The primary cluster can only be detached once it is the last member of the
+	// global database.
+	RemovedMemberARNs []*string `json:"removedMemberARNs,omitempty"`
 	// The Amazon Resource Name (ARN) to use as the primary cluster of the global
 	// database.
 	//
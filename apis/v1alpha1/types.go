@@ -115,6 +115,20 @@ type AccountQuota struct {
 	AccountQuotaName *string `json:"accountQuotaName,omitempty"`
 }
 
+type AddRoleToDBClusterInput struct {
+	FeatureName *string `json:"featureName,omitempty"`
+	RoleARN     *string `json:"roleARN,omitempty"`
+	// Reference field for RoleARN
+	RoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"roleRef,omitempty"`
+}
+
+type AddRoleToDBInstanceInput struct {
+	FeatureName *string `json:"featureName,omitempty"`
+	RoleARN     *string `json:"roleARN,omitempty"`
+	// Reference field for RoleARN
+	RoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"roleRef,omitempty"`
+}
+
 // Contains Availability Zone information.
 //
 // This data type is used as an element in the OrderableDBInstanceOption data
@@ -142,7 +156,7 @@ type AvailableProcessorFeature struct {
 // in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
 // for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
 // in the Amazon Aurora User Guide.
-type BlueGreenDeployment struct {
+type BlueGreenDeployment_SDK struct {
 	CreateTime *metav1.Time `json:"createTime,omitempty"`
 	DeleteTime *metav1.Time `json:"deleteTime,omitempty"`
 	// A list of tags.
@@ -154,6 +168,18 @@ type BlueGreenDeployment struct {
 	TagList []*Tag `json:"tagList,omitempty"`
 }
 
+// Details about a task for a blue/green deployment.
+//
+// For more information, see Using Amazon RDS Blue/Green Deployments for database
+// updates (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/blue-green-deployments.html)
+// in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
+// for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
+// in the Amazon Aurora User Guide.
+type BlueGreenDeploymentTask struct {
+	Name   *string `json:"name,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
 // A CA certificate for an Amazon Web Services account.
 //
 // For more information, see Using SSL/TLS to encrypt a connection to a DB instance
@@ -793,7 +819,7 @@ type DBParameterGroup_SDK struct {
 //
 // This data type is used as a response element in the DescribeDBProxyEndpoints
 // operation.
-type DBProxyEndpoint struct {
+type DBProxyEndpoint_SDK struct {
 	CreatedDate         *metav1.Time `json:"createdDate,omitempty"`
 	DBProxyEndpointARN  *string      `json:"dbProxyEndpointARN,omitempty"`
 	DBProxyEndpointName *string      `json:"dbProxyEndpointName,omitempty"`
@@ -812,11 +838,15 @@ type DBProxyEndpoint struct {
 // This data type is used as a response element in the DescribeDBProxyTargets
 // action.
 type DBProxyTarget struct {
-	Endpoint         *string `json:"endpoint,omitempty"`
-	Port             *int64  `json:"port,omitempty"`
-	RdsResourceID    *string `json:"rdsResourceID,omitempty"`
-	TargetARN        *string `json:"targetARN,omitempty"`
-	TrackedClusterID *string `json:"trackedClusterID,omitempty"`
+	Endpoint      *string `json:"endpoint,omitempty"`
+	Port          *int64  `json:"port,omitempty"`
+	RdsResourceID *string `json:"rdsResourceID,omitempty"`
+	Role          *string `json:"role,omitempty"`
+	TargetARN     *string `json:"targetARN,omitempty"`
+	// Information about the connection health of an RDS Proxy target.
+	TargetHealth     *TargetHealth `json:"targetHealth,omitempty"`
+	TrackedClusterID *string       `json:"trackedClusterID,omitempty"`
+	Type             *string       `json:"type,omitempty"`
 }
 
 // Represents a set of RDS DB instances, Aurora DB clusters, or both that a
@@ -1100,7 +1130,7 @@ type EventCategoriesMap struct {
 
 // Contains the results of a successful invocation of the DescribeEventSubscriptions
 // action.
-type EventSubscription struct {
+type EventSubscription_SDK struct {
 	CustSubscriptionID       *string `json:"custSubscriptionID,omitempty"`
 	CustomerAWSID            *string `json:"customerAWSID,omitempty"`
 	Enabled                  *bool   `json:"enabled,omitempty"`
@@ -1136,9 +1166,10 @@ type ExportTask struct {
 // (Aurora global database). This data type is empty unless a switchover or
 // failover operation is scheduled or is in progress on the Aurora global database.
 type FailoverState struct {
-	FromDBClusterARN *string `json:"fromDBClusterARN,omitempty"`
-	Status           *string `json:"status,omitempty"`
-	ToDBClusterARN   *string `json:"toDBClusterARN,omitempty"`
+	FromDBClusterARN  *string `json:"fromDBClusterARN,omitempty"`
+	IsDataLossAllowed *bool   `json:"isDataLossAllowed,omitempty"`
+	Status            *string `json:"status,omitempty"`
+	ToDBClusterARN    *string `json:"toDBClusterARN,omitempty"`
 }
 
 // A filter name and value pair that is used to return a more specific list
@@ -1279,14 +1310,15 @@ type Option struct {
 
 // A list of all available options for an option group.
 type OptionConfiguration struct {
-	DBSecurityGroupMemberships  []*string `json:"dbSecurityGroupMemberships,omitempty"`
-	OptionName                  *string   `json:"optionName,omitempty"`
-	OptionVersion               *string   `json:"optionVersion,omitempty"`
-	Port                        *int64    `json:"port,omitempty"`
-	VPCSecurityGroupMemberships []*string `json:"vpcSecurityGroupMemberships,omitempty"`
+	DBSecurityGroupMemberships  []*string        `json:"dbSecurityGroupMemberships,omitempty"`
+	OptionName                  *string          `json:"optionName,omitempty"`
+	OptionSettings              []*OptionSetting `json:"optionSettings,omitempty"`
+	OptionVersion               *string          `json:"optionVersion,omitempty"`
+	Port                        *int64           `json:"port,omitempty"`
+	VPCSecurityGroupMemberships []*string        `json:"vpcSecurityGroupMemberships,omitempty"`
 }
 
-type OptionGroup struct {
+type OptionGroup_SDK struct {
 	AllowsVPCAndNonVPCInstanceMemberships *bool        `json:"allowsVPCAndNonVPCInstanceMemberships,omitempty"`
 	CopyTimestamp                         *metav1.Time `json:"copyTimestamp,omitempty"`
 	EngineName                            *string      `json:"engineName,omitempty"`
@@ -1733,6 +1765,19 @@ type Subnet struct {
 	SubnetStatus  *string  `json:"subnetStatus,omitempty"`
 }
 
+// Contains the details about a blue/green deployment.
+//
+// For more information, see Using Amazon RDS Blue/Green Deployments for database
+// updates (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/blue-green-deployments.html)
+// in the Amazon RDS User Guide and Using Amazon RDS Blue/Green Deployments
+// for database updates (https://docs.aws.amazon.com/AmazonRDS/latest/AuroraUserGuide/blue-green-deployments.html)
+// in the Amazon Aurora User Guide.
+type SwitchoverDetail struct {
+	SourceMember *string `json:"sourceMember,omitempty"`
+	Status       *string `json:"status,omitempty"`
+	TargetMember *string `json:"targetMember,omitempty"`
+}
+
 // Metadata assigned to an Amazon RDS resource consisting of a key-value pair.
 //
 // For more information, see Tagging Amazon RDS resources (https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
@@ -1747,6 +1792,8 @@ type Tag struct {
 // Information about the connection health of an RDS Proxy target.
 type TargetHealth struct {
 	Description *string `json:"description,omitempty"`
+	Reason      *string `json:"reason,omitempty"`
+	State       *string `json:"state,omitempty"`
 }
 
 // A tenant database in the DB instance. This data type is an element in the
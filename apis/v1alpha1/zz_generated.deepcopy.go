@@ -44,6 +44,66 @@ func (in *AccountQuota) DeepCopy() *AccountQuota {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddRoleToDBClusterInput) DeepCopyInto(out *AddRoleToDBClusterInput) {
+	*out = *in
+	if in.FeatureName != nil {
+		in, out := &in.FeatureName, &out.FeatureName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddRoleToDBClusterInput.
+func (in *AddRoleToDBClusterInput) DeepCopy() *AddRoleToDBClusterInput {
+	if in == nil {
+		return nil
+	}
+	out := new(AddRoleToDBClusterInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddRoleToDBInstanceInput) DeepCopyInto(out *AddRoleToDBInstanceInput) {
+	*out = *in
+	if in.FeatureName != nil {
+		in, out := &in.FeatureName, &out.FeatureName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddRoleToDBInstanceInput.
+func (in *AddRoleToDBInstanceInput) DeepCopy() *AddRoleToDBInstanceInput {
+	if in == nil {
+		return nil
+	}
+	out := new(AddRoleToDBInstanceInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AvailabilityZone) DeepCopyInto(out *AvailabilityZone) {
 	*out = *in
@@ -84,18 +144,309 @@ func (in *AvailableProcessorFeature) DeepCopyInto(out *AvailableProcessorFeature
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableProcessorFeature.
-func (in *AvailableProcessorFeature) DeepCopy() *AvailableProcessorFeature {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableProcessorFeature.
+func (in *AvailableProcessorFeature) DeepCopy() *AvailableProcessorFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableProcessorFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenDeployment) DeepCopyInto(out *BlueGreenDeployment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment.
+func (in *BlueGreenDeployment) DeepCopy() *BlueGreenDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueGreenDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenDeploymentList) DeepCopyInto(out *BlueGreenDeploymentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BlueGreenDeployment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentList.
+func (in *BlueGreenDeploymentList) DeepCopy() *BlueGreenDeploymentList {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenDeploymentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlueGreenDeploymentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenDeploymentSpec) DeepCopyInto(out *BlueGreenDeploymentSpec) {
+	*out = *in
+	if in.DeleteSourceAfterSwitchover != nil {
+		in, out := &in.DeleteSourceAfterSwitchover, &out.DeleteSourceAfterSwitchover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteTarget != nil {
+		in, out := &in.DeleteTarget, &out.DeleteTarget
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBClusterRef != nil {
+		in, out := &in.SourceDBClusterRef, &out.SourceDBClusterRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceDBInstanceRef != nil {
+		in, out := &in.SourceDBInstanceRef, &out.SourceDBInstanceRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SwitchoverTimeout != nil {
+		in, out := &in.SwitchoverTimeout, &out.SwitchoverTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TargetAllocatedStorage != nil {
+		in, out := &in.TargetAllocatedStorage, &out.TargetAllocatedStorage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetDBClusterParameterGroupName != nil {
+		in, out := &in.TargetDBClusterParameterGroupName, &out.TargetDBClusterParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetDBClusterParameterGroupRef != nil {
+		in, out := &in.TargetDBClusterParameterGroupRef, &out.TargetDBClusterParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetDBInstanceClass != nil {
+		in, out := &in.TargetDBInstanceClass, &out.TargetDBInstanceClass
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetDBParameterGroupName != nil {
+		in, out := &in.TargetDBParameterGroupName, &out.TargetDBParameterGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetDBParameterGroupRef != nil {
+		in, out := &in.TargetDBParameterGroupRef, &out.TargetDBParameterGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetEngineVersion != nil {
+		in, out := &in.TargetEngineVersion, &out.TargetEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetIOPS != nil {
+		in, out := &in.TargetIOPS, &out.TargetIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetStorageThroughput != nil {
+		in, out := &in.TargetStorageThroughput, &out.TargetStorageThroughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TargetStorageType != nil {
+		in, out := &in.TargetStorageType, &out.TargetStorageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpgradeTargetStorageConfig != nil {
+		in, out := &in.UpgradeTargetStorageConfig, &out.UpgradeTargetStorageConfig
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentSpec.
+func (in *BlueGreenDeploymentSpec) DeepCopy() *BlueGreenDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenDeploymentStatus) DeepCopyInto(out *BlueGreenDeploymentStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.BlueGreenDeploymentIdentifier != nil {
+		in, out := &in.BlueGreenDeploymentIdentifier, &out.BlueGreenDeploymentIdentifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteTime != nil {
+		in, out := &in.DeleteTime, &out.DeleteTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceDeleted != nil {
+		in, out := &in.SourceDeleted, &out.SourceDeleted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusDetails != nil {
+		in, out := &in.StatusDetails, &out.StatusDetails
+		*out = new(string)
+		**out = **in
+	}
+	if in.SwitchoverDetails != nil {
+		in, out := &in.SwitchoverDetails, &out.SwitchoverDetails
+		*out = make([]*SwitchoverDetail, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SwitchoverDetail)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]*BlueGreenDeploymentTask, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(BlueGreenDeploymentTask)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentStatus.
+func (in *BlueGreenDeploymentStatus) DeepCopy() *BlueGreenDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenDeploymentTask) DeepCopyInto(out *BlueGreenDeploymentTask) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeploymentTask.
+func (in *BlueGreenDeploymentTask) DeepCopy() *BlueGreenDeploymentTask {
 	if in == nil {
 		return nil
 	}
-	out := new(AvailableProcessorFeature)
+	out := new(BlueGreenDeploymentTask)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BlueGreenDeployment) DeepCopyInto(out *BlueGreenDeployment) {
+func (in *BlueGreenDeployment_SDK) DeepCopyInto(out *BlueGreenDeployment_SDK) {
 	*out = *in
 	if in.CreateTime != nil {
 		in, out := &in.CreateTime, &out.CreateTime
@@ -118,12 +469,12 @@ func (in *BlueGreenDeployment) DeepCopyInto(out *BlueGreenDeployment) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment.
-func (in *BlueGreenDeployment) DeepCopy() *BlueGreenDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenDeployment_SDK.
+func (in *BlueGreenDeployment_SDK) DeepCopy() *BlueGreenDeployment_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(BlueGreenDeployment)
+	out := new(BlueGreenDeployment_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -712,6 +1063,22 @@ func (in *DBClusterEndpointSpec) DeepCopyInto(out *DBClusterEndpointSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExcludedMemberSelector != nil {
+		in, out := &in.ExcludedMemberSelector, &out.ExcludedMemberSelector
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.ExcludedMembers != nil {
 		in, out := &in.ExcludedMembers, &out.ExcludedMembers
 		*out = make([]*string, len(*in))
@@ -723,6 +1090,33 @@ func (in *DBClusterEndpointSpec) DeepCopyInto(out *DBClusterEndpointSpec) {
 			}
 		}
 	}
+	if in.ExcludedMemberRefs != nil {
+		in, out := &in.ExcludedMemberRefs, &out.ExcludedMemberRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.StaticMemberSelector != nil {
+		in, out := &in.StaticMemberSelector, &out.StaticMemberSelector
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.StaticMembers != nil {
 		in, out := &in.StaticMembers, &out.StaticMembers
 		*out = make([]*string, len(*in))
@@ -734,6 +1128,17 @@ func (in *DBClusterEndpointSpec) DeepCopyInto(out *DBClusterEndpointSpec) {
 			}
 		}
 	}
+	if in.StaticMemberRefs != nil {
+		in, out := &in.StaticMemberRefs, &out.StaticMemberRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -1124,6 +1529,17 @@ func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGro
 			}
 		}
 	}
+	if in.StaticParameterOverrides != nil {
+		in, out := &in.StaticParameterOverrides, &out.StaticParameterOverrides
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupStatus.
@@ -1303,6 +1719,11 @@ func (in *DBClusterSnapshotList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBClusterSnapshotSpec) DeepCopyInto(out *DBClusterSnapshotSpec) {
 	*out = *in
+	if in.CopyTags != nil {
+		in, out := &in.CopyTags, &out.CopyTags
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DBClusterIdentifier != nil {
 		in, out := &in.DBClusterIdentifier, &out.DBClusterIdentifier
 		*out = new(string)
@@ -1318,6 +1739,42 @@ func (in *DBClusterSnapshotSpec) DeepCopyInto(out *DBClusterSnapshotSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreSignedURL != nil {
+		in, out := &in.PreSignedURL, &out.PreSignedURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedAccounts != nil {
+		in, out := &in.SharedAccounts, &out.SharedAccounts
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SourceDBClusterSnapshotARN != nil {
+		in, out := &in.SourceDBClusterSnapshotARN, &out.SourceDBClusterSnapshotARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBClusterSnapshotRegion != nil {
+		in, out := &in.SourceDBClusterSnapshotRegion, &out.SourceDBClusterSnapshotRegion
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -1405,11 +1862,6 @@ func (in *DBClusterSnapshotStatus) DeepCopyInto(out *DBClusterSnapshotStatus) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
-		**out = **in
-	}
 	if in.LicenseModel != nil {
 		in, out := &in.LicenseModel, &out.LicenseModel
 		*out = new(string)
@@ -1439,11 +1891,6 @@ func (in *DBClusterSnapshotStatus) DeepCopyInto(out *DBClusterSnapshotStatus) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceDBClusterSnapshotARN != nil {
-		in, out := &in.SourceDBClusterSnapshotARN, &out.SourceDBClusterSnapshotARN
-		*out = new(string)
-		**out = **in
-	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -1625,6 +2072,11 @@ func (in *DBClusterSnapshot_SDK) DeepCopy() *DBClusterSnapshot_SDK {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 	*out = *in
+	if in.ActivationState != nil {
+		in, out := &in.ActivationState, &out.ActivationState
+		*out = new(string)
+		**out = **in
+	}
 	if in.AllocatedStorage != nil {
 		in, out := &in.AllocatedStorage, &out.AllocatedStorage
 		*out = new(int64)
@@ -1661,6 +2113,37 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConnectionDetailsDBProxyName != nil {
+		in, out := &in.ConnectionDetailsDBProxyName, &out.ConnectionDetailsDBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionDetailsKeys != nil {
+		in, out := &in.ConnectionDetailsKeys, &out.ConnectionDetailsKeys
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ConnectionDetailsKind != nil {
+		in, out := &in.ConnectionDetailsKind, &out.ConnectionDetailsKind
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionDetailsName != nil {
+		in, out := &in.ConnectionDetailsName, &out.ConnectionDetailsName
+		*out = new(string)
+		**out = **in
+	}
 	if in.CopyTagsToSnapshot != nil {
 		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
 		*out = new(bool)
@@ -1777,11 +2260,26 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FailoverGeneration != nil {
+		in, out := &in.FailoverGeneration, &out.FailoverGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FailoverTargetDBInstanceIdentifier != nil {
+		in, out := &in.FailoverTargetDBInstanceIdentifier, &out.FailoverTargetDBInstanceIdentifier
+		*out = new(string)
+		**out = **in
+	}
 	if in.GlobalClusterIdentifier != nil {
 		in, out := &in.GlobalClusterIdentifier, &out.GlobalClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
+	if in.GlobalClusterIdentifierRef != nil {
+		in, out := &in.GlobalClusterIdentifierRef, &out.GlobalClusterIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IOPS != nil {
 		in, out := &in.IOPS, &out.IOPS
 		*out = new(int64)
@@ -1805,7 +2303,7 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 	if in.MasterUserPassword != nil {
 		in, out := &in.MasterUserPassword, &out.MasterUserPassword
 		*out = new(corev1alpha1.SecretKeyReference)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MasterUserSecretKMSKeyID != nil {
 		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
@@ -1817,6 +2315,11 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterUserSecretSyncName != nil {
+		in, out := &in.MasterUserSecretSyncName, &out.MasterUserSecretSyncName
+		*out = new(string)
+		**out = **in
+	}
 	if in.MasterUsername != nil {
 		in, out := &in.MasterUsername, &out.MasterUsername
 		*out = new(string)
@@ -1847,6 +2350,11 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupRef != nil {
+		in, out := &in.OptionGroupRef, &out.OptionGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PerformanceInsightsKMSKeyID != nil {
 		in, out := &in.PerformanceInsightsKMSKeyID, &out.PerformanceInsightsKMSKeyID
 		*out = new(string)
@@ -1892,6 +2400,16 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReplicationSourceIdentifierRef != nil {
+		in, out := &in.ReplicationSourceIdentifierRef, &out.ReplicationSourceIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestartGeneration != nil {
+		in, out := &in.RestartGeneration, &out.RestartGeneration
+		*out = new(int64)
+		**out = **in
+	}
 	if in.RestoreToTime != nil {
 		in, out := &in.RestoreToTime, &out.RestoreToTime
 		*out = (*in).DeepCopy()
@@ -1901,6 +2419,17 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RoleAssociations != nil {
+		in, out := &in.RoleAssociations, &out.RoleAssociations
+		*out = make([]*AddRoleToDBClusterInput, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AddRoleToDBClusterInput)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.ScalingConfiguration != nil {
 		in, out := &in.ScalingConfiguration, &out.ScalingConfiguration
 		*out = new(ScalingConfiguration)
@@ -1916,11 +2445,21 @@ func (in *DBClusterSpec) DeepCopyInto(out *DBClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SnapshotIdentifierRef != nil {
+		in, out := &in.SnapshotIdentifierRef, &out.SnapshotIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SourceDBClusterIdentifier != nil {
 		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceDBClusterIdentifierRef != nil {
+		in, out := &in.SourceDBClusterIdentifierRef, &out.SourceDBClusterIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SourceRegion != nil {
 		in, out := &in.SourceRegion, &out.SourceRegion
 		*out = new(string)
@@ -2172,6 +2711,16 @@ func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastFailoverGeneration != nil {
+		in, out := &in.LastFailoverGeneration, &out.LastFailoverGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastRestartGeneration != nil {
+		in, out := &in.LastRestartGeneration, &out.LastRestartGeneration
+		*out = new(int64)
+		**out = **in
+	}
 	if in.LatestRestorableTime != nil {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
@@ -2181,6 +2730,11 @@ func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 		*out = new(MasterUserSecret)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterUserSecretVersionID != nil {
+		in, out := &in.MasterUserSecretVersionID, &out.MasterUserSecretVersionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.MultiAZ != nil {
 		in, out := &in.MultiAZ, &out.MultiAZ
 		*out = new(bool)
@@ -3160,6 +3714,11 @@ func (in *DBInstanceRole) DeepCopy() *DBInstanceRole {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 	*out = *in
+	if in.ActivationState != nil {
+		in, out := &in.ActivationState, &out.ActivationState
+		*out = new(string)
+		**out = **in
+	}
 	if in.AllocatedStorage != nil {
 		in, out := &in.AllocatedStorage, &out.AllocatedStorage
 		*out = new(int64)
@@ -3195,6 +3754,37 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConnectionDetailsDBProxyName != nil {
+		in, out := &in.ConnectionDetailsDBProxyName, &out.ConnectionDetailsDBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionDetailsKeys != nil {
+		in, out := &in.ConnectionDetailsKeys, &out.ConnectionDetailsKeys
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ConnectionDetailsKind != nil {
+		in, out := &in.ConnectionDetailsKind, &out.ConnectionDetailsKind
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionDetailsName != nil {
+		in, out := &in.ConnectionDetailsName, &out.ConnectionDetailsName
+		*out = new(string)
+		**out = **in
+	}
 	if in.CopyTagsToSnapshot != nil {
 		in, out := &in.CopyTagsToSnapshot, &out.CopyTagsToSnapshot
 		*out = new(bool)
@@ -3210,11 +3800,21 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBClusterIdentifierRef != nil {
+		in, out := &in.DBClusterIdentifierRef, &out.DBClusterIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBClusterSnapshotIdentifier != nil {
 		in, out := &in.DBClusterSnapshotIdentifier, &out.DBClusterSnapshotIdentifier
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBClusterSnapshotIdentifierRef != nil {
+		in, out := &in.DBClusterSnapshotIdentifierRef, &out.DBClusterSnapshotIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBInstanceClass != nil {
 		in, out := &in.DBInstanceClass, &out.DBInstanceClass
 		*out = new(string)
@@ -3245,6 +3845,11 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DBSnapshotIdentifierRef != nil {
+		in, out := &in.DBSnapshotIdentifierRef, &out.DBSnapshotIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBSubnetGroupName != nil {
 		in, out := &in.DBSubnetGroupName, &out.DBSubnetGroupName
 		*out = new(string)
@@ -3339,7 +3944,7 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 	if in.MasterUserPassword != nil {
 		in, out := &in.MasterUserPassword, &out.MasterUserPassword
 		*out = new(corev1alpha1.SecretKeyReference)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MasterUserSecretKMSKeyID != nil {
 		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
@@ -3351,6 +3956,11 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterUserSecretSyncName != nil {
+		in, out := &in.MasterUserSecretSyncName, &out.MasterUserSecretSyncName
+		*out = new(string)
+		**out = **in
+	}
 	if in.MasterUsername != nil {
 		in, out := &in.MasterUsername, &out.MasterUsername
 		*out = new(string)
@@ -3396,6 +4006,11 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupRef != nil {
+		in, out := &in.OptionGroupRef, &out.OptionGroupRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PerformanceInsightsEnabled != nil {
 		in, out := &in.PerformanceInsightsEnabled, &out.PerformanceInsightsEnabled
 		*out = new(bool)
@@ -3452,13 +4067,43 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.PubliclyAccessible != nil {
-		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
-		*out = new(bool)
+	if in.PubliclyAccessible != nil {
+		in, out := &in.PubliclyAccessible, &out.PubliclyAccessible
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReplicaMode != nil {
+		in, out := &in.ReplicaMode, &out.ReplicaMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestartGeneration != nil {
+		in, out := &in.RestartGeneration, &out.RestartGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RestoreTime != nil {
+		in, out := &in.RestoreTime, &out.RestoreTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RoleAssociations != nil {
+		in, out := &in.RoleAssociations, &out.RoleAssociations
+		*out = make([]*AddRoleToDBInstanceInput, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AddRoleToDBInstanceInput)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SourceDBIResourceID != nil {
+		in, out := &in.SourceDBIResourceID, &out.SourceDBIResourceID
+		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicaMode != nil {
-		in, out := &in.ReplicaMode, &out.ReplicaMode
+	if in.SourceDBInstanceAutomatedBackupsARN != nil {
+		in, out := &in.SourceDBInstanceAutomatedBackupsARN, &out.SourceDBInstanceAutomatedBackupsARN
 		*out = new(string)
 		**out = **in
 	}
@@ -3467,6 +4112,11 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceDBInstanceIdentifierRef != nil {
+		in, out := &in.SourceDBInstanceIdentifierRef, &out.SourceDBInstanceIdentifierRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SourceRegion != nil {
 		in, out := &in.SourceRegion, &out.SourceRegion
 		*out = new(string)
@@ -3518,6 +4168,11 @@ func (in *DBInstanceSpec) DeepCopyInto(out *DBInstanceSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.UseLatestRestorableTime != nil {
+		in, out := &in.UseLatestRestorableTime, &out.UseLatestRestorableTime
+		*out = new(bool)
+		**out = **in
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]*string, len(*in))
@@ -3724,6 +4379,11 @@ func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastRestartGeneration != nil {
+		in, out := &in.LastRestartGeneration, &out.LastRestartGeneration
+		*out = new(int64)
+		**out = **in
+	}
 	if in.LatestRestorableTime != nil {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
@@ -3738,6 +4398,11 @@ func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 		*out = new(MasterUserSecret)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterUserSecretVersionID != nil {
+		in, out := &in.MasterUserSecretVersionID, &out.MasterUserSecretVersionID
+		*out = new(string)
+		**out = **in
+	}
 	if in.OptionGroupMemberships != nil {
 		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
 		*out = make([]*OptionGroupMembership, len(*in))
@@ -4487,6 +5152,17 @@ func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
 			}
 		}
 	}
+	if in.StaticParameterOverrides != nil {
+		in, out := &in.StaticParameterOverrides, &out.StaticParameterOverrides
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupStatus.
@@ -4588,6 +5264,210 @@ func (in *DBProxy) DeepCopyObject() runtime.Object {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint.
+func (in *DBProxyEndpoint) DeepCopy() *DBProxyEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointList) DeepCopyInto(out *DBProxyEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DBProxyEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointList.
+func (in *DBProxyEndpointList) DeepCopy() *DBProxyEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DBProxyEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointSpec) DeepCopyInto(out *DBProxyEndpointSpec) {
+	*out = *in
+	if in.DBProxyName != nil {
+		in, out := &in.DBProxyName, &out.DBProxyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DBProxyRef != nil {
+		in, out := &in.DBProxyRef, &out.DBProxyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.TargetRole != nil {
+		in, out := &in.TargetRole, &out.TargetRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCSecurityGroupIDs != nil {
+		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSecurityGroupRefs != nil {
+		in, out := &in.VPCSecurityGroupRefs, &out.VPCSecurityGroupRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VPCSubnetIDs != nil {
+		in, out := &in.VPCSubnetIDs, &out.VPCSubnetIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.VPCSubnetRefs != nil {
+		in, out := &in.VPCSubnetRefs, &out.VPCSubnetRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointSpec.
+func (in *DBProxyEndpointSpec) DeepCopy() *DBProxyEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpointStatus) DeepCopyInto(out *DBProxyEndpointStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsDefault != nil {
+		in, out := &in.IsDefault, &out.IsDefault
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpointStatus.
+func (in *DBProxyEndpointStatus) DeepCopy() *DBProxyEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DBProxyEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxyEndpoint_SDK) DeepCopyInto(out *DBProxyEndpoint_SDK) {
 	*out = *in
 	if in.CreatedDate != nil {
 		in, out := &in.CreatedDate, &out.CreatedDate
@@ -4647,12 +5527,12 @@ func (in *DBProxyEndpoint) DeepCopyInto(out *DBProxyEndpoint) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint.
-func (in *DBProxyEndpoint) DeepCopy() *DBProxyEndpoint {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyEndpoint_SDK.
+func (in *DBProxyEndpoint_SDK) DeepCopy() *DBProxyEndpoint_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(DBProxyEndpoint)
+	out := new(DBProxyEndpoint_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4686,19 +5566,68 @@ func (in *DBProxyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
-	*out = *in
-	if in.Auth != nil {
-		in, out := &in.Auth, &out.Auth
-		*out = make([]*UserAuthConfig, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBProxySpec) DeepCopyInto(out *DBProxySpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = make([]*UserAuthConfig, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UserAuthConfig)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ConnectionPoolConfig != nil {
+		in, out := &in.ConnectionPoolConfig, &out.ConnectionPoolConfig
+		*out = new(ConnectionPoolConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DBClusterIdentifiers != nil {
+		in, out := &in.DBClusterIdentifiers, &out.DBClusterIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.DBClusterIdentifierRefs != nil {
+		in, out := &in.DBClusterIdentifierRefs, &out.DBClusterIdentifierRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DBInstanceIdentifiers != nil {
+		in, out := &in.DBInstanceIdentifiers, &out.DBInstanceIdentifiers
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.DBInstanceIdentifierRefs != nil {
+		in, out := &in.DBInstanceIdentifierRefs, &out.DBInstanceIdentifierRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(UserAuthConfig)
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -4816,6 +5745,17 @@ func (in *DBProxyStatus) DeepCopyInto(out *DBProxyStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]*DBProxyTarget, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DBProxyTarget)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.UpdatedDate != nil {
 		in, out := &in.UpdatedDate, &out.UpdatedDate
 		*out = (*in).DeepCopy()
@@ -4855,16 +5795,31 @@ func (in *DBProxyTarget) DeepCopyInto(out *DBProxyTarget) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Role != nil {
+		in, out := &in.Role, &out.Role
+		*out = new(string)
+		**out = **in
+	}
 	if in.TargetARN != nil {
 		in, out := &in.TargetARN, &out.TargetARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.TargetHealth != nil {
+		in, out := &in.TargetHealth, &out.TargetHealth
+		*out = new(TargetHealth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TrackedClusterID != nil {
 		in, out := &in.TrackedClusterID, &out.TrackedClusterID
 		*out = new(string)
 		**out = **in
 	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBProxyTarget.
@@ -5367,6 +6322,16 @@ func (in *DBSnapshotList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBSnapshotSpec) DeepCopyInto(out *DBSnapshotSpec) {
 	*out = *in
+	if in.CopyOptionGroup != nil {
+		in, out := &in.CopyOptionGroup, &out.CopyOptionGroup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyTags != nil {
+		in, out := &in.CopyTags, &out.CopyTags
+		*out = new(bool)
+		**out = **in
+	}
 	if in.DBInstanceIdentifier != nil {
 		in, out := &in.DBInstanceIdentifier, &out.DBInstanceIdentifier
 		*out = new(string)
@@ -5382,6 +6347,47 @@ func (in *DBSnapshotSpec) DeepCopyInto(out *DBSnapshotSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyRef != nil {
+		in, out := &in.KMSKeyRef, &out.KMSKeyRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OptionGroupName != nil {
+		in, out := &in.OptionGroupName, &out.OptionGroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreSignedURL != nil {
+		in, out := &in.PreSignedURL, &out.PreSignedURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.SharedAccounts != nil {
+		in, out := &in.SharedAccounts, &out.SharedAccounts
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SourceDBSnapshotARN != nil {
+		in, out := &in.SourceDBSnapshotARN, &out.SourceDBSnapshotARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceDBSnapshotRegion != nil {
+		in, out := &in.SourceDBSnapshotRegion, &out.SourceDBSnapshotRegion
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]*Tag, len(*in))
@@ -5463,11 +6469,6 @@ func (in *DBSnapshotStatus) DeepCopyInto(out *DBSnapshotStatus) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.KMSKeyID != nil {
-		in, out := &in.KMSKeyID, &out.KMSKeyID
-		*out = new(string)
-		**out = **in
-	}
 	if in.LicenseModel != nil {
 		in, out := &in.LicenseModel, &out.LicenseModel
 		*out = new(string)
@@ -6277,55 +7278,244 @@ func (in *Event) DeepCopyInto(out *Event) {
 		in, out := &in.Date, &out.Date
 		*out = (*in).DeepCopy()
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceIdentifier != nil {
+		in, out := &in.SourceIdentifier, &out.SourceIdentifier
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
+func (in *Event) DeepCopy() *Event {
+	if in == nil {
+		return nil
+	}
+	out := new(Event)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventCategoriesMap) DeepCopyInto(out *EventCategoriesMap) {
+	*out = *in
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCategoriesMap.
+func (in *EventCategoriesMap) DeepCopy() *EventCategoriesMap {
+	if in == nil {
+		return nil
+	}
+	out := new(EventCategoriesMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription.
+func (in *EventSubscription) DeepCopy() *EventSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionList) DeepCopyInto(out *EventSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionList.
+func (in *EventSubscriptionList) DeepCopy() *EventSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionSpec) DeepCopyInto(out *EventSubscriptionSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EventCategories != nil {
+		in, out := &in.EventCategories, &out.EventCategories
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SNSTopicARN != nil {
+		in, out := &in.SNSTopicARN, &out.SNSTopicARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceIDs != nil {
+		in, out := &in.SourceIDs, &out.SourceIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SourceRefs != nil {
+		in, out := &in.SourceRefs, &out.SourceRefs
+		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.SourceType != nil {
+		in, out := &in.SourceType, &out.SourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionSpec.
+func (in *EventSubscriptionSpec) DeepCopy() *EventSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSubscriptionStatus) DeepCopyInto(out *EventSubscriptionStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.CustSubscriptionID != nil {
+		in, out := &in.CustSubscriptionID, &out.CustSubscriptionID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceARN != nil {
-		in, out := &in.SourceARN, &out.SourceARN
+	if in.CustomerAWSID != nil {
+		in, out := &in.CustomerAWSID, &out.CustomerAWSID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceIdentifier != nil {
-		in, out := &in.SourceIdentifier, &out.SourceIdentifier
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Event.
-func (in *Event) DeepCopy() *Event {
-	if in == nil {
-		return nil
-	}
-	out := new(Event)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventCategoriesMap) DeepCopyInto(out *EventCategoriesMap) {
-	*out = *in
-	if in.SourceType != nil {
-		in, out := &in.SourceType, &out.SourceType
+	if in.SubscriptionCreationTime != nil {
+		in, out := &in.SubscriptionCreationTime, &out.SubscriptionCreationTime
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventCategoriesMap.
-func (in *EventCategoriesMap) DeepCopy() *EventCategoriesMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscriptionStatus.
+func (in *EventSubscriptionStatus) DeepCopy() *EventSubscriptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EventCategoriesMap)
+	out := new(EventSubscriptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
+func (in *EventSubscription_SDK) DeepCopyInto(out *EventSubscription_SDK) {
 	*out = *in
 	if in.CustSubscriptionID != nil {
 		in, out := &in.CustSubscriptionID, &out.CustSubscriptionID
@@ -6369,12 +7559,12 @@ func (in *EventSubscription) DeepCopyInto(out *EventSubscription) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription.
-func (in *EventSubscription) DeepCopy() *EventSubscription {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSubscription_SDK.
+func (in *EventSubscription_SDK) DeepCopy() *EventSubscription_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(EventSubscription)
+	out := new(EventSubscription_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6480,6 +7670,11 @@ func (in *FailoverState) DeepCopyInto(out *FailoverState) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IsDataLossAllowed != nil {
+		in, out := &in.IsDataLossAllowed, &out.IsDataLossAllowed
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -6661,6 +7856,27 @@ func (in *GlobalClusterSpec) DeepCopyInto(out *GlobalClusterSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrimaryChangeMode != nil {
+		in, out := &in.PrimaryChangeMode, &out.PrimaryChangeMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrimaryDBClusterARN != nil {
+		in, out := &in.PrimaryDBClusterARN, &out.PrimaryDBClusterARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RemovedMemberARNs != nil {
+		in, out := &in.RemovedMemberARNs, &out.RemovedMemberARNs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.SourceDBClusterIdentifier != nil {
 		in, out := &in.SourceDBClusterIdentifier, &out.SourceDBClusterIdentifier
 		*out = new(string)
@@ -7128,6 +8344,17 @@ func (in *OptionConfiguration) DeepCopyInto(out *OptionConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionSettings != nil {
+		in, out := &in.OptionSettings, &out.OptionSettings
+		*out = make([]*OptionSetting, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OptionSetting)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.OptionVersion != nil {
 		in, out := &in.OptionVersion, &out.OptionVersion
 		*out = new(string)
@@ -7163,6 +8390,177 @@ func (in *OptionConfiguration) DeepCopy() *OptionConfiguration {
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
+func (in *OptionGroup) DeepCopy() *OptionGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupList) DeepCopyInto(out *OptionGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OptionGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupList.
+func (in *OptionGroupList) DeepCopy() *OptionGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OptionGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupSpec) DeepCopyInto(out *OptionGroupSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EngineName != nil {
+		in, out := &in.EngineName, &out.EngineName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MajorEngineVersion != nil {
+		in, out := &in.MajorEngineVersion, &out.MajorEngineVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]*OptionConfiguration, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(OptionConfiguration)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]*Tag, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Tag)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupSpec.
+func (in *OptionGroupSpec) DeepCopy() *OptionGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroupStatus) DeepCopyInto(out *OptionGroupStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.AllowsVPCAndNonVPCInstanceMemberships != nil {
+		in, out := &in.AllowsVPCAndNonVPCInstanceMemberships, &out.AllowsVPCAndNonVPCInstanceMemberships
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CopyTimestamp != nil {
+		in, out := &in.CopyTimestamp, &out.CopyTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.SourceAccountID != nil {
+		in, out := &in.SourceAccountID, &out.SourceAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceOptionGroup != nil {
+		in, out := &in.SourceOptionGroup, &out.SourceOptionGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroupStatus.
+func (in *OptionGroupStatus) DeepCopy() *OptionGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OptionGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OptionGroup_SDK) DeepCopyInto(out *OptionGroup_SDK) {
 	*out = *in
 	if in.AllowsVPCAndNonVPCInstanceMemberships != nil {
 		in, out := &in.AllowsVPCAndNonVPCInstanceMemberships, &out.AllowsVPCAndNonVPCInstanceMemberships
@@ -7215,12 +8613,12 @@ func (in *OptionGroup) DeepCopyInto(out *OptionGroup) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup.
-func (in *OptionGroup) DeepCopy() *OptionGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptionGroup_SDK.
+func (in *OptionGroup_SDK) DeepCopy() *OptionGroup_SDK {
 	if in == nil {
 		return nil
 	}
-	out := new(OptionGroup)
+	out := new(OptionGroup_SDK)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -8654,6 +10052,36 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverDetail) DeepCopyInto(out *SwitchoverDetail) {
+	*out = *in
+	if in.SourceMember != nil {
+		in, out := &in.SourceMember, &out.SourceMember
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetMember != nil {
+		in, out := &in.TargetMember, &out.TargetMember
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverDetail.
+func (in *SwitchoverDetail) DeepCopy() *SwitchoverDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -8687,6 +10115,16 @@ func (in *TargetHealth) DeepCopyInto(out *TargetHealth) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetHealth.
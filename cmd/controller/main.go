@@ -42,8 +42,14 @@ import (
 	ctrlrtwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	svctypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+	svcrequeue "github.com/aws-controllers-k8s/rds-controller/pkg/requeue"
 	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+	svcutil "github.com/aws-controllers-k8s/rds-controller/pkg/util"
 
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/blue_green_deployment"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster_endpoint"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_cluster_parameter_group"
@@ -51,9 +57,12 @@ import (
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_instance"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_parameter_group"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_proxy"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_proxy_endpoint"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_snapshot"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_subnet_group"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/event_subscription"
 	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/global_cluster"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/option_group"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/version"
 )
@@ -93,6 +102,12 @@ func init() {
 func main() {
 	var ackCfg ackcfg.Config
 	ackCfg.BindFlags()
+	var requeueCfg svcrequeue.Config
+	requeueCfg.BindFlags()
+	var rdsapiCfg rdsapi.Config
+	rdsapiCfg.BindFlags()
+	var tagCfg svcutil.TagConfig
+	tagCfg.BindFlags()
 	flag.Parse()
 	ackCfg.SetupLogger()
 
@@ -110,6 +125,31 @@ func main() {
 		)
 		os.Exit(1)
 	}
+	requeuePolicy, err := requeueCfg.NewPolicy()
+	if err != nil {
+		setupLog.Error(
+			err, "Unable to parse requeue flags.",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	rdsapiOpts, err := rdsapiCfg.NewClientOptions()
+	if err != nil {
+		setupLog.Error(
+			err, "Unable to parse RDS API flags.",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
+	rdsapi.SetDefaultClientOptions(rdsapiOpts)
+	tagReconciler, err := tagCfg.NewTagReconciler()
+	if err != nil {
+		setupLog.Error(
+			err, "Unable to parse tag flags.",
+			"aws.service", awsServiceAlias,
+		)
+		os.Exit(1)
+	}
 
 	host, port, err := ackrtutil.GetHostPort(ackCfg.WebhookServerAddr)
 	if err != nil {
@@ -201,6 +241,19 @@ func main() {
 	).WithPrometheusRegistry(
 		ctrlrtmetrics.Registry,
 	)
+	ctrlrtmetrics.Registry.MustRegister(svcrequeue.Collectors()...)
+	connectionWriter := connection.NewWriter(
+		mgr.GetClient(), mgr.GetAPIReader(), mgr.GetScheme(),
+	)
+	svcresource.SetDependencies(svcresource.Dependencies{
+		TagReconciler:    tagReconciler,
+		RequeuePolicy:    requeuePolicy,
+		ConnectionWriter: connectionWriter,
+		MasterUserSecretSyncers: secrets.NewSyncerFactory(
+			secrets.NewAWSBackend, connectionWriter,
+			mgr.GetEventRecorder(awsServiceAlias+"-controller"),
+		),
+	})
 
 	if ackCfg.EnableWebhookServer {
 		webhooks := ackrtwebhook.GetWebhooks()
@@ -57,9 +57,11 @@ rules:
   - configmaps
   - secrets
   verbs:
+  - create
   - get
   - list
   - patch
+  - update
   - watch
 - apiGroups:
   - ""
@@ -79,6 +81,13 @@ rules:
   verbs:
   - get
   - list
+- apiGroups:
+  - events.k8s.io
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
 - apiGroups:
   - iam.services.k8s.aws
   resources:
@@ -98,6 +107,7 @@ rules:
 - apiGroups:
   - rds.services.k8s.aws
   resources:
+  - bluegreendeployments
   - dbclusterendpoints
   - dbclusterparametergroups
   - dbclusters
@@ -105,9 +115,12 @@ rules:
   - dbinstances
   - dbparametergroups
   - dbproxies
+  - dbproxyendpoints
   - dbsnapshots
   - dbsubnetgroups
+  - eventsubscriptions
   - globalclusters
+  - optiongroups
   verbs:
   - create
   - delete
@@ -119,6 +132,7 @@ rules:
 - apiGroups:
   - rds.services.k8s.aws
   resources:
+  - bluegreendeployments/status
   - dbclusterendpoints/status
   - dbclusterparametergroups/status
   - dbclusters/status
@@ -126,9 +140,12 @@ rules:
   - dbinstances/status
   - dbparametergroups/status
   - dbproxies/status
+  - dbproxyendpoints/status
   - dbsnapshots/status
   - dbsubnetgroups/status
+  - eventsubscriptions/status
   - globalclusters/status
+  - optiongroups/status
   verbs:
   - get
   - patch
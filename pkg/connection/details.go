@@ -0,0 +1,269 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package connection publishes the connection details of DB instances and DB
+// clusters, like their endpoints, master user and URI, to a Secret or a
+// ConfigMap of their namespace, so that workloads don't have to assemble them
+// from several fields and secrets.
+package connection
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+const (
+	// KindSecret publishes the connection details to a Secret. It is the
+	// default kind.
+	KindSecret = "Secret"
+	// KindConfigMap publishes the connection details to a ConfigMap. The
+	// sensitive details can't be published to a ConfigMap.
+	KindConfigMap = "ConfigMap"
+)
+
+// The connection details that can be published. The name of a detail is also
+// its key in the default layout.
+const (
+	DetailEngine     = "engine"
+	DetailHost       = "host"
+	DetailPort       = "port"
+	DetailReaderHost = "readerHost"
+	DetailProxyHost  = "proxyHost"
+	DetailProxyPort  = "proxyPort"
+	DetailDatabase   = "database"
+	DetailUsername   = "username"
+	DetailPassword   = "password"
+	DetailURI        = "uri"
+	DetailReaderURI  = "readerURI"
+	DetailProxyURI   = "proxyURI"
+)
+
+// sensitiveDetails are the details that contain the master user password, and
+// are only published to Secrets.
+var sensitiveDetails = map[string]bool{
+	DetailPassword:  true,
+	DetailURI:       true,
+	DetailReaderURI: true,
+	DetailProxyURI:  true,
+}
+
+// details are all the connection details that can be published.
+var details = map[string]bool{
+	DetailEngine:     true,
+	DetailHost:       true,
+	DetailPort:       true,
+	DetailReaderHost: true,
+	DetailProxyHost:  true,
+	DetailProxyPort:  true,
+	DetailDatabase:   true,
+	DetailUsername:   true,
+	DetailPassword:   true,
+	DetailURI:        true,
+	DetailReaderURI:  true,
+	DetailProxyURI:   true,
+}
+
+// Details are the connection details of a DB instance or DB cluster. Empty
+// details aren't published.
+type Details struct {
+	Engine     string
+	Host       string
+	Port       int64
+	ReaderHost string
+	ProxyHost  string
+	ProxyPort  int64
+	Database   string
+	Username   string
+	Password   string
+}
+
+// Values returns the non-empty connection details, by detail name, including
+// the URIs of the engine, if it has a URI scheme.
+func (d *Details) Values() map[string]string {
+	values := map[string]string{
+		DetailEngine:     d.Engine,
+		DetailHost:       d.Host,
+		DetailReaderHost: d.ReaderHost,
+		DetailProxyHost:  d.ProxyHost,
+		DetailDatabase:   d.Database,
+		DetailUsername:   d.Username,
+		DetailPassword:   d.Password,
+		DetailURI:        d.uri(d.Host, d.Port),
+		DetailReaderURI:  d.uri(d.ReaderHost, d.Port),
+		DetailProxyURI:   d.uri(d.ProxyHost, d.ProxyPort),
+	}
+	if d.Port != 0 {
+		values[DetailPort] = strconv.FormatInt(d.Port, 10)
+	}
+	if d.ProxyPort != 0 {
+		values[DetailProxyPort] = strconv.FormatInt(d.ProxyPort, 10)
+	}
+	for detail, value := range values {
+		if value == "" {
+			delete(values, detail)
+		}
+	}
+	return values
+}
+
+// uri returns the URI to connect to the database of the DB instance or DB
+// cluster at the supplied host and port as the master user, or an empty string
+// if the host is unknown or the engine has no URI scheme.
+func (d *Details) uri(host string, port int64) string {
+	scheme := URIScheme(d.Engine)
+	if host == "" || scheme == "" {
+		return ""
+	}
+	if port == 0 {
+		port = DefaultPort(d.Engine)
+	}
+	u := &url.URL{
+		Scheme: scheme,
+		Host:   net.JoinHostPort(host, strconv.FormatInt(port, 10)),
+	}
+	if d.Username != "" {
+		if d.Password != "" {
+			u.User = url.UserPassword(d.Username, d.Password)
+		} else {
+			u.User = url.User(d.Username)
+		}
+	}
+	if d.Database != "" {
+		// SQL Server drivers take the database as a query parameter, the
+		// other drivers as the path.
+		if scheme == "sqlserver" {
+			u.RawQuery = url.Values{"database": {d.Database}}.Encode()
+		} else {
+			u.Path = "/" + d.Database
+		}
+	}
+	return u.String()
+}
+
+// URIScheme returns the scheme of the URIs of the supplied engine, or of the
+// supplied DB proxy engine family, or an empty string if the engine is
+// unknown.
+func URIScheme(engine string) string {
+	engine = strings.ToLower(engine)
+	switch {
+	case strings.Contains(engine, "postgres"):
+		return "postgresql"
+	case strings.Contains(engine, "mysql"), engine == "mariadb":
+		return "mysql"
+	case strings.HasPrefix(engine, "sqlserver"):
+		return "sqlserver"
+	case strings.HasPrefix(engine, "oracle"):
+		return "oracle"
+	case strings.HasPrefix(engine, "db2"):
+		return "db2"
+	}
+	return ""
+}
+
+// DefaultPort returns the default port of the supplied engine, or of the
+// supplied DB proxy engine family, which is also the port DB proxies listen
+// on, or 0 if the engine is unknown.
+func DefaultPort(engine string) int64 {
+	switch URIScheme(engine) {
+	case "postgresql":
+		return 5432
+	case "mysql":
+		return 3306
+	case "sqlserver":
+		return 1433
+	case "oracle":
+		return 1521
+	case "db2":
+		return 50000
+	}
+	return 0
+}
+
+// Data returns the data of the Secret or ConfigMap of the supplied kind to
+// publish the supplied connection details to, using the supplied layout.
+//
+// The layout maps the name of each detail to publish to its key. A detail with
+// an empty key is published under its name. When the layout is empty, every
+// detail is published under its name, except the sensitive details if the
+// kind is ConfigMap. The details that are unknown, like the reader host of a
+// DB instance, aren't published.
+//
+// Returns a terminal error if the kind or the layout is invalid.
+func Data(
+	kind string,
+	layout map[string]*string,
+	values map[string]string,
+) (map[string]string, error) {
+	if kind != KindSecret && kind != KindConfigMap {
+		return nil, ackerr.NewTerminalError(fmt.Errorf(
+			"invalid connection details kind %q: must be %s or %s",
+			kind, KindSecret, KindConfigMap,
+		))
+	}
+	keys := map[string]string{}
+	if len(layout) == 0 {
+		for detail := range details {
+			if kind == KindSecret || !sensitiveDetails[detail] {
+				keys[detail] = detail
+			}
+		}
+	}
+	for detail, key := range layout {
+		if !details[detail] {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"invalid connection detail %q: must be one of %s",
+				detail, strings.Join(detailNames(), ", "),
+			))
+		}
+		if kind == KindConfigMap && sensitiveDetails[detail] {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"invalid connection detail %q: can only be published to a %s",
+				detail, KindSecret,
+			))
+		}
+		keys[detail] = detail
+		if key != nil && *key != "" {
+			keys[detail] = *key
+		}
+	}
+	used := map[string]bool{}
+	data := map[string]string{}
+	for detail, key := range keys {
+		if used[key] {
+			return nil, ackerr.NewTerminalError(fmt.Errorf(
+				"invalid connection details layout: key %q is used more than once", key,
+			))
+		}
+		used[key] = true
+		if value, ok := values[detail]; ok {
+			data[key] = value
+		}
+	}
+	return data, nil
+}
+
+// detailNames returns the sorted names of the connection details.
+func detailNames() []string {
+	names := make([]string, 0, len(details))
+	for detail := range details {
+		names = append(names, detail)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,213 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=create;update
+
+// Spec is the connection details section of the spec of a DBInstance or
+// DBCluster.
+type Spec struct {
+	// Name is the name of the Secret or ConfigMap. The connection details
+	// are only published when it is set.
+	Name *string
+	// Kind is either KindSecret or KindConfigMap. Defaults to KindSecret.
+	Kind *string
+	// Keys is the layout of the data, see Data.
+	Keys map[string]*string
+	// DBProxyName is the name of the DBProxy resource, in the same
+	// namespace, whose endpoint is published as the proxy details.
+	DBProxyName *string
+}
+
+// Writer publishes connection details to the Secrets and ConfigMaps of the
+// namespaces of the resources they belong to. The Secrets and ConfigMaps are
+// controlled by the resources, so that they are deleted with them.
+type Writer struct {
+	client client.Client
+	// apiReader reads directly from the API server, so that the controller
+	// doesn't cache every Secret and ConfigMap of the cluster.
+	apiReader client.Reader
+	scheme    *runtime.Scheme
+}
+
+// NewWriter returns a Writer that writes with the supplied client, reads with
+// the supplied reader, and looks up the kinds of the resources in the
+// supplied scheme.
+func NewWriter(
+	client client.Client,
+	apiReader client.Reader,
+	scheme *runtime.Scheme,
+) *Writer {
+	return &Writer{
+		client:    client,
+		apiReader: apiReader,
+		scheme:    scheme,
+	}
+}
+
+// Publish writes the supplied connection details of the owner to the Secret
+// or ConfigMap of the supplied spec, if it has a name. The proxy details are
+// read from the DBProxy resource of the spec. The Secret or ConfigMap is only
+// updated when its data changes.
+func (w *Writer) Publish(
+	ctx context.Context,
+	owner client.Object,
+	spec Spec,
+	details Details,
+) error {
+	if aws.ToString(spec.Name) == "" {
+		return nil
+	}
+	kind := KindSecret
+	if spec.Kind != nil {
+		kind = *spec.Kind
+	}
+	if spec.DBProxyName != nil {
+		proxy, err := w.getDBProxy(ctx, owner.GetNamespace(), *spec.DBProxyName)
+		if err != nil {
+			return err
+		}
+		details.ProxyHost = *proxy.Status.Endpoint
+		details.ProxyPort = DefaultPort(aws.ToString(proxy.Spec.EngineFamily))
+	}
+	data, err := Data(kind, spec.Keys, details.Values())
+	if err != nil {
+		return err
+	}
+	return w.Write(ctx, owner, kind, *spec.Name, data)
+}
+
+// getDBProxy returns the DBProxy resource with the supplied namespace and
+// name, or a requeue error until it has an endpoint.
+func (w *Writer) getDBProxy(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*svcapitypes.DBProxy, error) {
+	proxy := &svcapitypes.DBProxy{}
+	err := w.apiReader.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}, proxy)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || proxy.Status.Endpoint == nil {
+		return nil, ackrequeue.NeededAfter(
+			fmt.Errorf("DBProxy %s/%s has no endpoint yet", namespace, name),
+			ackrequeue.DefaultRequeueAfterDuration,
+		)
+	}
+	return proxy, nil
+}
+
+// Write creates or updates the Secret or ConfigMap of the supplied kind and
+// name, in the namespace of the owner, so that it has the supplied data and
+// is controlled by the owner. Returns an error if the Secret or ConfigMap
+// exists but isn't controlled by the owner, so that data created by someone
+// else is never overwritten. Nothing is written when the Secret or ConfigMap
+// already has the supplied data.
+func (w *Writer) Write(
+	ctx context.Context,
+	owner client.Object,
+	kind string,
+	name string,
+	data map[string]string,
+) error {
+	rlog := ackrtlog.FromContext(ctx)
+	obj, err := newObject(kind, owner.GetNamespace(), name)
+	if err != nil {
+		return err
+	}
+	err = w.apiReader.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if apierrors.IsNotFound(err) {
+		setData(obj, data)
+		if err := controllerutil.SetControllerReference(owner, obj, w.scheme); err != nil {
+			return err
+		}
+		rlog.Debug("creating connection details", "kind", kind, "name", name)
+		return w.client.Create(ctx, obj)
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(obj, owner) {
+		return fmt.Errorf(
+			"%s %s/%s already exists and is not controlled by %s",
+			kind, owner.GetNamespace(), name, owner.GetName(),
+		)
+	}
+	if !setData(obj, data) {
+		return nil
+	}
+	rlog.Debug("updating connection details", "kind", kind, "name", name)
+	return w.client.Update(ctx, obj)
+}
+
+// newObject returns an empty Secret or ConfigMap of the supplied kind.
+func newObject(kind string, namespace string, name string) (client.Object, error) {
+	meta := metav1.ObjectMeta{
+		Namespace: namespace,
+		Name:      name,
+	}
+	switch kind {
+	case KindSecret:
+		return &corev1.Secret{ObjectMeta: meta}, nil
+	case KindConfigMap:
+		return &corev1.ConfigMap{ObjectMeta: meta}, nil
+	}
+	return nil, fmt.Errorf("invalid connection details kind %q", kind)
+}
+
+// setData sets the data of the supplied Secret or ConfigMap. Returns true if
+// the data changed.
+func setData(obj client.Object, data map[string]string) bool {
+	switch obj := obj.(type) {
+	case *corev1.Secret:
+		secretData := make(map[string][]byte, len(data))
+		for key, value := range data {
+			secretData[key] = []byte(value)
+		}
+		if len(obj.Data) == 0 && len(secretData) == 0 || reflect.DeepEqual(obj.Data, secretData) {
+			return false
+		}
+		obj.Data = secretData
+		obj.StringData = nil
+	case *corev1.ConfigMap:
+		if len(obj.Data) == 0 && len(data) == 0 || reflect.DeepEqual(obj.Data, data) {
+			return false
+		}
+		obj.Data = data
+	}
+	return true
+}
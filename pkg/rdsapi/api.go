@@ -0,0 +1,205 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package rdsapi contains the subset of the RDS API that the resource
+// managers in this controller depend on.
+//
+// Resource managers hold an API instead of a concrete *svcsdk.Client so that
+// the hand-written logic in each resource package (hooks, custom update
+// methods, parameter and tag syncing) can be exercised against an in-memory
+// backend such as the one in the rdsapi/fake package.
+package rdsapi
+
+import (
+	"context"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// API is the set of RDS API operations called by the resource managers.
+//
+// When a resource manager needs to call a new RDS operation, add the method
+// here with the same signature as the corresponding *svcsdk.Client method
+// and teach the fake backend about it.
+type API interface {
+	// Tagging
+	AddTagsToResource(ctx context.Context, params *svcsdk.AddTagsToResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddTagsToResourceOutput, error)
+	ListTagsForResource(ctx context.Context, params *svcsdk.ListTagsForResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ListTagsForResourceOutput, error)
+	RemoveTagsFromResource(ctx context.Context, params *svcsdk.RemoveTagsFromResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveTagsFromResourceOutput, error)
+
+	// BlueGreenDeployment
+	CreateBlueGreenDeployment(ctx context.Context, params *svcsdk.CreateBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateBlueGreenDeploymentOutput, error)
+	DeleteBlueGreenDeployment(ctx context.Context, params *svcsdk.DeleteBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteBlueGreenDeploymentOutput, error)
+	DescribeBlueGreenDeployments(ctx context.Context, params *svcsdk.DescribeBlueGreenDeploymentsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeBlueGreenDeploymentsOutput, error)
+	SwitchoverBlueGreenDeployment(ctx context.Context, params *svcsdk.SwitchoverBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.SwitchoverBlueGreenDeploymentOutput, error)
+
+	// DBCluster
+	AddRoleToDBCluster(ctx context.Context, params *svcsdk.AddRoleToDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddRoleToDBClusterOutput, error)
+	CreateDBCluster(ctx context.Context, params *svcsdk.CreateDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterOutput, error)
+	DeleteDBCluster(ctx context.Context, params *svcsdk.DeleteDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterOutput, error)
+	DescribeDBClusters(ctx context.Context, params *svcsdk.DescribeDBClustersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClustersOutput, error)
+	FailoverDBCluster(ctx context.Context, params *svcsdk.FailoverDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.FailoverDBClusterOutput, error)
+	ModifyDBCluster(ctx context.Context, params *svcsdk.ModifyDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterOutput, error)
+	RebootDBCluster(ctx context.Context, params *svcsdk.RebootDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RebootDBClusterOutput, error)
+	RemoveRoleFromDBCluster(ctx context.Context, params *svcsdk.RemoveRoleFromDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveRoleFromDBClusterOutput, error)
+	RestoreDBClusterFromSnapshot(ctx context.Context, params *svcsdk.RestoreDBClusterFromSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBClusterFromSnapshotOutput, error)
+	RestoreDBClusterToPointInTime(ctx context.Context, params *svcsdk.RestoreDBClusterToPointInTimeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBClusterToPointInTimeOutput, error)
+	StartDBCluster(ctx context.Context, params *svcsdk.StartDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StartDBClusterOutput, error)
+	StopDBCluster(ctx context.Context, params *svcsdk.StopDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StopDBClusterOutput, error)
+
+	// DBClusterEndpoint
+	CreateDBClusterEndpoint(ctx context.Context, params *svcsdk.CreateDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterEndpointOutput, error)
+	DeleteDBClusterEndpoint(ctx context.Context, params *svcsdk.DeleteDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterEndpointOutput, error)
+	DescribeDBClusterEndpoints(ctx context.Context, params *svcsdk.DescribeDBClusterEndpointsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterEndpointsOutput, error)
+	ModifyDBClusterEndpoint(ctx context.Context, params *svcsdk.ModifyDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterEndpointOutput, error)
+
+	// DBClusterParameterGroup
+	CreateDBClusterParameterGroup(ctx context.Context, params *svcsdk.CreateDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterParameterGroupOutput, error)
+	DeleteDBClusterParameterGroup(ctx context.Context, params *svcsdk.DeleteDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterParameterGroupOutput, error)
+	DescribeDBClusterParameterGroups(ctx context.Context, params *svcsdk.DescribeDBClusterParameterGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterParameterGroupsOutput, error)
+	DescribeDBClusterParameters(ctx context.Context, params *svcsdk.DescribeDBClusterParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterParametersOutput, error)
+	DescribeEngineDefaultClusterParameters(ctx context.Context, params *svcsdk.DescribeEngineDefaultClusterParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEngineDefaultClusterParametersOutput, error)
+	ModifyDBClusterParameterGroup(ctx context.Context, params *svcsdk.ModifyDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterParameterGroupOutput, error)
+	ResetDBClusterParameterGroup(ctx context.Context, params *svcsdk.ResetDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ResetDBClusterParameterGroupOutput, error)
+
+	// DBClusterSnapshot
+	CopyDBClusterSnapshot(ctx context.Context, params *svcsdk.CopyDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CopyDBClusterSnapshotOutput, error)
+	CreateDBClusterSnapshot(ctx context.Context, params *svcsdk.CreateDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterSnapshotOutput, error)
+	DeleteDBClusterSnapshot(ctx context.Context, params *svcsdk.DeleteDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterSnapshotOutput, error)
+	DescribeDBClusterSnapshotAttributes(ctx context.Context, params *svcsdk.DescribeDBClusterSnapshotAttributesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterSnapshotAttributesOutput, error)
+	DescribeDBClusterSnapshots(ctx context.Context, params *svcsdk.DescribeDBClusterSnapshotsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterSnapshotsOutput, error)
+	ModifyDBClusterSnapshotAttribute(ctx context.Context, params *svcsdk.ModifyDBClusterSnapshotAttributeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterSnapshotAttributeOutput, error)
+
+	// DBInstance
+	AddRoleToDBInstance(ctx context.Context, params *svcsdk.AddRoleToDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddRoleToDBInstanceOutput, error)
+	CreateDBInstance(ctx context.Context, params *svcsdk.CreateDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBInstanceOutput, error)
+	CreateDBInstanceReadReplica(ctx context.Context, params *svcsdk.CreateDBInstanceReadReplicaInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBInstanceReadReplicaOutput, error)
+	DeleteDBInstance(ctx context.Context, params *svcsdk.DeleteDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBInstanceOutput, error)
+	DescribeDBInstances(ctx context.Context, params *svcsdk.DescribeDBInstancesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBInstancesOutput, error)
+	ModifyDBInstance(ctx context.Context, params *svcsdk.ModifyDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBInstanceOutput, error)
+	RebootDBInstance(ctx context.Context, params *svcsdk.RebootDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RebootDBInstanceOutput, error)
+	RemoveRoleFromDBInstance(ctx context.Context, params *svcsdk.RemoveRoleFromDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveRoleFromDBInstanceOutput, error)
+	RestoreDBInstanceFromDBSnapshot(ctx context.Context, params *svcsdk.RestoreDBInstanceFromDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBInstanceFromDBSnapshotOutput, error)
+	RestoreDBInstanceToPointInTime(ctx context.Context, params *svcsdk.RestoreDBInstanceToPointInTimeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBInstanceToPointInTimeOutput, error)
+	StartDBInstance(ctx context.Context, params *svcsdk.StartDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StartDBInstanceOutput, error)
+	StopDBInstance(ctx context.Context, params *svcsdk.StopDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StopDBInstanceOutput, error)
+
+	// DBParameterGroup
+	CreateDBParameterGroup(ctx context.Context, params *svcsdk.CreateDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBParameterGroupOutput, error)
+	DeleteDBParameterGroup(ctx context.Context, params *svcsdk.DeleteDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBParameterGroupOutput, error)
+	DescribeDBParameterGroups(ctx context.Context, params *svcsdk.DescribeDBParameterGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBParameterGroupsOutput, error)
+	DescribeDBParameters(ctx context.Context, params *svcsdk.DescribeDBParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBParametersOutput, error)
+	DescribeEngineDefaultParameters(ctx context.Context, params *svcsdk.DescribeEngineDefaultParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEngineDefaultParametersOutput, error)
+	ModifyDBParameterGroup(ctx context.Context, params *svcsdk.ModifyDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBParameterGroupOutput, error)
+	ResetDBParameterGroup(ctx context.Context, params *svcsdk.ResetDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ResetDBParameterGroupOutput, error)
+
+	// DBProxy
+	CreateDBProxy(ctx context.Context, params *svcsdk.CreateDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBProxyOutput, error)
+	DeleteDBProxy(ctx context.Context, params *svcsdk.DeleteDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBProxyOutput, error)
+	DeregisterDBProxyTargets(ctx context.Context, params *svcsdk.DeregisterDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeregisterDBProxyTargetsOutput, error)
+	DescribeDBProxies(ctx context.Context, params *svcsdk.DescribeDBProxiesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxiesOutput, error)
+	DescribeDBProxyTargetGroups(ctx context.Context, params *svcsdk.DescribeDBProxyTargetGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyTargetGroupsOutput, error)
+	DescribeDBProxyTargets(ctx context.Context, params *svcsdk.DescribeDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyTargetsOutput, error)
+	ModifyDBProxy(ctx context.Context, params *svcsdk.ModifyDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyOutput, error)
+	ModifyDBProxyTargetGroup(ctx context.Context, params *svcsdk.ModifyDBProxyTargetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyTargetGroupOutput, error)
+	RegisterDBProxyTargets(ctx context.Context, params *svcsdk.RegisterDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RegisterDBProxyTargetsOutput, error)
+
+	// DBProxyEndpoint
+	CreateDBProxyEndpoint(ctx context.Context, params *svcsdk.CreateDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBProxyEndpointOutput, error)
+	DeleteDBProxyEndpoint(ctx context.Context, params *svcsdk.DeleteDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBProxyEndpointOutput, error)
+	DescribeDBProxyEndpoints(ctx context.Context, params *svcsdk.DescribeDBProxyEndpointsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyEndpointsOutput, error)
+	ModifyDBProxyEndpoint(ctx context.Context, params *svcsdk.ModifyDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyEndpointOutput, error)
+
+	// DBSnapshot
+	CopyDBSnapshot(ctx context.Context, params *svcsdk.CopyDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CopyDBSnapshotOutput, error)
+	CreateDBSnapshot(ctx context.Context, params *svcsdk.CreateDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBSnapshotOutput, error)
+	DeleteDBSnapshot(ctx context.Context, params *svcsdk.DeleteDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBSnapshotOutput, error)
+	DescribeDBSnapshotAttributes(ctx context.Context, params *svcsdk.DescribeDBSnapshotAttributesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSnapshotAttributesOutput, error)
+	DescribeDBSnapshots(ctx context.Context, params *svcsdk.DescribeDBSnapshotsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSnapshotsOutput, error)
+	ModifyDBSnapshot(ctx context.Context, params *svcsdk.ModifyDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSnapshotOutput, error)
+	ModifyDBSnapshotAttribute(ctx context.Context, params *svcsdk.ModifyDBSnapshotAttributeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSnapshotAttributeOutput, error)
+
+	// DBSubnetGroup
+	CreateDBSubnetGroup(ctx context.Context, params *svcsdk.CreateDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBSubnetGroupOutput, error)
+	DeleteDBSubnetGroup(ctx context.Context, params *svcsdk.DeleteDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBSubnetGroupOutput, error)
+	DescribeDBSubnetGroups(ctx context.Context, params *svcsdk.DescribeDBSubnetGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSubnetGroupsOutput, error)
+	ModifyDBSubnetGroup(ctx context.Context, params *svcsdk.ModifyDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSubnetGroupOutput, error)
+
+	// EventSubscription
+	AddSourceIdentifierToSubscription(ctx context.Context, params *svcsdk.AddSourceIdentifierToSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddSourceIdentifierToSubscriptionOutput, error)
+	CreateEventSubscription(ctx context.Context, params *svcsdk.CreateEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateEventSubscriptionOutput, error)
+	DeleteEventSubscription(ctx context.Context, params *svcsdk.DeleteEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteEventSubscriptionOutput, error)
+	DescribeEventSubscriptions(ctx context.Context, params *svcsdk.DescribeEventSubscriptionsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEventSubscriptionsOutput, error)
+	ModifyEventSubscription(ctx context.Context, params *svcsdk.ModifyEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyEventSubscriptionOutput, error)
+	RemoveSourceIdentifierFromSubscription(ctx context.Context, params *svcsdk.RemoveSourceIdentifierFromSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveSourceIdentifierFromSubscriptionOutput, error)
+
+	// GlobalCluster
+	CreateGlobalCluster(ctx context.Context, params *svcsdk.CreateGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateGlobalClusterOutput, error)
+	DeleteGlobalCluster(ctx context.Context, params *svcsdk.DeleteGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteGlobalClusterOutput, error)
+	DescribeGlobalClusters(ctx context.Context, params *svcsdk.DescribeGlobalClustersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeGlobalClustersOutput, error)
+	FailoverGlobalCluster(ctx context.Context, params *svcsdk.FailoverGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.FailoverGlobalClusterOutput, error)
+	ModifyGlobalCluster(ctx context.Context, params *svcsdk.ModifyGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyGlobalClusterOutput, error)
+	RemoveFromGlobalCluster(ctx context.Context, params *svcsdk.RemoveFromGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveFromGlobalClusterOutput, error)
+	SwitchoverGlobalCluster(ctx context.Context, params *svcsdk.SwitchoverGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.SwitchoverGlobalClusterOutput, error)
+
+	// OptionGroup
+	CreateOptionGroup(ctx context.Context, params *svcsdk.CreateOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateOptionGroupOutput, error)
+	DeleteOptionGroup(ctx context.Context, params *svcsdk.DeleteOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteOptionGroupOutput, error)
+	DescribeOptionGroups(ctx context.Context, params *svcsdk.DescribeOptionGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeOptionGroupsOutput, error)
+	ModifyOptionGroup(ctx context.Context, params *svcsdk.ModifyOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyOptionGroupOutput, error)
+}
+
+// Ensure the aws-sdk-go-v2 RDS client satisfies API.
+var _ API = (*svcsdk.Client)(nil)
+
+// ClientConstructor returns an API for the supplied AWS client
+// configuration and AWS account, which records its throttled calls with the
+// supplied metrics. Resource manager factories use it to build the API that
+// each resource manager is handed.
+type ClientConstructor func(
+	cfg aws.Config,
+	accountID ackv1alpha1.AWSAccountID,
+	metrics util.APICallRecorder,
+) API
+
+// NewFromConfig returns an API backed by the aws-sdk-go-v2 RDS client for
+// the supplied AWS client configuration.
+//
+// The calls of the API wait on the rate limiter of the default
+// ClientOptions, which the APIs of every resource manager of the AWS account
+// and region share, and its reads of single DB instances and DB clusters made
+// while other reads are in flight are batched, if the coalescing window isn't
+// zero. The calls the rate limiter delays and the calls RDS throttles are
+// recorded with the supplied metrics, see OpTypeRateLimited and
+// OpTypeThrottled.
+func NewFromConfig(
+	cfg aws.Config,
+	accountID ackv1alpha1.AWSAccountID,
+	metrics util.APICallRecorder,
+) API {
+	opts := DefaultClientOptions()
+	scope := string(accountID) + "/" + cfg.Region
+	var api API = svcsdk.NewFromConfig(cfg, func(o *svcsdk.Options) {
+		if opts.RateLimiter != nil {
+			o.APIOptions = append(o.APIOptions, opts.RateLimiter.middleware(scope, metrics))
+		}
+	})
+	if opts.CoalescingWindow > 0 {
+		api = newCoalescingAPI(api, opts.CoalescingWindow)
+	}
+	return api
+}
@@ -0,0 +1,316 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+// DefaultCoalescingWindow is how long the reads of single DB instances or DB
+// clusters made while other reads are in flight wait for them before being
+// read in a batch. Batching is off by default.
+const DefaultCoalescingWindow time.Duration = 0
+
+// maxBatchSize is the maximum number of identifiers read in a single batch,
+// which is also the MaxRecords of each page of the batched Describe calls.
+const maxBatchSize = 100
+
+// coalescingAPI is an API that batches the reads of single DB instances and
+// DB clusters made while other reads are in flight into paginated Describe
+// calls filtered on the identifiers of the batch.
+//
+// A read made while no other read is in flight, a read that is alone in its
+// batch and a read whose batch fails are made on their own, like they would
+// be without batching. Reads with other parameters than the identifier
+// aren't batched.
+type coalescingAPI struct {
+	API
+	instances *batcher[svcsdktypes.DBInstance]
+	clusters  *batcher[svcsdktypes.DBCluster]
+}
+
+// newCoalescingAPI returns an API that batches the reads of api, waiting at
+// most the supplied window for the reads in flight.
+func newCoalescingAPI(api API, window time.Duration) *coalescingAPI {
+	return &coalescingAPI{
+		API:       api,
+		instances: newBatcher[svcsdktypes.DBInstance](window),
+		clusters:  newBatcher[svcsdktypes.DBCluster](window),
+	}
+}
+
+func (c *coalescingAPI) DescribeDBInstances(
+	ctx context.Context,
+	params *svcsdk.DescribeDBInstancesInput,
+	optFns ...func(*svcsdk.Options),
+) (*svcsdk.DescribeDBInstancesOutput, error) {
+	if params.DBInstanceIdentifier == nil || len(params.Filters) > 0 ||
+		params.Marker != nil || params.MaxRecords != nil || len(optFns) > 0 {
+		return c.API.DescribeDBInstances(ctx, params, optFns...)
+	}
+	id := *params.DBInstanceIdentifier
+	var out *svcsdk.DescribeDBInstancesOutput
+	var err error
+	instance, found, batched := c.instances.get(ctx, id, c.describeDBInstances, func() {
+		out, err = c.API.DescribeDBInstances(ctx, params)
+	})
+	if !batched {
+		return out, err
+	}
+	if !found {
+		return nil, &svcsdktypes.DBInstanceNotFoundFault{
+			Message: aws.String("DBInstance " + id + " not found."),
+		}
+	}
+	return &svcsdk.DescribeDBInstancesOutput{
+		DBInstances: []svcsdktypes.DBInstance{instance},
+	}, nil
+}
+
+// describeDBInstances returns the DB instances with the supplied
+// identifiers, by identifier.
+func (c *coalescingAPI) describeDBInstances(
+	ctx context.Context,
+	ids []string,
+) (map[string]svcsdktypes.DBInstance, error) {
+	instances := map[string]svcsdktypes.DBInstance{}
+	input := &svcsdk.DescribeDBInstancesInput{
+		Filters: []svcsdktypes.Filter{
+			{Name: aws.String("db-instance-id"), Values: ids},
+		},
+		MaxRecords: aws.Int32(maxBatchSize),
+	}
+	for {
+		resp, err := c.API.DescribeDBInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range resp.DBInstances {
+			instances[strings.ToLower(aws.ToString(instance.DBInstanceIdentifier))] = instance
+		}
+		if resp.Marker == nil {
+			return instances, nil
+		}
+		input.Marker = resp.Marker
+	}
+}
+
+func (c *coalescingAPI) DescribeDBClusters(
+	ctx context.Context,
+	params *svcsdk.DescribeDBClustersInput,
+	optFns ...func(*svcsdk.Options),
+) (*svcsdk.DescribeDBClustersOutput, error) {
+	if params.DBClusterIdentifier == nil || len(params.Filters) > 0 ||
+		params.Marker != nil || params.MaxRecords != nil ||
+		params.IncludeShared != nil || len(optFns) > 0 {
+		return c.API.DescribeDBClusters(ctx, params, optFns...)
+	}
+	id := *params.DBClusterIdentifier
+	var out *svcsdk.DescribeDBClustersOutput
+	var err error
+	cluster, found, batched := c.clusters.get(ctx, id, c.describeDBClusters, func() {
+		out, err = c.API.DescribeDBClusters(ctx, params)
+	})
+	if !batched {
+		return out, err
+	}
+	if !found {
+		return nil, &svcsdktypes.DBClusterNotFoundFault{
+			Message: aws.String("DBCluster " + id + " not found."),
+		}
+	}
+	return &svcsdk.DescribeDBClustersOutput{
+		DBClusters: []svcsdktypes.DBCluster{cluster},
+	}, nil
+}
+
+// describeDBClusters returns the DB clusters with the supplied identifiers,
+// by identifier.
+func (c *coalescingAPI) describeDBClusters(
+	ctx context.Context,
+	ids []string,
+) (map[string]svcsdktypes.DBCluster, error) {
+	clusters := map[string]svcsdktypes.DBCluster{}
+	input := &svcsdk.DescribeDBClustersInput{
+		Filters: []svcsdktypes.Filter{
+			{Name: aws.String("db-cluster-id"), Values: ids},
+		},
+		MaxRecords: aws.Int32(maxBatchSize),
+	}
+	for {
+		resp, err := c.API.DescribeDBClusters(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range resp.DBClusters {
+			clusters[strings.ToLower(aws.ToString(cluster.DBClusterIdentifier))] = cluster
+		}
+		if resp.Marker == nil {
+			return clusters, nil
+		}
+		input.Marker = resp.Marker
+	}
+}
+
+// batcher batches the reads of items by identifier made while other reads
+// are in flight.
+type batcher[T any] struct {
+	window time.Duration
+
+	mu sync.Mutex
+	// inFlight is the number of reads in flight, on their own or in a batch.
+	inFlight int
+	// idle is closed once no read is in flight anymore. It is nil while no
+	// read is in flight.
+	idle chan struct{}
+	// pending is the batch that collects identifiers, if any.
+	pending *batch[T]
+}
+
+// batch is a set of identifiers read together.
+type batch[T any] struct {
+	ids []string
+	// done is closed once the batch is read.
+	done chan struct{}
+	// items contains the items of the batch, by identifier, or is nil if
+	// the batch wasn't read.
+	items map[string]T
+}
+
+func newBatcher[T any](window time.Duration) *batcher[T] {
+	return &batcher[T]{window: window}
+}
+
+// get returns the item with the supplied identifier, read with describe
+// together with the other items requested while reads were in flight. found
+// is false if the item doesn't exist. batched is false if the item wasn't
+// read in a batch, in which case get made the caller's own read with single.
+//
+// A get made while no read is in flight makes its own read right away. The
+// gets made while reads are in flight join a batch, which the first get of
+// the batch reads for every get of the batch once the reads in flight are
+// done or the window ends, whichever comes first. A batch of a single item
+// isn't read, and neither is the item of a batch that failed: they make
+// their own read.
+func (b *batcher[T]) get(
+	ctx context.Context,
+	id string,
+	describe func(context.Context, []string) (map[string]T, error),
+	single func(),
+) (item T, found bool, batched bool) {
+	// Items are only indexed by identifier, not by ARN.
+	if arn.IsARN(id) {
+		b.do(single)
+		return item, false, false
+	}
+	// RDS identifiers are lowercase, whatever the case they are created
+	// with.
+	id = strings.ToLower(id)
+
+	b.mu.Lock()
+	if b.inFlight == 0 && b.pending == nil {
+		b.startLocked()
+		b.mu.Unlock()
+		defer b.end()
+		single()
+		return item, false, false
+	}
+	bt := b.pending
+	leader := bt == nil
+	if leader {
+		bt = &batch[T]{done: make(chan struct{})}
+		b.pending = bt
+	}
+	if !slices.Contains(bt.ids, id) {
+		bt.ids = append(bt.ids, id)
+	}
+	if len(bt.ids) >= maxBatchSize {
+		b.pending = nil
+	}
+	idle := b.idle
+	b.mu.Unlock()
+
+	if !leader {
+		select {
+		case <-bt.done:
+		case <-ctx.Done():
+			b.do(single)
+			return item, false, false
+		}
+	} else {
+		timer := time.NewTimer(b.window)
+		defer timer.Stop()
+		select {
+		case <-idle:
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+		b.mu.Lock()
+		if b.pending == bt {
+			b.pending = nil
+		}
+		b.mu.Unlock()
+		if len(bt.ids) > 1 {
+			b.do(func() {
+				// The batch is read for every get of the batch, so it
+				// isn't canceled with the context of the first one.
+				bt.items, _ = describe(context.WithoutCancel(ctx), bt.ids)
+			})
+		}
+		close(bt.done)
+	}
+	if bt.items == nil {
+		b.do(single)
+		return item, false, false
+	}
+	item, found = bt.items[id]
+	return item, found, true
+}
+
+// do makes the supplied read, which is in flight until it returns.
+func (b *batcher[T]) do(read func()) {
+	b.mu.Lock()
+	b.startLocked()
+	b.mu.Unlock()
+	defer b.end()
+	read()
+}
+
+// startLocked marks a read as in flight. b.mu must be held.
+func (b *batcher[T]) startLocked() {
+	if b.inFlight == 0 {
+		b.idle = make(chan struct{})
+	}
+	b.inFlight++
+}
+
+// end marks a read as done.
+func (b *batcher[T]) end() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.inFlight--
+	if b.inFlight == 0 {
+		close(b.idle)
+		b.idle = nil
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+// testWindow is long enough for the batches of the tests to only be read
+// once the reads in flight are done.
+const testWindow = 10 * time.Second
+
+func newFakeWithInstances(ids ...string) *fake.RDS {
+	api := fake.New()
+	for _, id := range ids {
+		api.DBInstances[id] = &svcsdktypes.DBInstance{
+			DBInstanceIdentifier: aws.String(id),
+			DBInstanceStatus:     aws.String(fake.StatusAvailable),
+		}
+	}
+	return api
+}
+
+// blockFirstCall makes the first call of the supplied operation wait until
+// release is called. started is closed once that call is in flight.
+func blockFirstCall(
+	backend *fake.RDS,
+	operation string,
+) (started <-chan struct{}, release func()) {
+	startedCh := make(chan struct{})
+	releaseCh := make(chan struct{})
+	var once sync.Once
+	hook := backend.Hooks[operation]
+	backend.Hooks[operation] = func(input any) error {
+		once.Do(func() {
+			close(startedCh)
+			<-releaseCh
+		})
+		if hook != nil {
+			return hook(input)
+		}
+		return nil
+	}
+	return startedCh, func() { close(releaseCh) }
+}
+
+// waitForPendingReads waits until the supplied number of reads joined the
+// batches of the coalescing API.
+func waitForPendingReads(t *testing.T, api rdsapi.API, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return rdsapi.PendingReads(api) == n
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// cancelAwareAPI fails the reads made with a canceled context, like the
+// aws-sdk-go-v2 client does.
+type cancelAwareAPI struct {
+	rdsapi.API
+}
+
+func (a cancelAwareAPI) DescribeDBInstances(
+	ctx context.Context,
+	params *svcsdk.DescribeDBInstancesInput,
+	optFns ...func(*svcsdk.Options),
+) (*svcsdk.DescribeDBInstancesOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.API.DescribeDBInstances(ctx, params, optFns...)
+}
+
+func describeInstance(
+	ctx context.Context,
+	api rdsapi.API,
+	id string,
+) (*svcsdk.DescribeDBInstancesOutput, error) {
+	return api.DescribeDBInstances(
+		ctx, &svcsdk.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(id)},
+	)
+}
+
+// describeConcurrently reads the supplied DB instances at the same time and
+// returns the results in the same order once they are all read.
+func describeConcurrently(
+	api rdsapi.API,
+	ids ...string,
+) (wait func() ([]*svcsdk.DescribeDBInstancesOutput, []error)) {
+	outputs := make([]*svcsdk.DescribeDBInstancesOutput, len(ids))
+	errs := make([]error, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			outputs[i], errs[i] = describeInstance(context.Background(), api, id)
+		}()
+	}
+	return func() ([]*svcsdk.DescribeDBInstancesOutput, []error) {
+		wg.Wait()
+		return outputs, errs
+	}
+}
+
+func TestCoalescingAPI_ReadWithoutReadsInFlightIsNotDelayed(t *testing.T) {
+	backend := newFakeWithInstances("db-1")
+	// The read would time the test out if it waited for the window.
+	api := rdsapi.NewCoalescingAPI(backend, time.Hour)
+
+	_, err := describeInstance(context.Background(), api, "db-1")
+	require.NoError(t, err)
+	calls := backend.CallsTo("DescribeDBInstances")
+	require.Len(t, calls, 1)
+	assert.Equal(t, "db-1", *calls[0].(*svcsdk.DescribeDBInstancesInput).DBInstanceIdentifier)
+}
+
+func TestCoalescingAPI_BatchesReadsMadeWhileAReadIsInFlight(t *testing.T) {
+	backend := newFakeWithInstances("db-1", "db-2", "db-3")
+	started, release := blockFirstCall(backend, "DescribeDBInstances")
+	api := rdsapi.NewCoalescingAPI(backend, testWindow)
+
+	first := describeConcurrently(api, "db-1")
+	<-started
+	batched := describeConcurrently(api, "DB-2", "db-3", "missing")
+	waitForPendingReads(t, api, 3)
+	release()
+
+	_, errs := first()
+	require.NoError(t, errs[0])
+	outputs, errs := batched()
+	for i, id := range []string{"db-2", "db-3"} {
+		require.NoError(t, errs[i])
+		require.Len(t, outputs[i].DBInstances, 1)
+		assert.Equal(t, id, *outputs[i].DBInstances[0].DBInstanceIdentifier)
+	}
+	var apiErr smithy.APIError
+	require.True(t, errors.As(errs[2], &apiErr))
+	assert.Equal(t, "DBInstanceNotFound", apiErr.ErrorCode())
+
+	calls := backend.CallsTo("DescribeDBInstances")
+	require.Len(t, calls, 2)
+	assert.Equal(t, "db-1", *calls[0].(*svcsdk.DescribeDBInstancesInput).DBInstanceIdentifier)
+	input := calls[1].(*svcsdk.DescribeDBInstancesInput)
+	assert.Nil(t, input.DBInstanceIdentifier)
+	require.Len(t, input.Filters, 1)
+	assert.Equal(t, "db-instance-id", *input.Filters[0].Name)
+	assert.ElementsMatch(t, []string{"db-2", "db-3", "missing"}, input.Filters[0].Values)
+}
+
+func TestCoalescingAPI_FailedBatchFallsBackToSingleReads(t *testing.T) {
+	backend := newFakeWithInstances("db-1", "db-2", "db-3")
+	backend.Hooks["DescribeDBInstances"] = func(input any) error {
+		if len(input.(*svcsdk.DescribeDBInstancesInput).Filters) > 0 {
+			return fake.NewAPIError("AccessDenied", "not allowed")
+		}
+		return nil
+	}
+	started, release := blockFirstCall(backend, "DescribeDBInstances")
+	api := rdsapi.NewCoalescingAPI(backend, testWindow)
+
+	first := describeConcurrently(api, "db-1")
+	<-started
+	batched := describeConcurrently(api, "db-2", "db-3")
+	waitForPendingReads(t, api, 2)
+	release()
+
+	_, errs := first()
+	require.NoError(t, errs[0])
+	outputs, errs := batched()
+	for i := range outputs {
+		require.NoError(t, errs[i])
+		require.Len(t, outputs[i].DBInstances, 1)
+	}
+	assert.Len(t, backend.CallsTo("DescribeDBInstances"), 4)
+}
+
+func TestCoalescingAPI_BatchOutlivesTheContextOfItsFirstRead(t *testing.T) {
+	backend := newFakeWithInstances("db-1", "db-2", "db-3")
+	started, release := blockFirstCall(backend, "DescribeDBInstances")
+	api := rdsapi.NewCoalescingAPI(cancelAwareAPI{backend}, testWindow)
+
+	first := describeConcurrently(api, "db-1")
+	<-started
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = describeInstance(ctx, api, "db-2")
+	}()
+	waitForPendingReads(t, api, 1)
+	batched := describeConcurrently(api, "db-3")
+	waitForPendingReads(t, api, 2)
+	// The batch is still read for the other reads once the first read of
+	// the batch is canceled.
+	cancel()
+	release()
+	wg.Wait()
+
+	_, errs := first()
+	require.NoError(t, errs[0])
+	outputs, errs := batched()
+	require.NoError(t, errs[0])
+	require.Len(t, outputs[0].DBInstances, 1)
+	calls := backend.CallsTo("DescribeDBInstances")
+	require.Len(t, calls, 2)
+	assert.Len(t, calls[1].(*svcsdk.DescribeDBInstancesInput).Filters, 1)
+}
+
+func TestCoalescingAPI_PassesThroughOtherReads(t *testing.T) {
+	backend := newFakeWithInstances("db-1", "db-2")
+	api := rdsapi.NewCoalescingAPI(backend, testWindow)
+
+	resp, err := api.DescribeDBInstances(context.Background(), &svcsdk.DescribeDBInstancesInput{})
+	require.NoError(t, err)
+	assert.Len(t, resp.DBInstances, 2)
+	_, err = api.DescribeDBInstances(context.Background(), &svcsdk.DescribeDBInstancesInput{
+		DBInstanceIdentifier: aws.String("arn:aws:rds:us-west-2:111111111111:db:db-1"),
+	})
+	assert.Error(t, err)
+	assert.Len(t, backend.CallsTo("DescribeDBInstances"), 2)
+}
+
+func TestCoalescingAPI_BatchesDBClusters(t *testing.T) {
+	backend := fake.New()
+	for _, id := range []string{"cluster-1", "cluster-2", "cluster-3"} {
+		backend.DBClusters[id] = &svcsdktypes.DBCluster{DBClusterIdentifier: aws.String(id)}
+	}
+	started, release := blockFirstCall(backend, "DescribeDBClusters")
+	api := rdsapi.NewCoalescingAPI(backend, testWindow)
+
+	ids := []string{"cluster-1", "cluster-2", "cluster-3", "missing"}
+	var wg sync.WaitGroup
+	errs := make([]error, len(ids))
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = api.DescribeDBClusters(
+				context.Background(),
+				&svcsdk.DescribeDBClustersInput{DBClusterIdentifier: aws.String(id)},
+			)
+		}()
+		if i == 0 {
+			<-started
+		}
+	}
+	waitForPendingReads(t, api, 3)
+	release()
+	wg.Wait()
+
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.NoError(t, errs[2])
+	var apiErr smithy.APIError
+	require.True(t, errors.As(errs[3], &apiErr))
+	assert.Equal(t, "DBClusterNotFoundFault", apiErr.ErrorCode())
+	assert.Len(t, backend.CallsTo("DescribeDBClusters"), 2)
+}
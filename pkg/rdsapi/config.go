@@ -0,0 +1,150 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	flagRDSAPIRateLimit          = "rds-api-rate-limit"
+	flagRDSAPIBurst              = "rds-api-burst"
+	flagRDSAPIOperationRateLimit = "rds-api-operation-rate-limit"
+	flagRDSAPICoalescingWindow   = "rds-api-describe-coalescing-window"
+)
+
+// Config contains the controller flags of the RDS API clients.
+type Config struct {
+	Rate             float64
+	Burst            int
+	OperationLimits  []string
+	CoalescingWindow time.Duration
+}
+
+// BindFlags defines the RDS API client flags.
+func (cfg *Config) BindFlags() {
+	flag.Float64Var(
+		&cfg.Rate, flagRDSAPIRateLimit,
+		DefaultRate,
+		"The number of calls per second to each RDS API operation, in an AWS account and region,"+
+			" shared by all resource managers. 0 disables the limit.",
+	)
+	flag.IntVar(
+		&cfg.Burst, flagRDSAPIBurst,
+		DefaultBurst,
+		"The number of calls to each RDS API operation that can be made at once.",
+	)
+	flag.StringArrayVar(
+		&cfg.OperationLimits, flagRDSAPIOperationRateLimit,
+		[]string{},
+		"The rate limit of an RDS API operation, as operation=rate or operation=rate:burst,"+
+			" e.g. DescribeDBInstances=5:10. Overrides the default limit. Can be repeated.",
+	)
+	flag.DurationVar(
+		&cfg.CoalescingWindow, flagRDSAPICoalescingWindow,
+		DefaultCoalescingWindow,
+		"How long the reads of single DB instances or DB clusters made while other reads are in"+
+			" flight wait for them, to be batched in a single Describe call. 0 disables the batching.",
+	)
+}
+
+// NewClientOptions validates the flags and returns the ClientOptions they
+// configure.
+func (cfg *Config) NewClientOptions() (ClientOptions, error) {
+	if cfg.Rate < 0 {
+		return ClientOptions{}, fmt.Errorf("invalid value for flag '%s': must not be negative", flagRDSAPIRateLimit)
+	}
+	if cfg.Burst < 1 {
+		return ClientOptions{}, fmt.Errorf("invalid value for flag '%s': must be positive", flagRDSAPIBurst)
+	}
+	if cfg.CoalescingWindow < 0 {
+		return ClientOptions{}, fmt.Errorf("invalid value for flag '%s': must not be negative", flagRDSAPICoalescingWindow)
+	}
+	defaultLimit := RateLimit{Rate: cfg.Rate, Burst: cfg.Burst}
+	limits := map[string]RateLimit{}
+	for _, entry := range cfg.OperationLimits {
+		op, limit, err := parseOperationRateLimit(entry, defaultLimit.Burst)
+		if err != nil {
+			return ClientOptions{}, fmt.Errorf("invalid value for flag '%s': %v", flagRDSAPIOperationRateLimit, err)
+		}
+		limits[op] = limit
+	}
+	return ClientOptions{
+		RateLimiter:      NewRateLimiter(defaultLimit, limits),
+		CoalescingWindow: cfg.CoalescingWindow,
+	}, nil
+}
+
+// parseOperationRateLimit parses an operation=rate[:burst] entry. The burst
+// defaults to the supplied one.
+func parseOperationRateLimit(entry string, burst int) (string, RateLimit, error) {
+	op, value, found := strings.Cut(entry, "=")
+	op = strings.TrimSpace(op)
+	if !found || op == "" {
+		return "", RateLimit{}, fmt.Errorf("expected operation=rate[:burst], got %q", entry)
+	}
+	rateValue, burstValue, hasBurst := strings.Cut(strings.TrimSpace(value), ":")
+	rate, err := strconv.ParseFloat(rateValue, 64)
+	if err != nil || rate < 0 {
+		return "", RateLimit{}, fmt.Errorf("invalid rate for operation %q: %q", op, rateValue)
+	}
+	if hasBurst {
+		burst, err = strconv.Atoi(burstValue)
+		if err != nil || burst < 1 {
+			return "", RateLimit{}, fmt.Errorf("invalid burst for operation %q: %q", op, burstValue)
+		}
+	}
+	return op, RateLimit{Rate: rate, Burst: burst}, nil
+}
+
+// ClientOptions configures the APIs NewFromConfig returns.
+type ClientOptions struct {
+	// RateLimiter limits the rate of the calls of every API.
+	RateLimiter *RateLimiter
+	// CoalescingWindow is how long the reads of single DB instances or DB
+	// clusters made while other reads are in flight wait for them, to be
+	// batched. Zero disables the batching.
+	CoalescingWindow time.Duration
+}
+
+var (
+	defaultClientOptionsMu sync.RWMutex
+	defaultClientOptions   = ClientOptions{
+		RateLimiter: NewRateLimiter(
+			RateLimit{Rate: DefaultRate, Burst: DefaultBurst}, nil,
+		),
+		CoalescingWindow: DefaultCoalescingWindow,
+	}
+)
+
+// SetDefaultClientOptions replaces the options NewFromConfig uses, which are
+// built from the controller flags at startup.
+func SetDefaultClientOptions(opts ClientOptions) {
+	defaultClientOptionsMu.Lock()
+	defer defaultClientOptionsMu.Unlock()
+	defaultClientOptions = opts
+}
+
+// DefaultClientOptions returns the options NewFromConfig uses.
+func DefaultClientOptions() ClientOptions {
+	defaultClientOptionsMu.RLock()
+	defer defaultClientOptionsMu.RUnlock()
+	return defaultClientOptions
+}
@@ -0,0 +1,60 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+)
+
+func TestConfigNewClientOptions(t *testing.T) {
+	cfg := rdsapi.Config{
+		Rate:             rdsapi.DefaultRate,
+		Burst:            rdsapi.DefaultBurst,
+		OperationLimits:  []string{"DescribeDBInstances=5:10", "ModifyDBInstance = 1"},
+		CoalescingWindow: 100 * time.Millisecond,
+	}
+	opts, err := cfg.NewClientOptions()
+	require.NoError(t, err)
+	assert.NotNil(t, opts.RateLimiter)
+	assert.Equal(t, 100*time.Millisecond, opts.CoalescingWindow)
+}
+
+func TestConfigNewClientOptions_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*rdsapi.Config)
+	}{
+		{"negative rate", func(c *rdsapi.Config) { c.Rate = -1 }},
+		{"zero burst", func(c *rdsapi.Config) { c.Burst = 0 }},
+		{"negative window", func(c *rdsapi.Config) { c.CoalescingWindow = -1 }},
+		{"operation without rate", func(c *rdsapi.Config) { c.OperationLimits = []string{"DescribeDBInstances"} }},
+		{"operation without name", func(c *rdsapi.Config) { c.OperationLimits = []string{"=5"} }},
+		{"bad rate", func(c *rdsapi.Config) { c.OperationLimits = []string{"DescribeDBInstances=fast"} }},
+		{"bad burst", func(c *rdsapi.Config) { c.OperationLimits = []string{"DescribeDBInstances=5:0"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := rdsapi.Config{Rate: rdsapi.DefaultRate, Burst: rdsapi.DefaultBurst}
+			tt.modify(&cfg)
+			_, err := cfg.NewClientOptions()
+			assert.Error(t, err)
+		})
+	}
+}
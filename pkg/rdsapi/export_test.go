@@ -0,0 +1,35 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi
+
+// NewCoalescingAPI exposes newCoalescingAPI to the tests of the rdsapi_test
+// package, which can use the fake backend without an import cycle.
+var NewCoalescingAPI = newCoalescingAPI
+
+// PendingReads returns the number of DB instances and DB clusters in the
+// batches the supplied coalescing API collects, so that the tests can wait
+// for reads to join them.
+func PendingReads(api API) int {
+	c := api.(*coalescingAPI)
+	return pendingReads(c.instances) + pendingReads(c.clusters)
+}
+
+func pendingReads[T any](b *batcher[T]) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.pending == nil {
+		return 0
+	}
+	return len(b.pending.ids)
+}
@@ -0,0 +1,2630 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package fake contains an in-memory implementation of rdsapi.API for use in
+// unit tests.
+//
+// DB instances, DB clusters, DB parameter groups, DB cluster parameter groups,
+// engine default parameters, DB proxies with their default target group and
+// resource tags are modelled so that create, read, modify, reset and delete
+// flows behave like the real service. The
+// remaining operations only record the call and return an empty output.
+// Every call is recorded in RDS.Calls and an error can be injected for any
+// operation through RDS.Errors. RDS.ManagerFor returns the resource manager
+// that a resource manager factory produces with the fake as its RDS API.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+)
+
+const (
+	defaultRegion    = "us-west-2"
+	defaultAccountID = "111111111111"
+	// defaultPageSize matches the default MaxRecords of the Describe*
+	// operations that the fake paginates.
+	defaultPageSize = 100
+	// maxParametersPerCall is the maximum number of parameters RDS accepts
+	// in a single Modify*ParameterGroup or Reset*ParameterGroup call.
+	maxParametersPerCall = 20
+
+	// StatusAvailable is the status of a DB instance or DB cluster that can
+	// be modified.
+	StatusAvailable = "available"
+	// StatusCreating is the status the fake gives to newly created DB
+	// instances and DB clusters.
+	StatusCreating = "creating"
+	// StatusDeleting is the status the fake gives to deleted DB instances
+	// and DB clusters.
+	StatusDeleting = "deleting"
+	// StatusRebooting is the status the fake gives to rebooted DB instances
+	// and DB clusters.
+	StatusRebooting = "rebooting"
+	// StatusFailingOver is the status the fake gives to DB clusters that
+	// are failed over.
+	StatusFailingOver = "failing-over"
+	// StatusStarting and StatusStopping are the statuses the fake gives to
+	// started and stopped DB instances and DB clusters.
+	StatusStarting = "starting"
+	StatusStopping = "stopping"
+	// StatusStopped is the status of a DB instance or DB cluster that can be
+	// started.
+	StatusStopped = "stopped"
+
+	// ParameterApplyStatusInSync and ParameterApplyStatusPendingReboot are
+	// the values RDS reports for the parameter groups of DB instances and
+	// DB cluster members.
+	ParameterApplyStatusInSync        = "in-sync"
+	ParameterApplyStatusPendingReboot = "pending-reboot"
+
+	// RoleStatusActive is the status the fake gives to the IAM roles
+	// associated with DB clusters and DB instances.
+	RoleStatusActive = "ACTIVE"
+
+	// BlueGreenDeploymentStatusProvisioning, ...SwitchoverInProgress and
+	// ...Deleting are the statuses the fake gives to created, switched over
+	// and deleted blue/green deployments.
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	// BlueGreenDeploymentStatusAvailable and ...SwitchoverCompleted are the
+	// statuses of a blue/green deployment that can be switched over and of
+	// one that has been switched over.
+	BlueGreenDeploymentStatusAvailable           = "AVAILABLE"
+	BlueGreenDeploymentStatusSwitchoverCompleted = "SWITCHOVER_COMPLETED"
+
+	// GlobalClusterStatusSwitchingOver and ...FailingOver are the statuses
+	// the fake gives to global clusters on SwitchoverGlobalCluster and
+	// FailoverGlobalCluster.
+	GlobalClusterStatusSwitchingOver = "switching-over"
+	GlobalClusterStatusFailingOver   = "failing-over"
+
+	// DefaultTargetGroupName is the name of the target group RDS creates
+	// along with each DB proxy.
+	DefaultTargetGroupName = "default"
+)
+
+var _ rdsapi.API = (*RDS)(nil)
+
+// Call is a single recorded call to the fake RDS API.
+type Call struct {
+	// Operation is the name of the RDS API operation, e.g. "CreateDBInstance"
+	Operation string
+	// Input is the input shape passed to the operation
+	Input any
+}
+
+// ParameterGroup is the fake's representation of a DB parameter group or a
+// DB cluster parameter group.
+type ParameterGroup struct {
+	Family     string
+	Parameters map[string]svcsdktypes.Parameter
+}
+
+// RDS is an in-memory implementation of rdsapi.API.
+//
+// Tests may seed and inspect the exported maps directly. The zero value is
+// not usable; construct one with New.
+type RDS struct {
+	sync.Mutex
+
+	// Region and AccountID are used to build the ARNs of created resources.
+	Region    string
+	AccountID string
+	// PageSize is the number of records returned per page by the paginated
+	// Describe*Parameters operations.
+	PageSize int
+
+	// BlueGreenDeployments is keyed by blue/green deployment identifier.
+	BlueGreenDeployments map[string]*svcsdktypes.BlueGreenDeployment
+	// DBInstances is keyed by DB instance identifier.
+	DBInstances map[string]*svcsdktypes.DBInstance
+	// DBClusters is keyed by DB cluster identifier.
+	DBClusters map[string]*svcsdktypes.DBCluster
+	// DBClusterSnapshots is keyed by DB cluster snapshot identifier.
+	DBClusterSnapshots map[string]*svcsdktypes.DBClusterSnapshot
+	// DBClusterSnapshotAttributes contains the values of the attributes of
+	// each DB cluster snapshot, keyed by DB cluster snapshot identifier and
+	// then by attribute name.
+	DBClusterSnapshotAttributes map[string]map[string][]string
+	// DBParameterGroups is keyed by DB parameter group name.
+	DBParameterGroups map[string]*ParameterGroup
+	// DBClusterParameterGroups is keyed by DB cluster parameter group name.
+	DBClusterParameterGroups map[string]*ParameterGroup
+	// EngineDefaults contains the engine default parameters, keyed by DB
+	// parameter group family. It backs both DescribeEngineDefaultParameters
+	// and DescribeEngineDefaultClusterParameters.
+	EngineDefaults map[string][]svcsdktypes.Parameter
+	// DBProxies is keyed by DB proxy name.
+	DBProxies map[string]*svcsdktypes.DBProxy
+	// DBProxyTargetGroups contains the default target group of each DB
+	// proxy, keyed by DB proxy name.
+	DBProxyTargetGroups map[string]*svcsdktypes.DBProxyTargetGroup
+	// DBProxyTargets contains the targets registered with the default target
+	// group of each DB proxy, keyed by DB proxy name.
+	DBProxyTargets map[string][]svcsdktypes.DBProxyTarget
+	// DBProxyEndpoints is keyed by DB proxy endpoint name.
+	DBProxyEndpoints map[string]*svcsdktypes.DBProxyEndpoint
+	// DBSnapshots is keyed by DB snapshot identifier.
+	DBSnapshots map[string]*svcsdktypes.DBSnapshot
+	// DBSnapshotAttributes contains the values of the attributes of each DB
+	// snapshot, keyed by DB snapshot identifier and then by attribute name.
+	DBSnapshotAttributes map[string]map[string][]string
+	// EventSubscriptions is keyed by subscription name.
+	EventSubscriptions map[string]*svcsdktypes.EventSubscription
+	// GlobalClusters is keyed by global cluster identifier.
+	GlobalClusters map[string]*svcsdktypes.GlobalCluster
+	// OptionGroups is keyed by option group name.
+	OptionGroups map[string]*svcsdktypes.OptionGroup
+	// Tags is keyed by resource ARN.
+	Tags map[string][]svcsdktypes.Tag
+
+	// Errors maps an operation name to the error returned by every call to
+	// that operation.
+	Errors map[string]error
+	// Hooks maps an operation name to a function that is called with the
+	// input of each call to that operation. A non-nil error returned by the
+	// hook is returned in place of the operation's result, which lets tests
+	// fail a specific call rather than every call.
+	Hooks map[string]func(input any) error
+	// Calls contains every call made to the fake, in order.
+	Calls []Call
+}
+
+// New returns an empty fake RDS backend.
+func New() *RDS {
+	return &RDS{
+		Region:                      defaultRegion,
+		AccountID:                   defaultAccountID,
+		PageSize:                    defaultPageSize,
+		BlueGreenDeployments:        map[string]*svcsdktypes.BlueGreenDeployment{},
+		DBInstances:                 map[string]*svcsdktypes.DBInstance{},
+		DBClusters:                  map[string]*svcsdktypes.DBCluster{},
+		DBClusterSnapshots:          map[string]*svcsdktypes.DBClusterSnapshot{},
+		DBClusterSnapshotAttributes: map[string]map[string][]string{},
+		DBParameterGroups:           map[string]*ParameterGroup{},
+		DBClusterParameterGroups:    map[string]*ParameterGroup{},
+		DBProxies:                   map[string]*svcsdktypes.DBProxy{},
+		DBProxyTargetGroups:         map[string]*svcsdktypes.DBProxyTargetGroup{},
+		DBProxyTargets:              map[string][]svcsdktypes.DBProxyTarget{},
+		DBProxyEndpoints:            map[string]*svcsdktypes.DBProxyEndpoint{},
+		DBSnapshots:                 map[string]*svcsdktypes.DBSnapshot{},
+		DBSnapshotAttributes:        map[string]map[string][]string{},
+		EngineDefaults:              map[string][]svcsdktypes.Parameter{},
+		EventSubscriptions:          map[string]*svcsdktypes.EventSubscription{},
+		GlobalClusters:              map[string]*svcsdktypes.GlobalCluster{},
+		OptionGroups:                map[string]*svcsdktypes.OptionGroup{},
+		Tags:                        map[string][]svcsdktypes.Tag{},
+		Errors:                      map[string]error{},
+		Hooks:                       map[string]func(input any) error{},
+	}
+}
+
+// CallsTo returns the inputs of every recorded call to the named operation.
+func (f *RDS) CallsTo(operation string) []any {
+	f.Lock()
+	defer f.Unlock()
+	inputs := []any{}
+	for _, c := range f.Calls {
+		if c.Operation == operation {
+			inputs = append(inputs, c.Input)
+		}
+	}
+	return inputs
+}
+
+// Operations returns the names of the recorded operations, in call order.
+func (f *RDS) Operations() []string {
+	f.Lock()
+	defer f.Unlock()
+	ops := make([]string, 0, len(f.Calls))
+	for _, c := range f.Calls {
+		ops = append(ops, c.Operation)
+	}
+	return ops
+}
+
+// record records a call and returns the error injected for the operation,
+// if any. Callers must hold the lock.
+func (f *RDS) record(operation string, input any) error {
+	f.Calls = append(f.Calls, Call{Operation: operation, Input: input})
+	if err := f.Errors[operation]; err != nil {
+		return err
+	}
+	if hook := f.Hooks[operation]; hook != nil {
+		return hook(input)
+	}
+	return nil
+}
+
+// NewAPIError returns a smithy.APIError with the supplied code, as returned
+// by the real RDS API.
+func NewAPIError(code string, msg string) error {
+	return &smithy.GenericAPIError{
+		Code:    code,
+		Message: msg,
+		Fault:   smithy.FaultClient,
+	}
+}
+
+func (f *RDS) arn(resourceType string, name *string) *string {
+	return aws.String(fmt.Sprintf(
+		"arn:aws:rds:%s:%s:%s:%s",
+		f.Region, f.AccountID, resourceType, aws.ToString(name),
+	))
+}
+
+// Tagging
+
+func (f *RDS) AddTagsToResource(ctx context.Context, params *svcsdk.AddTagsToResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddTagsToResourceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("AddTagsToResource", params); err != nil {
+		return nil, err
+	}
+	arn := aws.ToString(params.ResourceName)
+	for _, tag := range params.Tags {
+		f.Tags[arn] = setTag(f.Tags[arn], tag)
+	}
+	return &svcsdk.AddTagsToResourceOutput{}, nil
+}
+
+func (f *RDS) ListTagsForResource(ctx context.Context, params *svcsdk.ListTagsForResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ListTagsForResourceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ListTagsForResource", params); err != nil {
+		return nil, err
+	}
+	tags := append([]svcsdktypes.Tag{}, f.Tags[aws.ToString(params.ResourceName)]...)
+	return &svcsdk.ListTagsForResourceOutput{TagList: tags}, nil
+}
+
+func (f *RDS) RemoveTagsFromResource(ctx context.Context, params *svcsdk.RemoveTagsFromResourceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveTagsFromResourceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RemoveTagsFromResource", params); err != nil {
+		return nil, err
+	}
+	arn := aws.ToString(params.ResourceName)
+	for _, key := range params.TagKeys {
+		f.Tags[arn] = removeTag(f.Tags[arn], key)
+	}
+	return &svcsdk.RemoveTagsFromResourceOutput{}, nil
+}
+
+// tagList returns the current tags of the resource with the supplied ARN,
+// which RDS also reports in the TagList of DB instances, DB clusters and their
+// snapshots.
+func (f *RDS) tagList(arn *string) []svcsdktypes.Tag {
+	return append([]svcsdktypes.Tag{}, f.Tags[aws.ToString(arn)]...)
+}
+
+func setTag(tags []svcsdktypes.Tag, tag svcsdktypes.Tag) []svcsdktypes.Tag {
+	for i := range tags {
+		if aws.ToString(tags[i].Key) == aws.ToString(tag.Key) {
+			tags[i].Value = tag.Value
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
+
+func removeTag(tags []svcsdktypes.Tag, key string) []svcsdktypes.Tag {
+	res := []svcsdktypes.Tag{}
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) != key {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// BlueGreenDeployment
+
+// sourceDatabaseExists returns true if the supplied ARN is the ARN of a DB
+// instance or a DB cluster known to the fake. Callers must hold the lock.
+func (f *RDS) sourceDatabaseExists(arn string) bool {
+	for _, instance := range f.DBInstances {
+		if aws.ToString(instance.DBInstanceArn) == arn {
+			return true
+		}
+	}
+	for _, cluster := range f.DBClusters {
+		if aws.ToString(cluster.DBClusterArn) == arn {
+			return true
+		}
+	}
+	return false
+}
+
+// blueGreenDeploymentARN returns the ARN of the supplied blue/green
+// deployment, which isn't part of the BlueGreenDeployment shape.
+func (f *RDS) blueGreenDeploymentARN(bgd *svcsdktypes.BlueGreenDeployment) string {
+	return *f.arn("deployment", bgd.BlueGreenDeploymentIdentifier)
+}
+
+// CreateBlueGreenDeployment creates a blue/green deployment in the
+// PROVISIONING status. The green environment isn't modelled, the target is
+// only given an ARN derived from the source's.
+func (f *RDS) CreateBlueGreenDeployment(ctx context.Context, params *svcsdk.CreateBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateBlueGreenDeploymentOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateBlueGreenDeployment", params); err != nil {
+		return nil, err
+	}
+	for _, bgd := range f.BlueGreenDeployments {
+		if aws.ToString(bgd.BlueGreenDeploymentName) == aws.ToString(params.BlueGreenDeploymentName) {
+			return nil, NewAPIError("BlueGreenDeploymentAlreadyExistsFault", "blue/green deployment already exists")
+		}
+	}
+	if !f.sourceDatabaseExists(aws.ToString(params.Source)) {
+		return nil, NewAPIError("SourceDatabaseNotFoundFault", "source database not found")
+	}
+	id := "bgd-" + aws.ToString(params.BlueGreenDeploymentName)
+	target := aws.ToString(params.Source) + "-green"
+	bgd := &svcsdktypes.BlueGreenDeployment{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+		BlueGreenDeploymentName:       params.BlueGreenDeploymentName,
+		Source:                        params.Source,
+		Status:                        aws.String(BlueGreenDeploymentStatusProvisioning),
+		SwitchoverDetails: []svcsdktypes.SwitchoverDetail{{
+			SourceMember: params.Source,
+			Status:       aws.String(BlueGreenDeploymentStatusProvisioning),
+			TargetMember: aws.String(target),
+		}},
+		TagList: append([]svcsdktypes.Tag{}, params.Tags...),
+		Target:  aws.String(target),
+	}
+	f.BlueGreenDeployments[id] = bgd
+	f.Tags[f.blueGreenDeploymentARN(bgd)] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateBlueGreenDeploymentOutput{BlueGreenDeployment: bgd}, nil
+}
+
+func (f *RDS) DeleteBlueGreenDeployment(ctx context.Context, params *svcsdk.DeleteBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteBlueGreenDeploymentOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteBlueGreenDeployment", params); err != nil {
+		return nil, err
+	}
+	bgd, ok := f.BlueGreenDeployments[aws.ToString(params.BlueGreenDeploymentIdentifier)]
+	if !ok {
+		return nil, NewAPIError("BlueGreenDeploymentNotFoundFault", "blue/green deployment not found")
+	}
+	if params.DeleteTarget != nil && aws.ToString(bgd.Status) == BlueGreenDeploymentStatusSwitchoverCompleted {
+		return nil, NewAPIError("InvalidBlueGreenDeploymentStateFault", "can't delete the target of a switched over blue/green deployment")
+	}
+	bgd.Status = aws.String(BlueGreenDeploymentStatusDeleting)
+	return &svcsdk.DeleteBlueGreenDeploymentOutput{BlueGreenDeployment: bgd}, nil
+}
+
+// DescribeBlueGreenDeployments returns the blue/green deployments with their
+// current tags, since RDS reports them in the TagList of each deployment.
+func (f *RDS) DescribeBlueGreenDeployments(ctx context.Context, params *svcsdk.DescribeBlueGreenDeploymentsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeBlueGreenDeploymentsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeBlueGreenDeployments", params); err != nil {
+		return nil, err
+	}
+	ids := sortedKeys(f.BlueGreenDeployments)
+	if params.BlueGreenDeploymentIdentifier != nil {
+		if _, ok := f.BlueGreenDeployments[*params.BlueGreenDeploymentIdentifier]; !ok {
+			return nil, NewAPIError("BlueGreenDeploymentNotFoundFault", "blue/green deployment not found")
+		}
+		ids = []string{*params.BlueGreenDeploymentIdentifier}
+	}
+	bgds := []svcsdktypes.BlueGreenDeployment{}
+	for _, id := range ids {
+		bgd := *f.BlueGreenDeployments[id]
+		bgd.TagList = append([]svcsdktypes.Tag{}, f.Tags[f.blueGreenDeploymentARN(&bgd)]...)
+		bgds = append(bgds, bgd)
+	}
+	return &svcsdk.DescribeBlueGreenDeploymentsOutput{BlueGreenDeployments: bgds}, nil
+}
+
+// SwitchoverBlueGreenDeployment puts an available blue/green deployment in
+// the SWITCHOVER_IN_PROGRESS status.
+func (f *RDS) SwitchoverBlueGreenDeployment(ctx context.Context, params *svcsdk.SwitchoverBlueGreenDeploymentInput, optFns ...func(*svcsdk.Options)) (*svcsdk.SwitchoverBlueGreenDeploymentOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("SwitchoverBlueGreenDeployment", params); err != nil {
+		return nil, err
+	}
+	bgd, ok := f.BlueGreenDeployments[aws.ToString(params.BlueGreenDeploymentIdentifier)]
+	if !ok {
+		return nil, NewAPIError("BlueGreenDeploymentNotFoundFault", "blue/green deployment not found")
+	}
+	if aws.ToString(bgd.Status) != BlueGreenDeploymentStatusAvailable {
+		return nil, NewAPIError("InvalidBlueGreenDeploymentStateFault", "blue/green deployment is not available")
+	}
+	bgd.Status = aws.String(BlueGreenDeploymentStatusSwitchoverInProgress)
+	for i := range bgd.SwitchoverDetails {
+		bgd.SwitchoverDetails[i].Status = aws.String(BlueGreenDeploymentStatusSwitchoverInProgress)
+	}
+	return &svcsdk.SwitchoverBlueGreenDeploymentOutput{BlueGreenDeployment: bgd}, nil
+}
+
+// DBCluster
+
+func (f *RDS) addDBCluster(
+	id *string,
+	engine *string,
+	tags []svcsdktypes.Tag,
+) (*svcsdktypes.DBCluster, error) {
+	if _, ok := f.DBClusters[aws.ToString(id)]; ok {
+		return nil, NewAPIError("DBClusterAlreadyExistsFault", "DB cluster already exists")
+	}
+	cluster := &svcsdktypes.DBCluster{
+		DBClusterIdentifier: id,
+		DBClusterArn:        f.arn("cluster", id),
+		Engine:              engine,
+		Status:              aws.String(StatusCreating),
+	}
+	f.DBClusters[aws.ToString(id)] = cluster
+	f.Tags[*cluster.DBClusterArn] = append([]svcsdktypes.Tag{}, tags...)
+	return cluster, nil
+}
+
+func (f *RDS) AddRoleToDBCluster(ctx context.Context, params *svcsdk.AddRoleToDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddRoleToDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("AddRoleToDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	for _, role := range cluster.AssociatedRoles {
+		if aws.ToString(role.RoleArn) == aws.ToString(params.RoleArn) &&
+			aws.ToString(role.FeatureName) == aws.ToString(params.FeatureName) {
+			return nil, NewAPIError("DBClusterRoleAlreadyExists", "role is already associated with the DB cluster")
+		}
+	}
+	cluster.AssociatedRoles = append(cluster.AssociatedRoles, svcsdktypes.DBClusterRole{
+		FeatureName: params.FeatureName,
+		RoleArn:     params.RoleArn,
+		Status:      aws.String(RoleStatusActive),
+	})
+	return &svcsdk.AddRoleToDBClusterOutput{}, nil
+}
+
+func (f *RDS) CreateDBCluster(ctx context.Context, params *svcsdk.CreateDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, err := f.addDBCluster(params.DBClusterIdentifier, params.Engine, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	cluster.DBClusterParameterGroup = params.DBClusterParameterGroupName
+	cluster.EngineVersion = params.EngineVersion
+	return &svcsdk.CreateDBClusterOutput{DBCluster: cluster}, nil
+}
+
+func (f *RDS) DeleteDBCluster(ctx context.Context, params *svcsdk.DeleteDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	cluster.Status = aws.String(StatusDeleting)
+	return &svcsdk.DeleteDBClusterOutput{DBCluster: cluster}, nil
+}
+
+// describedDBCluster returns a copy of the DB cluster with its current tags.
+func (f *RDS) describedDBCluster(cluster *svcsdktypes.DBCluster) svcsdktypes.DBCluster {
+	described := *cluster
+	described.TagList = f.tagList(cluster.DBClusterArn)
+	return described
+}
+
+func (f *RDS) DescribeDBClusters(ctx context.Context, params *svcsdk.DescribeDBClustersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClustersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBClusters", params); err != nil {
+		return nil, err
+	}
+	if params.DBClusterIdentifier != nil {
+		cluster, ok := f.DBClusters[*params.DBClusterIdentifier]
+		if !ok {
+			return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+		}
+		return &svcsdk.DescribeDBClustersOutput{
+			DBClusters: []svcsdktypes.DBCluster{f.describedDBCluster(cluster)},
+		}, nil
+	}
+	ids, filtered := filterValues(params.Filters, "db-cluster-id")
+	clusters := []svcsdktypes.DBCluster{}
+	for _, id := range sortedKeys(f.DBClusters) {
+		if !filtered || slices.Contains(ids, id) {
+			clusters = append(clusters, f.describedDBCluster(f.DBClusters[id]))
+		}
+	}
+	page, marker := paginate(clusters, params.Marker, f.pageSize(params.MaxRecords))
+	return &svcsdk.DescribeDBClustersOutput{DBClusters: page, Marker: marker}, nil
+}
+
+// FailoverDBCluster promotes the requested member, or the first reader when
+// no target is given, to be the writer of the cluster.
+func (f *RDS) FailoverDBCluster(ctx context.Context, params *svcsdk.FailoverDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.FailoverDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("FailoverDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	if aws.ToString(cluster.Status) != StatusAvailable {
+		return nil, NewAPIError("InvalidDBClusterStateFault", "DB cluster is not available")
+	}
+	target := -1
+	for i, m := range cluster.DBClusterMembers {
+		if params.TargetDBInstanceIdentifier != nil {
+			if aws.ToString(m.DBInstanceIdentifier) == *params.TargetDBInstanceIdentifier {
+				target = i
+			}
+		} else if target < 0 && !aws.ToBool(m.IsClusterWriter) {
+			target = i
+		}
+	}
+	if target < 0 {
+		return nil, NewAPIError("InvalidDBInstanceState", "no DB instance to fail over to")
+	}
+	for i := range cluster.DBClusterMembers {
+		cluster.DBClusterMembers[i].IsClusterWriter = aws.Bool(i == target)
+	}
+	cluster.Status = aws.String(StatusFailingOver)
+	return &svcsdk.FailoverDBClusterOutput{DBCluster: cluster}, nil
+}
+
+func (f *RDS) ModifyDBCluster(ctx context.Context, params *svcsdk.ModifyDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	if params.DBClusterParameterGroupName != nil {
+		cluster.DBClusterParameterGroup = params.DBClusterParameterGroupName
+	}
+	if params.EngineVersion != nil {
+		cluster.EngineVersion = params.EngineVersion
+	}
+	return &svcsdk.ModifyDBClusterOutput{DBCluster: cluster}, nil
+}
+
+// RebootDBCluster puts the cluster in the rebooting status. The reboot
+// completes immediately as far as the members' parameter groups are
+// concerned.
+func (f *RDS) RebootDBCluster(ctx context.Context, params *svcsdk.RebootDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RebootDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RebootDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	if aws.ToString(cluster.Status) != StatusAvailable {
+		return nil, NewAPIError("InvalidDBClusterStateFault", "DB cluster is not available")
+	}
+	cluster.Status = aws.String(StatusRebooting)
+	for i := range cluster.DBClusterMembers {
+		cluster.DBClusterMembers[i].DBClusterParameterGroupStatus = aws.String(ParameterApplyStatusInSync)
+	}
+	return &svcsdk.RebootDBClusterOutput{DBCluster: cluster}, nil
+}
+
+func (f *RDS) RemoveRoleFromDBCluster(ctx context.Context, params *svcsdk.RemoveRoleFromDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveRoleFromDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RemoveRoleFromDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	for i, role := range cluster.AssociatedRoles {
+		if aws.ToString(role.RoleArn) == aws.ToString(params.RoleArn) &&
+			aws.ToString(role.FeatureName) == aws.ToString(params.FeatureName) {
+			cluster.AssociatedRoles = append(cluster.AssociatedRoles[:i], cluster.AssociatedRoles[i+1:]...)
+			return &svcsdk.RemoveRoleFromDBClusterOutput{}, nil
+		}
+	}
+	return nil, NewAPIError("DBClusterRoleNotFound", "role is not associated with the DB cluster")
+}
+
+func (f *RDS) RestoreDBClusterFromSnapshot(ctx context.Context, params *svcsdk.RestoreDBClusterFromSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBClusterFromSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RestoreDBClusterFromSnapshot", params); err != nil {
+		return nil, err
+	}
+	cluster, err := f.addDBCluster(params.DBClusterIdentifier, params.Engine, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &svcsdk.RestoreDBClusterFromSnapshotOutput{DBCluster: cluster}, nil
+}
+
+func (f *RDS) RestoreDBClusterToPointInTime(ctx context.Context, params *svcsdk.RestoreDBClusterToPointInTimeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBClusterToPointInTimeOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RestoreDBClusterToPointInTime", params); err != nil {
+		return nil, err
+	}
+	source, ok := f.DBClusters[aws.ToString(params.SourceDBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "source DB cluster not found")
+	}
+	cluster, err := f.addDBCluster(params.DBClusterIdentifier, source.Engine, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	return &svcsdk.RestoreDBClusterToPointInTimeOutput{DBCluster: cluster}, nil
+}
+
+// StartDBCluster puts a stopped cluster in the starting status.
+func (f *RDS) StartDBCluster(ctx context.Context, params *svcsdk.StartDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StartDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("StartDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	if aws.ToString(cluster.Status) != StatusStopped {
+		return nil, NewAPIError("InvalidDBClusterStateFault", "DB cluster is not stopped")
+	}
+	cluster.Status = aws.String(StatusStarting)
+	return &svcsdk.StartDBClusterOutput{DBCluster: cluster}, nil
+}
+
+// StopDBCluster puts an available cluster in the stopping status.
+func (f *RDS) StopDBCluster(ctx context.Context, params *svcsdk.StopDBClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StopDBClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("StopDBCluster", params); err != nil {
+		return nil, err
+	}
+	cluster, ok := f.DBClusters[aws.ToString(params.DBClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+	}
+	if aws.ToString(cluster.Status) != StatusAvailable {
+		return nil, NewAPIError("InvalidDBClusterStateFault", "DB cluster is not available")
+	}
+	cluster.Status = aws.String(StatusStopping)
+	return &svcsdk.StopDBClusterOutput{DBCluster: cluster}, nil
+}
+
+// DBClusterEndpoint
+
+func (f *RDS) CreateDBClusterEndpoint(ctx context.Context, params *svcsdk.CreateDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.CreateDBClusterEndpointOutput{}, f.record("CreateDBClusterEndpoint", params)
+}
+
+func (f *RDS) DeleteDBClusterEndpoint(ctx context.Context, params *svcsdk.DeleteDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DeleteDBClusterEndpointOutput{}, f.record("DeleteDBClusterEndpoint", params)
+}
+
+func (f *RDS) DescribeDBClusterEndpoints(ctx context.Context, params *svcsdk.DescribeDBClusterEndpointsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterEndpointsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DescribeDBClusterEndpointsOutput{}, f.record("DescribeDBClusterEndpoints", params)
+}
+
+func (f *RDS) ModifyDBClusterEndpoint(ctx context.Context, params *svcsdk.ModifyDBClusterEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.ModifyDBClusterEndpointOutput{}, f.record("ModifyDBClusterEndpoint", params)
+}
+
+// DBClusterParameterGroup
+
+func (f *RDS) CreateDBClusterParameterGroup(ctx context.Context, params *svcsdk.CreateDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBClusterParameterGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBClusterParameterGroupName)
+	if _, ok := f.DBClusterParameterGroups[name]; ok {
+		return nil, NewAPIError("DBParameterGroupAlreadyExists", "DB cluster parameter group already exists")
+	}
+	f.DBClusterParameterGroups[name] = f.newParameterGroup(aws.ToString(params.DBParameterGroupFamily))
+	arn := f.arn("cluster-pg", params.DBClusterParameterGroupName)
+	f.Tags[*arn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateDBClusterParameterGroupOutput{
+		DBClusterParameterGroup: &svcsdktypes.DBClusterParameterGroup{
+			DBClusterParameterGroupArn:  arn,
+			DBClusterParameterGroupName: params.DBClusterParameterGroupName,
+			DBParameterGroupFamily:      params.DBParameterGroupFamily,
+			Description:                 params.Description,
+		},
+	}, nil
+}
+
+func (f *RDS) DeleteDBClusterParameterGroup(ctx context.Context, params *svcsdk.DeleteDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBClusterParameterGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBClusterParameterGroupName)
+	if _, ok := f.DBClusterParameterGroups[name]; !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB cluster parameter group not found")
+	}
+	delete(f.DBClusterParameterGroups, name)
+	return &svcsdk.DeleteDBClusterParameterGroupOutput{}, nil
+}
+
+func (f *RDS) DescribeDBClusterParameterGroups(ctx context.Context, params *svcsdk.DescribeDBClusterParameterGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterParameterGroupsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBClusterParameterGroups", params); err != nil {
+		return nil, err
+	}
+	names := sortedKeys(f.DBClusterParameterGroups)
+	if params.DBClusterParameterGroupName != nil {
+		if _, ok := f.DBClusterParameterGroups[*params.DBClusterParameterGroupName]; !ok {
+			return nil, NewAPIError("DBParameterGroupNotFound", "DB cluster parameter group not found")
+		}
+		names = []string{*params.DBClusterParameterGroupName}
+	}
+	groups := []svcsdktypes.DBClusterParameterGroup{}
+	for _, name := range names {
+		groups = append(groups, svcsdktypes.DBClusterParameterGroup{
+			DBClusterParameterGroupArn:  f.arn("cluster-pg", aws.String(name)),
+			DBClusterParameterGroupName: aws.String(name),
+			DBParameterGroupFamily:      aws.String(f.DBClusterParameterGroups[name].Family),
+		})
+	}
+	return &svcsdk.DescribeDBClusterParameterGroupsOutput{DBClusterParameterGroups: groups}, nil
+}
+
+func (f *RDS) DescribeDBClusterParameters(ctx context.Context, params *svcsdk.DescribeDBClusterParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterParametersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBClusterParameters", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBClusterParameterGroups[aws.ToString(params.DBClusterParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB cluster parameter group not found")
+	}
+	page, marker := paginate(pg.sortedParameters(), params.Marker, f.PageSize)
+	return &svcsdk.DescribeDBClusterParametersOutput{Parameters: page, Marker: marker}, nil
+}
+
+func (f *RDS) DescribeEngineDefaultClusterParameters(ctx context.Context, params *svcsdk.DescribeEngineDefaultClusterParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEngineDefaultClusterParametersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeEngineDefaultClusterParameters", params); err != nil {
+		return nil, err
+	}
+	page, marker := paginate(f.EngineDefaults[aws.ToString(params.DBParameterGroupFamily)], params.Marker, f.PageSize)
+	return &svcsdk.DescribeEngineDefaultClusterParametersOutput{
+		EngineDefaults: &svcsdktypes.EngineDefaults{
+			DBParameterGroupFamily: params.DBParameterGroupFamily,
+			Parameters:             page,
+			Marker:                 marker,
+		},
+	}, nil
+}
+
+func (f *RDS) ModifyDBClusterParameterGroup(ctx context.Context, params *svcsdk.ModifyDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBClusterParameterGroup", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBClusterParameterGroups[aws.ToString(params.DBClusterParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB cluster parameter group not found")
+	}
+	if err := pg.modify(params.Parameters); err != nil {
+		return nil, err
+	}
+	return &svcsdk.ModifyDBClusterParameterGroupOutput{
+		DBClusterParameterGroupName: params.DBClusterParameterGroupName,
+	}, nil
+}
+
+func (f *RDS) ResetDBClusterParameterGroup(ctx context.Context, params *svcsdk.ResetDBClusterParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ResetDBClusterParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ResetDBClusterParameterGroup", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBClusterParameterGroups[aws.ToString(params.DBClusterParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB cluster parameter group not found")
+	}
+	if err := pg.reset(f.EngineDefaults[pg.Family], params.Parameters); err != nil {
+		return nil, err
+	}
+	return &svcsdk.ResetDBClusterParameterGroupOutput{
+		DBClusterParameterGroupName: params.DBClusterParameterGroupName,
+	}, nil
+}
+
+// DBClusterSnapshot
+
+func (f *RDS) CopyDBClusterSnapshot(ctx context.Context, params *svcsdk.CopyDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CopyDBClusterSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CopyDBClusterSnapshot", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.TargetDBClusterSnapshotIdentifier)
+	if _, ok := f.DBClusterSnapshots[id]; ok {
+		return nil, NewAPIError("DBClusterSnapshotAlreadyExistsFault", "DB cluster snapshot already exists")
+	}
+	snapshot := &svcsdktypes.DBClusterSnapshot{
+		DBClusterSnapshotArn:        f.arn("cluster-snapshot", params.TargetDBClusterSnapshotIdentifier),
+		DBClusterSnapshotIdentifier: params.TargetDBClusterSnapshotIdentifier,
+		KmsKeyId:                    params.KmsKeyId,
+		PercentProgress:             aws.Int32(0),
+		SnapshotType:                aws.String("manual"),
+		SourceDBClusterSnapshotArn:  params.SourceDBClusterSnapshotIdentifier,
+		Status:                      aws.String("copying"),
+	}
+	f.DBClusterSnapshots[id] = snapshot
+	f.Tags[*snapshot.DBClusterSnapshotArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CopyDBClusterSnapshotOutput{DBClusterSnapshot: snapshot}, nil
+}
+
+func (f *RDS) CreateDBClusterSnapshot(ctx context.Context, params *svcsdk.CreateDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBClusterSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.CreateDBClusterSnapshotOutput{}, f.record("CreateDBClusterSnapshot", params)
+}
+
+func (f *RDS) DeleteDBClusterSnapshot(ctx context.Context, params *svcsdk.DeleteDBClusterSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBClusterSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DeleteDBClusterSnapshotOutput{}, f.record("DeleteDBClusterSnapshot", params)
+}
+
+func (f *RDS) DescribeDBClusterSnapshotAttributes(ctx context.Context, params *svcsdk.DescribeDBClusterSnapshotAttributesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterSnapshotAttributesOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBClusterSnapshotAttributes", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.DBClusterSnapshotIdentifier)
+	if _, ok := f.DBClusterSnapshots[id]; !ok {
+		return nil, NewAPIError("DBClusterSnapshotNotFoundFault", "DB cluster snapshot not found")
+	}
+	attrs := []svcsdktypes.DBClusterSnapshotAttribute{}
+	for _, name := range sortedKeys(f.DBClusterSnapshotAttributes[id]) {
+		attrs = append(attrs, svcsdktypes.DBClusterSnapshotAttribute{
+			AttributeName:   aws.String(name),
+			AttributeValues: f.DBClusterSnapshotAttributes[id][name],
+		})
+	}
+	return &svcsdk.DescribeDBClusterSnapshotAttributesOutput{
+		DBClusterSnapshotAttributesResult: &svcsdktypes.DBClusterSnapshotAttributesResult{
+			DBClusterSnapshotAttributes: attrs,
+			DBClusterSnapshotIdentifier: params.DBClusterSnapshotIdentifier,
+		},
+	}, nil
+}
+
+func (f *RDS) DescribeDBClusterSnapshots(ctx context.Context, params *svcsdk.DescribeDBClusterSnapshotsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBClusterSnapshotsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBClusterSnapshots", params); err != nil {
+		return nil, err
+	}
+	snapshots := []svcsdktypes.DBClusterSnapshot{}
+	for _, id := range sortedKeys(f.DBClusterSnapshots) {
+		if params.DBClusterSnapshotIdentifier != nil && id != *params.DBClusterSnapshotIdentifier {
+			continue
+		}
+		snapshot := *f.DBClusterSnapshots[id]
+		snapshot.TagList = f.tagList(snapshot.DBClusterSnapshotArn)
+		snapshots = append(snapshots, snapshot)
+	}
+	if params.DBClusterSnapshotIdentifier != nil && len(snapshots) == 0 {
+		return nil, NewAPIError("DBClusterSnapshotNotFoundFault", "DB cluster snapshot not found")
+	}
+	return &svcsdk.DescribeDBClusterSnapshotsOutput{DBClusterSnapshots: snapshots}, nil
+}
+
+func (f *RDS) ModifyDBClusterSnapshotAttribute(ctx context.Context, params *svcsdk.ModifyDBClusterSnapshotAttributeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBClusterSnapshotAttributeOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBClusterSnapshotAttribute", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.DBClusterSnapshotIdentifier)
+	if _, ok := f.DBClusterSnapshots[id]; !ok {
+		return nil, NewAPIError("DBClusterSnapshotNotFoundFault", "DB cluster snapshot not found")
+	}
+	if f.DBClusterSnapshotAttributes[id] == nil {
+		f.DBClusterSnapshotAttributes[id] = map[string][]string{}
+	}
+	name := aws.ToString(params.AttributeName)
+	attrs := f.DBClusterSnapshotAttributes[id]
+	attrs[name] = modifyAttributeValues(attrs[name], params.ValuesToAdd, params.ValuesToRemove)
+	return &svcsdk.ModifyDBClusterSnapshotAttributeOutput{}, nil
+}
+
+// DBInstance
+
+func (f *RDS) addDBInstance(
+	id *string,
+	tags []svcsdktypes.Tag,
+) (*svcsdktypes.DBInstance, error) {
+	if _, ok := f.DBInstances[aws.ToString(id)]; ok {
+		return nil, NewAPIError("DBInstanceAlreadyExists", "DB instance already exists")
+	}
+	instance := &svcsdktypes.DBInstance{
+		DBInstanceIdentifier: id,
+		DBInstanceArn:        f.arn("db", id),
+		DBInstanceStatus:     aws.String(StatusCreating),
+	}
+	f.DBInstances[aws.ToString(id)] = instance
+	f.Tags[*instance.DBInstanceArn] = append([]svcsdktypes.Tag{}, tags...)
+	return instance, nil
+}
+
+func parameterGroupStatuses(name *string) []svcsdktypes.DBParameterGroupStatus {
+	if name == nil {
+		return nil
+	}
+	return []svcsdktypes.DBParameterGroupStatus{{
+		DBParameterGroupName: name,
+		ParameterApplyStatus: aws.String(ParameterApplyStatusInSync),
+	}}
+}
+
+func (f *RDS) AddRoleToDBInstance(ctx context.Context, params *svcsdk.AddRoleToDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddRoleToDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("AddRoleToDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	for _, role := range instance.AssociatedRoles {
+		if aws.ToString(role.RoleArn) == aws.ToString(params.RoleArn) &&
+			aws.ToString(role.FeatureName) == aws.ToString(params.FeatureName) {
+			return nil, NewAPIError("DBInstanceRoleAlreadyExists", "role is already associated with the DB instance")
+		}
+	}
+	instance.AssociatedRoles = append(instance.AssociatedRoles, svcsdktypes.DBInstanceRole{
+		FeatureName: params.FeatureName,
+		RoleArn:     params.RoleArn,
+		Status:      aws.String(RoleStatusActive),
+	})
+	return &svcsdk.AddRoleToDBInstanceOutput{}, nil
+}
+
+func (f *RDS) CreateDBInstance(ctx context.Context, params *svcsdk.CreateDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, err := f.addDBInstance(params.DBInstanceIdentifier, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	instance.DBInstanceClass = params.DBInstanceClass
+	instance.DBClusterIdentifier = params.DBClusterIdentifier
+	instance.Engine = params.Engine
+	instance.EngineVersion = params.EngineVersion
+	instance.DBParameterGroups = parameterGroupStatuses(params.DBParameterGroupName)
+	return &svcsdk.CreateDBInstanceOutput{DBInstance: instance}, nil
+}
+
+func (f *RDS) CreateDBInstanceReadReplica(ctx context.Context, params *svcsdk.CreateDBInstanceReadReplicaInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBInstanceReadReplicaOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBInstanceReadReplica", params); err != nil {
+		return nil, err
+	}
+	source, ok := f.DBInstances[aws.ToString(params.SourceDBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "source DB instance not found")
+	}
+	instance, err := f.addDBInstance(params.DBInstanceIdentifier, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	instance.DBInstanceClass = params.DBInstanceClass
+	instance.Engine = source.Engine
+	instance.EngineVersion = source.EngineVersion
+	instance.ReadReplicaSourceDBInstanceIdentifier = source.DBInstanceIdentifier
+	instance.DBParameterGroups = parameterGroupStatuses(params.DBParameterGroupName)
+	source.ReadReplicaDBInstanceIdentifiers = append(
+		source.ReadReplicaDBInstanceIdentifiers, aws.ToString(params.DBInstanceIdentifier),
+	)
+	return &svcsdk.CreateDBInstanceReadReplicaOutput{DBInstance: instance}, nil
+}
+
+func (f *RDS) DeleteDBInstance(ctx context.Context, params *svcsdk.DeleteDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	instance.DBInstanceStatus = aws.String(StatusDeleting)
+	return &svcsdk.DeleteDBInstanceOutput{DBInstance: instance}, nil
+}
+
+// describedDBInstance returns a copy of the DB instance with its current tags.
+func (f *RDS) describedDBInstance(instance *svcsdktypes.DBInstance) svcsdktypes.DBInstance {
+	described := *instance
+	described.TagList = f.tagList(instance.DBInstanceArn)
+	return described
+}
+
+func (f *RDS) DescribeDBInstances(ctx context.Context, params *svcsdk.DescribeDBInstancesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBInstancesOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBInstances", params); err != nil {
+		return nil, err
+	}
+	if params.DBInstanceIdentifier != nil {
+		instance, ok := f.DBInstances[*params.DBInstanceIdentifier]
+		if !ok {
+			return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+		}
+		return &svcsdk.DescribeDBInstancesOutput{
+			DBInstances: []svcsdktypes.DBInstance{f.describedDBInstance(instance)},
+		}, nil
+	}
+	ids, filtered := filterValues(params.Filters, "db-instance-id")
+	instances := []svcsdktypes.DBInstance{}
+	for _, id := range sortedKeys(f.DBInstances) {
+		if !filtered || slices.Contains(ids, id) {
+			instances = append(instances, f.describedDBInstance(f.DBInstances[id]))
+		}
+	}
+	page, marker := paginate(instances, params.Marker, f.pageSize(params.MaxRecords))
+	return &svcsdk.DescribeDBInstancesOutput{DBInstances: page, Marker: marker}, nil
+}
+
+func (f *RDS) ModifyDBInstance(ctx context.Context, params *svcsdk.ModifyDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	if params.DBInstanceClass != nil {
+		instance.DBInstanceClass = params.DBInstanceClass
+	}
+	if params.EngineVersion != nil {
+		instance.EngineVersion = params.EngineVersion
+	}
+	if params.DBParameterGroupName != nil {
+		instance.DBParameterGroups = parameterGroupStatuses(params.DBParameterGroupName)
+	}
+	return &svcsdk.ModifyDBInstanceOutput{DBInstance: instance}, nil
+}
+
+// RebootDBInstance puts the instance in the rebooting status. The reboot
+// completes immediately as far as its parameter groups, and its cluster
+// membership's parameter group, are concerned.
+func (f *RDS) RebootDBInstance(ctx context.Context, params *svcsdk.RebootDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RebootDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RebootDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	if aws.ToString(instance.DBInstanceStatus) != StatusAvailable {
+		return nil, NewAPIError("InvalidDBInstanceState", "DB instance is not available")
+	}
+	instance.DBInstanceStatus = aws.String(StatusRebooting)
+	for i := range instance.DBParameterGroups {
+		instance.DBParameterGroups[i].ParameterApplyStatus = aws.String(ParameterApplyStatusInSync)
+	}
+	if cluster, ok := f.DBClusters[aws.ToString(instance.DBClusterIdentifier)]; ok {
+		for i, m := range cluster.DBClusterMembers {
+			if aws.ToString(m.DBInstanceIdentifier) == aws.ToString(instance.DBInstanceIdentifier) {
+				cluster.DBClusterMembers[i].DBClusterParameterGroupStatus = aws.String(ParameterApplyStatusInSync)
+			}
+		}
+	}
+	return &svcsdk.RebootDBInstanceOutput{DBInstance: instance}, nil
+}
+
+func (f *RDS) RemoveRoleFromDBInstance(ctx context.Context, params *svcsdk.RemoveRoleFromDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveRoleFromDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RemoveRoleFromDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	for i, role := range instance.AssociatedRoles {
+		if aws.ToString(role.RoleArn) == aws.ToString(params.RoleArn) &&
+			aws.ToString(role.FeatureName) == aws.ToString(params.FeatureName) {
+			instance.AssociatedRoles = append(instance.AssociatedRoles[:i], instance.AssociatedRoles[i+1:]...)
+			return &svcsdk.RemoveRoleFromDBInstanceOutput{}, nil
+		}
+	}
+	return nil, NewAPIError("DBInstanceRoleNotFound", "role is not associated with the DB instance")
+}
+
+func (f *RDS) RestoreDBInstanceFromDBSnapshot(ctx context.Context, params *svcsdk.RestoreDBInstanceFromDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBInstanceFromDBSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RestoreDBInstanceFromDBSnapshot", params); err != nil {
+		return nil, err
+	}
+	instance, err := f.addDBInstance(params.DBInstanceIdentifier, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	instance.DBInstanceClass = params.DBInstanceClass
+	instance.Engine = params.Engine
+	instance.DBParameterGroups = parameterGroupStatuses(params.DBParameterGroupName)
+	return &svcsdk.RestoreDBInstanceFromDBSnapshotOutput{DBInstance: instance}, nil
+}
+
+// RestoreDBInstanceToPointInTime creates a DB instance from the source DB
+// instance, looked up by identifier or resource ID. Automated backups
+// replicated from another region aren't modelled, so a restore from an
+// automated backups ARN only creates the DB instance.
+func (f *RDS) RestoreDBInstanceToPointInTime(ctx context.Context, params *svcsdk.RestoreDBInstanceToPointInTimeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RestoreDBInstanceToPointInTimeOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RestoreDBInstanceToPointInTime", params); err != nil {
+		return nil, err
+	}
+	var source *svcsdktypes.DBInstance
+	switch {
+	case params.SourceDBInstanceIdentifier != nil:
+		source = f.DBInstances[*params.SourceDBInstanceIdentifier]
+	case params.SourceDbiResourceId != nil:
+		for _, instance := range f.DBInstances {
+			if aws.ToString(instance.DbiResourceId) == *params.SourceDbiResourceId {
+				source = instance
+			}
+		}
+	case params.SourceDBInstanceAutomatedBackupsArn != nil:
+		source = &svcsdktypes.DBInstance{Engine: params.Engine}
+	}
+	if source == nil {
+		return nil, NewAPIError("DBInstanceNotFound", "source DB instance not found")
+	}
+	instance, err := f.addDBInstance(params.TargetDBInstanceIdentifier, params.Tags)
+	if err != nil {
+		return nil, err
+	}
+	instance.DBInstanceClass = params.DBInstanceClass
+	instance.Engine = source.Engine
+	instance.EngineVersion = source.EngineVersion
+	instance.DBParameterGroups = parameterGroupStatuses(params.DBParameterGroupName)
+	return &svcsdk.RestoreDBInstanceToPointInTimeOutput{DBInstance: instance}, nil
+}
+
+// StartDBInstance puts a stopped instance in the starting status.
+func (f *RDS) StartDBInstance(ctx context.Context, params *svcsdk.StartDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StartDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("StartDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	if aws.ToString(instance.DBInstanceStatus) != StatusStopped {
+		return nil, NewAPIError("InvalidDBInstanceState", "DB instance is not stopped")
+	}
+	instance.DBInstanceStatus = aws.String(StatusStarting)
+	instance.AutomaticRestartTime = nil
+	return &svcsdk.StartDBInstanceOutput{DBInstance: instance}, nil
+}
+
+// StopDBInstance puts an available instance in the stopping status.
+func (f *RDS) StopDBInstance(ctx context.Context, params *svcsdk.StopDBInstanceInput, optFns ...func(*svcsdk.Options)) (*svcsdk.StopDBInstanceOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("StopDBInstance", params); err != nil {
+		return nil, err
+	}
+	instance, ok := f.DBInstances[aws.ToString(params.DBInstanceIdentifier)]
+	if !ok {
+		return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+	}
+	if aws.ToString(instance.DBInstanceStatus) != StatusAvailable {
+		return nil, NewAPIError("InvalidDBInstanceState", "DB instance is not available")
+	}
+	instance.DBInstanceStatus = aws.String(StatusStopping)
+	return &svcsdk.StopDBInstanceOutput{DBInstance: instance}, nil
+}
+
+// DBParameterGroup
+
+func (f *RDS) CreateDBParameterGroup(ctx context.Context, params *svcsdk.CreateDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBParameterGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBParameterGroupName)
+	if _, ok := f.DBParameterGroups[name]; ok {
+		return nil, NewAPIError("DBParameterGroupAlreadyExists", "DB parameter group already exists")
+	}
+	f.DBParameterGroups[name] = f.newParameterGroup(aws.ToString(params.DBParameterGroupFamily))
+	arn := f.arn("pg", params.DBParameterGroupName)
+	f.Tags[*arn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateDBParameterGroupOutput{
+		DBParameterGroup: &svcsdktypes.DBParameterGroup{
+			DBParameterGroupArn:    arn,
+			DBParameterGroupName:   params.DBParameterGroupName,
+			DBParameterGroupFamily: params.DBParameterGroupFamily,
+			Description:            params.Description,
+		},
+	}, nil
+}
+
+func (f *RDS) DeleteDBParameterGroup(ctx context.Context, params *svcsdk.DeleteDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBParameterGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBParameterGroupName)
+	if _, ok := f.DBParameterGroups[name]; !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB parameter group not found")
+	}
+	delete(f.DBParameterGroups, name)
+	return &svcsdk.DeleteDBParameterGroupOutput{}, nil
+}
+
+func (f *RDS) DescribeDBParameterGroups(ctx context.Context, params *svcsdk.DescribeDBParameterGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBParameterGroupsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBParameterGroups", params); err != nil {
+		return nil, err
+	}
+	names := sortedKeys(f.DBParameterGroups)
+	if params.DBParameterGroupName != nil {
+		if _, ok := f.DBParameterGroups[*params.DBParameterGroupName]; !ok {
+			return nil, NewAPIError("DBParameterGroupNotFound", "DB parameter group not found")
+		}
+		names = []string{*params.DBParameterGroupName}
+	}
+	groups := []svcsdktypes.DBParameterGroup{}
+	for _, name := range names {
+		groups = append(groups, svcsdktypes.DBParameterGroup{
+			DBParameterGroupArn:    f.arn("pg", aws.String(name)),
+			DBParameterGroupName:   aws.String(name),
+			DBParameterGroupFamily: aws.String(f.DBParameterGroups[name].Family),
+		})
+	}
+	return &svcsdk.DescribeDBParameterGroupsOutput{DBParameterGroups: groups}, nil
+}
+
+func (f *RDS) DescribeDBParameters(ctx context.Context, params *svcsdk.DescribeDBParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBParametersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBParameters", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBParameterGroups[aws.ToString(params.DBParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB parameter group not found")
+	}
+	page, marker := paginate(pg.sortedParameters(), params.Marker, f.PageSize)
+	return &svcsdk.DescribeDBParametersOutput{Parameters: page, Marker: marker}, nil
+}
+
+func (f *RDS) DescribeEngineDefaultParameters(ctx context.Context, params *svcsdk.DescribeEngineDefaultParametersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEngineDefaultParametersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeEngineDefaultParameters", params); err != nil {
+		return nil, err
+	}
+	page, marker := paginate(f.EngineDefaults[aws.ToString(params.DBParameterGroupFamily)], params.Marker, f.PageSize)
+	return &svcsdk.DescribeEngineDefaultParametersOutput{
+		EngineDefaults: &svcsdktypes.EngineDefaults{
+			DBParameterGroupFamily: params.DBParameterGroupFamily,
+			Parameters:             page,
+			Marker:                 marker,
+		},
+	}, nil
+}
+
+func (f *RDS) ModifyDBParameterGroup(ctx context.Context, params *svcsdk.ModifyDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBParameterGroup", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBParameterGroups[aws.ToString(params.DBParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB parameter group not found")
+	}
+	if err := pg.modify(params.Parameters); err != nil {
+		return nil, err
+	}
+	return &svcsdk.ModifyDBParameterGroupOutput{
+		DBParameterGroupName: params.DBParameterGroupName,
+	}, nil
+}
+
+func (f *RDS) ResetDBParameterGroup(ctx context.Context, params *svcsdk.ResetDBParameterGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ResetDBParameterGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ResetDBParameterGroup", params); err != nil {
+		return nil, err
+	}
+	pg, ok := f.DBParameterGroups[aws.ToString(params.DBParameterGroupName)]
+	if !ok {
+		return nil, NewAPIError("DBParameterGroupNotFound", "DB parameter group not found")
+	}
+	if err := pg.reset(f.EngineDefaults[pg.Family], params.Parameters); err != nil {
+		return nil, err
+	}
+	return &svcsdk.ResetDBParameterGroupOutput{
+		DBParameterGroupName: params.DBParameterGroupName,
+	}, nil
+}
+
+// DBProxy
+
+func (f *RDS) CreateDBProxy(ctx context.Context, params *svcsdk.CreateDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBProxyOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBProxy", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if _, ok := f.DBProxies[name]; ok {
+		return nil, NewAPIError("DBProxyAlreadyExistsFault", "DB proxy already exists")
+	}
+	proxy := &svcsdktypes.DBProxy{
+		DBProxyName:         params.DBProxyName,
+		DBProxyArn:          f.arn("db-proxy", params.DBProxyName),
+		EngineFamily:        aws.String(string(params.EngineFamily)),
+		RoleArn:             params.RoleArn,
+		Status:              svcsdktypes.DBProxyStatusCreating,
+		VpcSubnetIds:        params.VpcSubnetIds,
+		VpcSecurityGroupIds: params.VpcSecurityGroupIds,
+	}
+	f.DBProxies[name] = proxy
+	f.DBProxyTargetGroups[name] = &svcsdktypes.DBProxyTargetGroup{
+		DBProxyName:     params.DBProxyName,
+		TargetGroupName: aws.String(DefaultTargetGroupName),
+		IsDefault:       aws.Bool(true),
+		Status:          aws.String(StatusAvailable),
+		ConnectionPoolConfig: &svcsdktypes.ConnectionPoolConfigurationInfo{
+			ConnectionBorrowTimeout:   aws.Int32(120),
+			MaxConnectionsPercent:     aws.Int32(100),
+			MaxIdleConnectionsPercent: aws.Int32(50),
+		},
+	}
+	f.Tags[*proxy.DBProxyArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateDBProxyOutput{DBProxy: proxy}, nil
+}
+
+func (f *RDS) DeleteDBProxy(ctx context.Context, params *svcsdk.DeleteDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBProxyOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBProxy", params); err != nil {
+		return nil, err
+	}
+	proxy, ok := f.DBProxies[aws.ToString(params.DBProxyName)]
+	if !ok {
+		return nil, NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+	}
+	proxy.Status = svcsdktypes.DBProxyStatusDeleting
+	return &svcsdk.DeleteDBProxyOutput{DBProxy: proxy}, nil
+}
+
+// DeregisterDBProxyTargets removes DB instances and DB clusters from the
+// default target group. Deregistering a DB cluster also removes the targets
+// of its DB instances.
+func (f *RDS) DeregisterDBProxyTargets(ctx context.Context, params *svcsdk.DeregisterDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeregisterDBProxyTargetsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeregisterDBProxyTargets", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if err := f.checkDBProxyTargetGroup(name, params.TargetGroupName); err != nil {
+		return nil, err
+	}
+	for _, id := range params.DBInstanceIdentifiers {
+		if f.dbProxyTarget(name, id, svcsdktypes.TargetTypeRdsInstance) == nil {
+			return nil, NewAPIError("DBProxyTargetNotFoundFault", "DB instance is not registered with the DB proxy")
+		}
+	}
+	for _, id := range params.DBClusterIdentifiers {
+		if f.dbProxyTarget(name, id, svcsdktypes.TargetTypeTrackedCluster) == nil {
+			return nil, NewAPIError("DBProxyTargetNotFoundFault", "DB cluster is not registered with the DB proxy")
+		}
+	}
+	targets := []svcsdktypes.DBProxyTarget{}
+	for _, t := range f.DBProxyTargets[name] {
+		id := aws.ToString(t.RdsResourceId)
+		switch {
+		case t.Type == svcsdktypes.TargetTypeTrackedCluster && inStrings(id, params.DBClusterIdentifiers):
+		case t.TrackedClusterId != nil && inStrings(*t.TrackedClusterId, params.DBClusterIdentifiers):
+		case t.TrackedClusterId == nil && inStrings(id, params.DBInstanceIdentifiers):
+		default:
+			targets = append(targets, t)
+		}
+	}
+	f.DBProxyTargets[name] = targets
+	return &svcsdk.DeregisterDBProxyTargetsOutput{}, nil
+}
+
+func (f *RDS) DescribeDBProxies(ctx context.Context, params *svcsdk.DescribeDBProxiesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxiesOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBProxies", params); err != nil {
+		return nil, err
+	}
+	if params.DBProxyName != nil {
+		proxy, ok := f.DBProxies[*params.DBProxyName]
+		if !ok {
+			return nil, NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+		}
+		return &svcsdk.DescribeDBProxiesOutput{
+			DBProxies: []svcsdktypes.DBProxy{*proxy},
+		}, nil
+	}
+	proxies := []svcsdktypes.DBProxy{}
+	for _, name := range sortedKeys(f.DBProxies) {
+		proxies = append(proxies, *f.DBProxies[name])
+	}
+	return &svcsdk.DescribeDBProxiesOutput{DBProxies: proxies}, nil
+}
+
+func (f *RDS) DescribeDBProxyTargetGroups(ctx context.Context, params *svcsdk.DescribeDBProxyTargetGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyTargetGroupsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBProxyTargetGroups", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if err := f.checkDBProxyTargetGroup(name, params.TargetGroupName); err != nil {
+		return nil, err
+	}
+	return &svcsdk.DescribeDBProxyTargetGroupsOutput{
+		TargetGroups: []svcsdktypes.DBProxyTargetGroup{*f.DBProxyTargetGroups[name]},
+	}, nil
+}
+
+func (f *RDS) DescribeDBProxyTargets(ctx context.Context, params *svcsdk.DescribeDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyTargetsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBProxyTargets", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if err := f.checkDBProxyTargetGroup(name, params.TargetGroupName); err != nil {
+		return nil, err
+	}
+	targets := append([]svcsdktypes.DBProxyTarget{}, f.DBProxyTargets[name]...)
+	return &svcsdk.DescribeDBProxyTargetsOutput{Targets: targets}, nil
+}
+
+func (f *RDS) ModifyDBProxy(ctx context.Context, params *svcsdk.ModifyDBProxyInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBProxy", params); err != nil {
+		return nil, err
+	}
+	proxy, ok := f.DBProxies[aws.ToString(params.DBProxyName)]
+	if !ok {
+		return nil, NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+	}
+	if params.DebugLogging != nil {
+		proxy.DebugLogging = params.DebugLogging
+	}
+	if params.IdleClientTimeout != nil {
+		proxy.IdleClientTimeout = params.IdleClientTimeout
+	}
+	if params.RequireTLS != nil {
+		proxy.RequireTLS = params.RequireTLS
+	}
+	if params.RoleArn != nil {
+		proxy.RoleArn = params.RoleArn
+	}
+	return &svcsdk.ModifyDBProxyOutput{DBProxy: proxy}, nil
+}
+
+func (f *RDS) ModifyDBProxyTargetGroup(ctx context.Context, params *svcsdk.ModifyDBProxyTargetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyTargetGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBProxyTargetGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if err := f.checkDBProxyTargetGroup(name, params.TargetGroupName); err != nil {
+		return nil, err
+	}
+	group := f.DBProxyTargetGroups[name]
+	if in := params.ConnectionPoolConfig; in != nil {
+		cfg := group.ConnectionPoolConfig
+		if in.ConnectionBorrowTimeout != nil {
+			cfg.ConnectionBorrowTimeout = in.ConnectionBorrowTimeout
+		}
+		if in.InitQuery != nil {
+			cfg.InitQuery = in.InitQuery
+		}
+		if in.MaxConnectionsPercent != nil {
+			cfg.MaxConnectionsPercent = in.MaxConnectionsPercent
+		}
+		if in.MaxIdleConnectionsPercent != nil {
+			cfg.MaxIdleConnectionsPercent = in.MaxIdleConnectionsPercent
+		}
+		if in.SessionPinningFilters != nil {
+			cfg.SessionPinningFilters = in.SessionPinningFilters
+		}
+	}
+	return &svcsdk.ModifyDBProxyTargetGroupOutput{DBProxyTargetGroup: group}, nil
+}
+
+// RegisterDBProxyTargets adds DB instances and DB clusters to the default
+// target group. A DB cluster is registered as a TRACKED_CLUSTER target along
+// with one RDS_INSTANCE target for each of its members. New targets are in
+// the REGISTERING state; tests can update DBProxyTargets to simulate health
+// checks.
+func (f *RDS) RegisterDBProxyTargets(ctx context.Context, params *svcsdk.RegisterDBProxyTargetsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RegisterDBProxyTargetsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RegisterDBProxyTargets", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.DBProxyName)
+	if err := f.checkDBProxyTargetGroup(name, params.TargetGroupName); err != nil {
+		return nil, err
+	}
+	if f.DBProxies[name].Status != svcsdktypes.DBProxyStatusAvailable {
+		return nil, NewAPIError("InvalidDBProxyStateFault", "DB proxy is not available")
+	}
+	registered := []svcsdktypes.DBProxyTarget{}
+	for _, id := range params.DBInstanceIdentifiers {
+		instance, ok := f.DBInstances[id]
+		if !ok {
+			return nil, NewAPIError("DBInstanceNotFound", "DB instance not found")
+		}
+		if f.dbProxyTarget(name, id, svcsdktypes.TargetTypeRdsInstance) != nil {
+			return nil, NewAPIError("DBProxyTargetAlreadyRegisteredFault", "DB instance is already registered with the DB proxy")
+		}
+		registered = append(registered, instanceProxyTarget(instance, nil))
+	}
+	for _, id := range params.DBClusterIdentifiers {
+		cluster, ok := f.DBClusters[id]
+		if !ok {
+			return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster not found")
+		}
+		if f.dbProxyTarget(name, id, svcsdktypes.TargetTypeTrackedCluster) != nil {
+			return nil, NewAPIError("DBProxyTargetAlreadyRegisteredFault", "DB cluster is already registered with the DB proxy")
+		}
+		registered = append(registered, svcsdktypes.DBProxyTarget{
+			RdsResourceId: cluster.DBClusterIdentifier,
+			TargetArn:     cluster.DBClusterArn,
+			Type:          svcsdktypes.TargetTypeTrackedCluster,
+		})
+		for _, member := range cluster.DBClusterMembers {
+			if instance, ok := f.DBInstances[aws.ToString(member.DBInstanceIdentifier)]; ok {
+				registered = append(registered, instanceProxyTarget(instance, cluster.DBClusterIdentifier))
+			}
+		}
+	}
+	f.DBProxyTargets[name] = append(f.DBProxyTargets[name], registered...)
+	return &svcsdk.RegisterDBProxyTargetsOutput{DBProxyTargets: registered}, nil
+}
+
+// checkDBProxyTargetGroup returns the error RDS returns when the DB proxy or
+// its target group doesn't exist. Only the default target group is modelled.
+func (f *RDS) checkDBProxyTargetGroup(name string, targetGroup *string) error {
+	if _, ok := f.DBProxies[name]; !ok {
+		return NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+	}
+	if targetGroup != nil && *targetGroup != DefaultTargetGroupName {
+		return NewAPIError("DBProxyTargetGroupNotFoundFault", "DB proxy target group not found")
+	}
+	return nil
+}
+
+// dbProxyTarget returns the target of the DB proxy for the supplied DB
+// instance or DB cluster, or nil if it isn't registered. DB instances that
+// are registered through their DB cluster are ignored.
+func (f *RDS) dbProxyTarget(
+	name string,
+	id string,
+	targetType svcsdktypes.TargetType,
+) *svcsdktypes.DBProxyTarget {
+	for i, t := range f.DBProxyTargets[name] {
+		if t.Type == targetType && t.TrackedClusterId == nil &&
+			aws.ToString(t.RdsResourceId) == id {
+			return &f.DBProxyTargets[name][i]
+		}
+	}
+	return nil
+}
+
+func instanceProxyTarget(
+	instance *svcsdktypes.DBInstance,
+	trackedClusterID *string,
+) svcsdktypes.DBProxyTarget {
+	target := svcsdktypes.DBProxyTarget{
+		RdsResourceId:    instance.DBInstanceIdentifier,
+		TargetArn:        instance.DBInstanceArn,
+		TrackedClusterId: trackedClusterID,
+		Type:             svcsdktypes.TargetTypeRdsInstance,
+		Role:             svcsdktypes.TargetRoleReadWrite,
+		TargetHealth: &svcsdktypes.TargetHealth{
+			State: svcsdktypes.TargetStateRegistering,
+		},
+	}
+	if instance.Endpoint != nil {
+		target.Endpoint = instance.Endpoint.Address
+		target.Port = instance.Endpoint.Port
+	}
+	return target
+}
+
+func inStrings(s string, list []string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// DBProxyEndpoint
+
+func (f *RDS) CreateDBProxyEndpoint(ctx context.Context, params *svcsdk.CreateDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBProxyEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateDBProxyEndpoint", params); err != nil {
+		return nil, err
+	}
+	proxy, ok := f.DBProxies[aws.ToString(params.DBProxyName)]
+	if !ok {
+		return nil, NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+	}
+	name := aws.ToString(params.DBProxyEndpointName)
+	if _, ok := f.DBProxyEndpoints[name]; ok {
+		return nil, NewAPIError("DBProxyEndpointAlreadyExistsFault", "DB proxy endpoint already exists")
+	}
+	role := params.TargetRole
+	if role == "" {
+		role = svcsdktypes.DBProxyEndpointTargetRoleReadWrite
+	}
+	securityGroups := params.VpcSecurityGroupIds
+	if len(securityGroups) == 0 {
+		securityGroups = proxy.VpcSecurityGroupIds
+	}
+	endpoint := &svcsdktypes.DBProxyEndpoint{
+		DBProxyEndpointName: params.DBProxyEndpointName,
+		DBProxyEndpointArn:  f.arn("db-proxy-endpoint", params.DBProxyEndpointName),
+		DBProxyName:         params.DBProxyName,
+		Endpoint: aws.String(fmt.Sprintf(
+			"%s.endpoint.proxy-xxxxxxxxxxxx.%s.rds.amazonaws.com", name, f.Region,
+		)),
+		IsDefault:           aws.Bool(false),
+		Status:              svcsdktypes.DBProxyEndpointStatusCreating,
+		TargetRole:          role,
+		VpcId:               proxy.VpcId,
+		VpcSecurityGroupIds: securityGroups,
+		VpcSubnetIds:        params.VpcSubnetIds,
+	}
+	f.DBProxyEndpoints[name] = endpoint
+	f.Tags[*endpoint.DBProxyEndpointArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateDBProxyEndpointOutput{DBProxyEndpoint: endpoint}, nil
+}
+
+func (f *RDS) DeleteDBProxyEndpoint(ctx context.Context, params *svcsdk.DeleteDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBProxyEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteDBProxyEndpoint", params); err != nil {
+		return nil, err
+	}
+	endpoint, ok := f.DBProxyEndpoints[aws.ToString(params.DBProxyEndpointName)]
+	if !ok {
+		return nil, NewAPIError("DBProxyEndpointNotFoundFault", "DB proxy endpoint not found")
+	}
+	endpoint.Status = svcsdktypes.DBProxyEndpointStatusDeleting
+	return &svcsdk.DeleteDBProxyEndpointOutput{DBProxyEndpoint: endpoint}, nil
+}
+
+func (f *RDS) DescribeDBProxyEndpoints(ctx context.Context, params *svcsdk.DescribeDBProxyEndpointsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBProxyEndpointsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBProxyEndpoints", params); err != nil {
+		return nil, err
+	}
+	if params.DBProxyName != nil {
+		if _, ok := f.DBProxies[*params.DBProxyName]; !ok {
+			return nil, NewAPIError("DBProxyNotFoundFault", "DB proxy not found")
+		}
+	}
+	endpoints := []svcsdktypes.DBProxyEndpoint{}
+	for _, name := range sortedKeys(f.DBProxyEndpoints) {
+		endpoint := f.DBProxyEndpoints[name]
+		if params.DBProxyName != nil && aws.ToString(endpoint.DBProxyName) != *params.DBProxyName {
+			continue
+		}
+		if params.DBProxyEndpointName != nil && name != *params.DBProxyEndpointName {
+			continue
+		}
+		endpoints = append(endpoints, *endpoint)
+	}
+	if params.DBProxyEndpointName != nil && len(endpoints) == 0 {
+		return nil, NewAPIError("DBProxyEndpointNotFoundFault", "DB proxy endpoint not found")
+	}
+	return &svcsdk.DescribeDBProxyEndpointsOutput{DBProxyEndpoints: endpoints}, nil
+}
+
+func (f *RDS) ModifyDBProxyEndpoint(ctx context.Context, params *svcsdk.ModifyDBProxyEndpointInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBProxyEndpointOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBProxyEndpoint", params); err != nil {
+		return nil, err
+	}
+	endpoint, ok := f.DBProxyEndpoints[aws.ToString(params.DBProxyEndpointName)]
+	if !ok {
+		return nil, NewAPIError("DBProxyEndpointNotFoundFault", "DB proxy endpoint not found")
+	}
+	if params.VpcSecurityGroupIds != nil {
+		endpoint.VpcSecurityGroupIds = params.VpcSecurityGroupIds
+	}
+	endpoint.Status = svcsdktypes.DBProxyEndpointStatusModifying
+	return &svcsdk.ModifyDBProxyEndpointOutput{DBProxyEndpoint: endpoint}, nil
+}
+
+// DBSnapshot
+
+func (f *RDS) CopyDBSnapshot(ctx context.Context, params *svcsdk.CopyDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CopyDBSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CopyDBSnapshot", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.TargetDBSnapshotIdentifier)
+	if _, ok := f.DBSnapshots[id]; ok {
+		return nil, NewAPIError("DBSnapshotAlreadyExists", "DB snapshot already exists")
+	}
+	snapshot := &svcsdktypes.DBSnapshot{
+		DBSnapshotArn:              f.arn("snapshot", params.TargetDBSnapshotIdentifier),
+		DBSnapshotIdentifier:       params.TargetDBSnapshotIdentifier,
+		KmsKeyId:                   params.KmsKeyId,
+		OptionGroupName:            params.OptionGroupName,
+		PercentProgress:            aws.Int32(0),
+		SnapshotType:               aws.String("manual"),
+		SourceDBSnapshotIdentifier: params.SourceDBSnapshotIdentifier,
+		SourceRegion:               params.SourceRegion,
+		Status:                     aws.String("pending"),
+	}
+	f.DBSnapshots[id] = snapshot
+	f.Tags[*snapshot.DBSnapshotArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CopyDBSnapshotOutput{DBSnapshot: snapshot}, nil
+}
+
+func (f *RDS) CreateDBSnapshot(ctx context.Context, params *svcsdk.CreateDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.CreateDBSnapshotOutput{}, f.record("CreateDBSnapshot", params)
+}
+
+func (f *RDS) DeleteDBSnapshot(ctx context.Context, params *svcsdk.DeleteDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DeleteDBSnapshotOutput{}, f.record("DeleteDBSnapshot", params)
+}
+
+func (f *RDS) DescribeDBSnapshotAttributes(ctx context.Context, params *svcsdk.DescribeDBSnapshotAttributesInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSnapshotAttributesOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBSnapshotAttributes", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.DBSnapshotIdentifier)
+	if _, ok := f.DBSnapshots[id]; !ok {
+		return nil, NewAPIError("DBSnapshotNotFound", "DB snapshot not found")
+	}
+	attrs := []svcsdktypes.DBSnapshotAttribute{}
+	for _, name := range sortedKeys(f.DBSnapshotAttributes[id]) {
+		attrs = append(attrs, svcsdktypes.DBSnapshotAttribute{
+			AttributeName:   aws.String(name),
+			AttributeValues: f.DBSnapshotAttributes[id][name],
+		})
+	}
+	return &svcsdk.DescribeDBSnapshotAttributesOutput{
+		DBSnapshotAttributesResult: &svcsdktypes.DBSnapshotAttributesResult{
+			DBSnapshotAttributes: attrs,
+			DBSnapshotIdentifier: params.DBSnapshotIdentifier,
+		},
+	}, nil
+}
+
+func (f *RDS) DescribeDBSnapshots(ctx context.Context, params *svcsdk.DescribeDBSnapshotsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSnapshotsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeDBSnapshots", params); err != nil {
+		return nil, err
+	}
+	snapshots := []svcsdktypes.DBSnapshot{}
+	for _, id := range sortedKeys(f.DBSnapshots) {
+		if params.DBSnapshotIdentifier != nil && id != *params.DBSnapshotIdentifier {
+			continue
+		}
+		snapshot := *f.DBSnapshots[id]
+		snapshot.TagList = f.tagList(snapshot.DBSnapshotArn)
+		snapshots = append(snapshots, snapshot)
+	}
+	if params.DBSnapshotIdentifier != nil && len(snapshots) == 0 {
+		return nil, NewAPIError("DBSnapshotNotFound", "DB snapshot not found")
+	}
+	return &svcsdk.DescribeDBSnapshotsOutput{DBSnapshots: snapshots}, nil
+}
+
+func (f *RDS) ModifyDBSnapshot(ctx context.Context, params *svcsdk.ModifyDBSnapshotInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSnapshotOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.ModifyDBSnapshotOutput{}, f.record("ModifyDBSnapshot", params)
+}
+
+func (f *RDS) ModifyDBSnapshotAttribute(ctx context.Context, params *svcsdk.ModifyDBSnapshotAttributeInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSnapshotAttributeOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyDBSnapshotAttribute", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.DBSnapshotIdentifier)
+	if _, ok := f.DBSnapshots[id]; !ok {
+		return nil, NewAPIError("DBSnapshotNotFound", "DB snapshot not found")
+	}
+	if f.DBSnapshotAttributes[id] == nil {
+		f.DBSnapshotAttributes[id] = map[string][]string{}
+	}
+	name := aws.ToString(params.AttributeName)
+	attrs := f.DBSnapshotAttributes[id]
+	attrs[name] = modifyAttributeValues(attrs[name], params.ValuesToAdd, params.ValuesToRemove)
+	return &svcsdk.ModifyDBSnapshotAttributeOutput{}, nil
+}
+
+// modifyAttributeValues returns the values of a snapshot attribute with the
+// supplied values added and removed.
+func modifyAttributeValues(values []string, add []string, remove []string) []string {
+	res := []string{}
+	for _, v := range values {
+		if !inStrings(v, remove) {
+			res = append(res, v)
+		}
+	}
+	for _, v := range add {
+		if !inStrings(v, res) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// DBSubnetGroup
+
+func (f *RDS) CreateDBSubnetGroup(ctx context.Context, params *svcsdk.CreateDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateDBSubnetGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.CreateDBSubnetGroupOutput{}, f.record("CreateDBSubnetGroup", params)
+}
+
+func (f *RDS) DeleteDBSubnetGroup(ctx context.Context, params *svcsdk.DeleteDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteDBSubnetGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DeleteDBSubnetGroupOutput{}, f.record("DeleteDBSubnetGroup", params)
+}
+
+func (f *RDS) DescribeDBSubnetGroups(ctx context.Context, params *svcsdk.DescribeDBSubnetGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeDBSubnetGroupsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.DescribeDBSubnetGroupsOutput{}, f.record("DescribeDBSubnetGroups", params)
+}
+
+func (f *RDS) ModifyDBSubnetGroup(ctx context.Context, params *svcsdk.ModifyDBSubnetGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyDBSubnetGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	return &svcsdk.ModifyDBSubnetGroupOutput{}, f.record("ModifyDBSubnetGroup", params)
+}
+
+// EventSubscription
+
+func (f *RDS) AddSourceIdentifierToSubscription(ctx context.Context, params *svcsdk.AddSourceIdentifierToSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.AddSourceIdentifierToSubscriptionOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("AddSourceIdentifierToSubscription", params); err != nil {
+		return nil, err
+	}
+	sub, ok := f.EventSubscriptions[aws.ToString(params.SubscriptionName)]
+	if !ok {
+		return nil, NewAPIError("SubscriptionNotFound", "event subscription not found")
+	}
+	id := aws.ToString(params.SourceIdentifier)
+	if !slices.Contains(sub.SourceIdsList, id) {
+		sub.SourceIdsList = append(slices.Clone(sub.SourceIdsList), id)
+	}
+	return &svcsdk.AddSourceIdentifierToSubscriptionOutput{EventSubscription: sub}, nil
+}
+
+func (f *RDS) CreateEventSubscription(ctx context.Context, params *svcsdk.CreateEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateEventSubscriptionOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateEventSubscription", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.SubscriptionName)
+	if _, ok := f.EventSubscriptions[name]; ok {
+		return nil, NewAPIError("SubscriptionAlreadyExist", "event subscription already exists")
+	}
+	if len(params.SourceIds) > 0 && params.SourceType == nil {
+		return nil, NewAPIError("InvalidParameterValue", "SourceType must be provided with SourceIds")
+	}
+	enabled := params.Enabled
+	if enabled == nil {
+		enabled = aws.Bool(true)
+	}
+	sub := &svcsdktypes.EventSubscription{
+		CustSubscriptionId:       params.SubscriptionName,
+		CustomerAwsId:            aws.String(f.AccountID),
+		Enabled:                  enabled,
+		EventCategoriesList:      params.EventCategories,
+		EventSubscriptionArn:     f.arn("es", params.SubscriptionName),
+		SnsTopicArn:              params.SnsTopicArn,
+		SourceIdsList:            params.SourceIds,
+		SourceType:               params.SourceType,
+		Status:                   aws.String("creating"),
+		SubscriptionCreationTime: aws.String("2024-01-01 00:00:00.000"),
+	}
+	f.EventSubscriptions[name] = sub
+	f.Tags[*sub.EventSubscriptionArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateEventSubscriptionOutput{EventSubscription: sub}, nil
+}
+
+func (f *RDS) DeleteEventSubscription(ctx context.Context, params *svcsdk.DeleteEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteEventSubscriptionOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteEventSubscription", params); err != nil {
+		return nil, err
+	}
+	sub, ok := f.EventSubscriptions[aws.ToString(params.SubscriptionName)]
+	if !ok {
+		return nil, NewAPIError("SubscriptionNotFound", "event subscription not found")
+	}
+	sub.Status = aws.String("deleting")
+	return &svcsdk.DeleteEventSubscriptionOutput{EventSubscription: sub}, nil
+}
+
+func (f *RDS) DescribeEventSubscriptions(ctx context.Context, params *svcsdk.DescribeEventSubscriptionsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeEventSubscriptionsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeEventSubscriptions", params); err != nil {
+		return nil, err
+	}
+	subs := []svcsdktypes.EventSubscription{}
+	for _, name := range sortedKeys(f.EventSubscriptions) {
+		if params.SubscriptionName != nil && name != *params.SubscriptionName {
+			continue
+		}
+		subs = append(subs, *f.EventSubscriptions[name])
+	}
+	if params.SubscriptionName != nil && len(subs) == 0 {
+		return nil, NewAPIError("SubscriptionNotFound", "event subscription not found")
+	}
+	return &svcsdk.DescribeEventSubscriptionsOutput{EventSubscriptionsList: subs}, nil
+}
+
+func (f *RDS) ModifyEventSubscription(ctx context.Context, params *svcsdk.ModifyEventSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyEventSubscriptionOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyEventSubscription", params); err != nil {
+		return nil, err
+	}
+	sub, ok := f.EventSubscriptions[aws.ToString(params.SubscriptionName)]
+	if !ok {
+		return nil, NewAPIError("SubscriptionNotFound", "event subscription not found")
+	}
+	if params.Enabled != nil {
+		sub.Enabled = params.Enabled
+	}
+	if params.EventCategories != nil {
+		sub.EventCategoriesList = params.EventCategories
+	}
+	if params.SnsTopicArn != nil {
+		sub.SnsTopicArn = params.SnsTopicArn
+	}
+	if params.SourceType != nil {
+		sub.SourceType = params.SourceType
+	}
+	sub.Status = aws.String("modifying")
+	return &svcsdk.ModifyEventSubscriptionOutput{EventSubscription: sub}, nil
+}
+
+func (f *RDS) RemoveSourceIdentifierFromSubscription(ctx context.Context, params *svcsdk.RemoveSourceIdentifierFromSubscriptionInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveSourceIdentifierFromSubscriptionOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RemoveSourceIdentifierFromSubscription", params); err != nil {
+		return nil, err
+	}
+	sub, ok := f.EventSubscriptions[aws.ToString(params.SubscriptionName)]
+	if !ok {
+		return nil, NewAPIError("SubscriptionNotFound", "event subscription not found")
+	}
+	id := aws.ToString(params.SourceIdentifier)
+	idx := slices.Index(sub.SourceIdsList, id)
+	if idx < 0 {
+		return nil, NewAPIError("SourceNotFound", "source identifier not found in the subscription")
+	}
+	sub.SourceIdsList = slices.Delete(slices.Clone(sub.SourceIdsList), idx, idx+1)
+	return &svcsdk.RemoveSourceIdentifierFromSubscriptionOutput{EventSubscription: sub}, nil
+}
+
+// GlobalCluster
+
+func (f *RDS) CreateGlobalCluster(ctx context.Context, params *svcsdk.CreateGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.GlobalClusterIdentifier)
+	if _, ok := f.GlobalClusters[id]; ok {
+		return nil, NewAPIError("GlobalClusterAlreadyExistsFault", "global cluster already exists")
+	}
+	gc := &svcsdktypes.GlobalCluster{
+		GlobalClusterIdentifier: params.GlobalClusterIdentifier,
+		GlobalClusterArn:        aws.String(fmt.Sprintf("arn:aws:rds::%s:global-cluster:%s", f.AccountID, id)),
+		DeletionProtection:      params.DeletionProtection,
+		Engine:                  params.Engine,
+		EngineVersion:           params.EngineVersion,
+		Status:                  aws.String(StatusCreating),
+	}
+	f.GlobalClusters[id] = gc
+	return &svcsdk.CreateGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+func (f *RDS) DeleteGlobalCluster(ctx context.Context, params *svcsdk.DeleteGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	id := aws.ToString(params.GlobalClusterIdentifier)
+	gc, ok := f.GlobalClusters[id]
+	if !ok {
+		return nil, NewAPIError("GlobalClusterNotFoundFault", "global cluster not found")
+	}
+	delete(f.GlobalClusters, id)
+	return &svcsdk.DeleteGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+func (f *RDS) DescribeGlobalClusters(ctx context.Context, params *svcsdk.DescribeGlobalClustersInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeGlobalClustersOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeGlobalClusters", params); err != nil {
+		return nil, err
+	}
+	if params.GlobalClusterIdentifier != nil {
+		gc, ok := f.GlobalClusters[*params.GlobalClusterIdentifier]
+		if !ok {
+			return nil, NewAPIError("GlobalClusterNotFoundFault", "global cluster not found")
+		}
+		return &svcsdk.DescribeGlobalClustersOutput{
+			GlobalClusters: []svcsdktypes.GlobalCluster{*gc},
+		}, nil
+	}
+	gcs := []svcsdktypes.GlobalCluster{}
+	for _, id := range sortedKeys(f.GlobalClusters) {
+		gcs = append(gcs, *f.GlobalClusters[id])
+	}
+	return &svcsdk.DescribeGlobalClustersOutput{GlobalClusters: gcs}, nil
+}
+
+// FailoverGlobalCluster starts a failover of the global cluster to the
+// target secondary cluster. The failover stays in progress until a test
+// clears the FailoverState of the global cluster.
+func (f *RDS) FailoverGlobalCluster(ctx context.Context, params *svcsdk.FailoverGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.FailoverGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("FailoverGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	gc, err := f.startGlobalClusterFailover(
+		params.GlobalClusterIdentifier,
+		params.TargetDbClusterIdentifier,
+		aws.ToBool(params.AllowDataLoss),
+		GlobalClusterStatusFailingOver,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &svcsdk.FailoverGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+func (f *RDS) ModifyGlobalCluster(ctx context.Context, params *svcsdk.ModifyGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	gc, ok := f.GlobalClusters[aws.ToString(params.GlobalClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("GlobalClusterNotFoundFault", "global cluster not found")
+	}
+	if params.DeletionProtection != nil {
+		gc.DeletionProtection = params.DeletionProtection
+	}
+	if params.EngineVersion != nil {
+		gc.EngineVersion = params.EngineVersion
+	}
+	return &svcsdk.ModifyGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+// RemoveFromGlobalCluster detaches the DB cluster with the supplied ARN from
+// the global cluster. The writer can only be detached when it is the last
+// member.
+func (f *RDS) RemoveFromGlobalCluster(ctx context.Context, params *svcsdk.RemoveFromGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.RemoveFromGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("RemoveFromGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	gc, ok := f.GlobalClusters[aws.ToString(params.GlobalClusterIdentifier)]
+	if !ok {
+		return nil, NewAPIError("GlobalClusterNotFoundFault", "global cluster not found")
+	}
+	idx := slices.IndexFunc(gc.GlobalClusterMembers, func(m svcsdktypes.GlobalClusterMember) bool {
+		return aws.ToString(m.DBClusterArn) == aws.ToString(params.DbClusterIdentifier)
+	})
+	if idx < 0 {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster is not a member of the global cluster")
+	}
+	if aws.ToBool(gc.GlobalClusterMembers[idx].IsWriter) && len(gc.GlobalClusterMembers) > 1 {
+		return nil, NewAPIError("InvalidGlobalClusterStateFault", "the primary cluster has secondary clusters")
+	}
+	gc.GlobalClusterMembers = slices.Delete(slices.Clone(gc.GlobalClusterMembers), idx, idx+1)
+	return &svcsdk.RemoveFromGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+// SwitchoverGlobalCluster starts a switchover of the global cluster to the
+// target secondary cluster. The switchover stays in progress until a test
+// clears the FailoverState of the global cluster.
+func (f *RDS) SwitchoverGlobalCluster(ctx context.Context, params *svcsdk.SwitchoverGlobalClusterInput, optFns ...func(*svcsdk.Options)) (*svcsdk.SwitchoverGlobalClusterOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("SwitchoverGlobalCluster", params); err != nil {
+		return nil, err
+	}
+	gc, err := f.startGlobalClusterFailover(
+		params.GlobalClusterIdentifier,
+		params.TargetDbClusterIdentifier,
+		false,
+		GlobalClusterStatusSwitchingOver,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &svcsdk.SwitchoverGlobalClusterOutput{GlobalCluster: gc}, nil
+}
+
+// startGlobalClusterFailover records a pending switchover or failover of the
+// global cluster to the target member. Callers must hold the lock.
+func (f *RDS) startGlobalClusterFailover(
+	id *string,
+	target *string,
+	allowDataLoss bool,
+	status string,
+) (*svcsdktypes.GlobalCluster, error) {
+	gc, ok := f.GlobalClusters[aws.ToString(id)]
+	if !ok {
+		return nil, NewAPIError("GlobalClusterNotFoundFault", "global cluster not found")
+	}
+	if aws.ToString(gc.Status) != StatusAvailable {
+		return nil, NewAPIError("InvalidGlobalClusterStateFault", "global cluster is not available")
+	}
+	var from *string
+	isMember := false
+	for _, m := range gc.GlobalClusterMembers {
+		if aws.ToBool(m.IsWriter) {
+			from = m.DBClusterArn
+		}
+		if aws.ToString(m.DBClusterArn) == aws.ToString(target) {
+			isMember = true
+		}
+	}
+	if !isMember {
+		return nil, NewAPIError("DBClusterNotFoundFault", "DB cluster is not a member of the global cluster")
+	}
+	gc.Status = aws.String(status)
+	gc.FailoverState = &svcsdktypes.FailoverState{
+		FromDbClusterArn:  from,
+		IsDataLossAllowed: aws.Bool(allowDataLoss),
+		Status:            svcsdktypes.FailoverStatusPending,
+		ToDbClusterArn:    target,
+	}
+	return gc, nil
+}
+
+// OptionGroup
+
+func (f *RDS) CreateOptionGroup(ctx context.Context, params *svcsdk.CreateOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.CreateOptionGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("CreateOptionGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.OptionGroupName)
+	if _, ok := f.OptionGroups[name]; ok {
+		return nil, NewAPIError("OptionGroupAlreadyExistsFault", "option group already exists")
+	}
+	group := &svcsdktypes.OptionGroup{
+		AllowsVpcAndNonVpcInstanceMemberships: aws.Bool(true),
+		EngineName:                            params.EngineName,
+		MajorEngineVersion:                    params.MajorEngineVersion,
+		OptionGroupArn:                        f.arn("og", params.OptionGroupName),
+		OptionGroupDescription:                params.OptionGroupDescription,
+		OptionGroupName:                       params.OptionGroupName,
+		Options:                               []svcsdktypes.Option{},
+	}
+	f.OptionGroups[name] = group
+	f.Tags[*group.OptionGroupArn] = append([]svcsdktypes.Tag{}, params.Tags...)
+	return &svcsdk.CreateOptionGroupOutput{OptionGroup: group}, nil
+}
+
+func (f *RDS) DeleteOptionGroup(ctx context.Context, params *svcsdk.DeleteOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DeleteOptionGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DeleteOptionGroup", params); err != nil {
+		return nil, err
+	}
+	name := aws.ToString(params.OptionGroupName)
+	if _, ok := f.OptionGroups[name]; !ok {
+		return nil, NewAPIError("OptionGroupNotFoundFault", "option group not found")
+	}
+	delete(f.OptionGroups, name)
+	return &svcsdk.DeleteOptionGroupOutput{}, nil
+}
+
+func (f *RDS) DescribeOptionGroups(ctx context.Context, params *svcsdk.DescribeOptionGroupsInput, optFns ...func(*svcsdk.Options)) (*svcsdk.DescribeOptionGroupsOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("DescribeOptionGroups", params); err != nil {
+		return nil, err
+	}
+	groups := []svcsdktypes.OptionGroup{}
+	for _, name := range sortedKeys(f.OptionGroups) {
+		if params.OptionGroupName != nil && name != *params.OptionGroupName {
+			continue
+		}
+		groups = append(groups, *f.OptionGroups[name])
+	}
+	if params.OptionGroupName != nil && len(groups) == 0 {
+		return nil, NewAPIError("OptionGroupNotFoundFault", "option group not found")
+	}
+	return &svcsdk.DescribeOptionGroupsOutput{OptionGroupsList: groups}, nil
+}
+
+// ModifyOptionGroup adds or updates the options in OptionsToInclude and
+// removes the options in OptionsToRemove. Like RDS, settings that aren't
+// supplied for an option that is already in the group keep their value, and
+// permanent options can't be removed.
+func (f *RDS) ModifyOptionGroup(ctx context.Context, params *svcsdk.ModifyOptionGroupInput, optFns ...func(*svcsdk.Options)) (*svcsdk.ModifyOptionGroupOutput, error) {
+	f.Lock()
+	defer f.Unlock()
+	if err := f.record("ModifyOptionGroup", params); err != nil {
+		return nil, err
+	}
+	group, ok := f.OptionGroups[aws.ToString(params.OptionGroupName)]
+	if !ok {
+		return nil, NewAPIError("OptionGroupNotFoundFault", "option group not found")
+	}
+	for _, name := range params.OptionsToRemove {
+		idx := optionIndex(group.Options, name)
+		if idx < 0 {
+			return nil, NewAPIError(
+				"InvalidParameterValue",
+				fmt.Sprintf("option %s is not in the option group", name),
+			)
+		}
+		if aws.ToBool(group.Options[idx].Permanent) {
+			return nil, NewAPIError(
+				"InvalidParameterCombination",
+				fmt.Sprintf("permanent option %s cannot be removed", name),
+			)
+		}
+	}
+	options := []svcsdktypes.Option{}
+	for _, opt := range group.Options {
+		if !slices.Contains(params.OptionsToRemove, aws.ToString(opt.OptionName)) {
+			options = append(options, opt)
+		}
+	}
+	for _, cfg := range params.OptionsToInclude {
+		idx := optionIndex(options, aws.ToString(cfg.OptionName))
+		if idx < 0 {
+			options = append(options, svcsdktypes.Option{OptionName: cfg.OptionName})
+			idx = len(options) - 1
+		}
+		options[idx] = includeOption(options[idx], cfg)
+	}
+	group.Options = options
+	return &svcsdk.ModifyOptionGroupOutput{OptionGroup: group}, nil
+}
+
+// optionIndex returns the index of the named option, or -1.
+func optionIndex(options []svcsdktypes.Option, name string) int {
+	for i, opt := range options {
+		if aws.ToString(opt.OptionName) == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// includeOption returns a copy of the option with the supplied configuration
+// applied to it.
+func includeOption(
+	opt svcsdktypes.Option,
+	cfg svcsdktypes.OptionConfiguration,
+) svcsdktypes.Option {
+	if cfg.OptionVersion != nil {
+		opt.OptionVersion = cfg.OptionVersion
+	}
+	if cfg.Port != nil {
+		opt.Port = cfg.Port
+	}
+	if cfg.VpcSecurityGroupMemberships != nil {
+		opt.VpcSecurityGroupMemberships = []svcsdktypes.VpcSecurityGroupMembership{}
+		for _, id := range cfg.VpcSecurityGroupMemberships {
+			opt.VpcSecurityGroupMemberships = append(
+				opt.VpcSecurityGroupMemberships,
+				svcsdktypes.VpcSecurityGroupMembership{
+					VpcSecurityGroupId: aws.String(id),
+					Status:             aws.String("active"),
+				},
+			)
+		}
+	}
+	settings := append([]svcsdktypes.OptionSetting{}, opt.OptionSettings...)
+	for _, s := range cfg.OptionSettings {
+		found := false
+		for i := range settings {
+			if aws.ToString(settings[i].Name) == aws.ToString(s.Name) {
+				settings[i].Value = s.Value
+				found = true
+			}
+		}
+		if !found {
+			settings = append(settings, svcsdktypes.OptionSetting{
+				Name:  s.Name,
+				Value: s.Value,
+			})
+		}
+	}
+	opt.OptionSettings = settings
+	return opt
+}
+
+// newParameterGroup returns a parameter group seeded with the engine
+// defaults of the supplied family.
+func (f *RDS) newParameterGroup(family string) *ParameterGroup {
+	pg := &ParameterGroup{
+		Family:     family,
+		Parameters: map[string]svcsdktypes.Parameter{},
+	}
+	for _, p := range f.EngineDefaults[family] {
+		pg.Parameters[aws.ToString(p.ParameterName)] = p
+	}
+	return pg
+}
+
+// sortedParameters returns the parameters of the group ordered by name.
+func (pg *ParameterGroup) sortedParameters() []svcsdktypes.Parameter {
+	params := make([]svcsdktypes.Parameter, 0, len(pg.Parameters))
+	for _, name := range sortedKeys(pg.Parameters) {
+		params = append(params, pg.Parameters[name])
+	}
+	return params
+}
+
+// modify sets the supplied parameter values. Like RDS, it rejects unknown
+// and unmodifiable parameters, as well as more than 20 parameters at once,
+// and leaves the group untouched in that case.
+func (pg *ParameterGroup) modify(params []svcsdktypes.Parameter) error {
+	if err := checkParameterCount(params); err != nil {
+		return err
+	}
+	for _, p := range params {
+		existing, ok := pg.Parameters[aws.ToString(p.ParameterName)]
+		if !ok || (existing.IsModifiable != nil && !*existing.IsModifiable) {
+			return NewAPIError(
+				"InvalidParameterValue",
+				fmt.Sprintf("parameter %s cannot be modified", aws.ToString(p.ParameterName)),
+			)
+		}
+	}
+	for _, p := range params {
+		name := aws.ToString(p.ParameterName)
+		existing := pg.Parameters[name]
+		existing.ParameterValue = p.ParameterValue
+		existing.ApplyMethod = p.ApplyMethod
+		existing.Source = aws.String("user")
+		pg.Parameters[name] = existing
+	}
+	return nil
+}
+
+// reset restores the supplied parameters to their engine default values.
+func (pg *ParameterGroup) reset(
+	defaults []svcsdktypes.Parameter,
+	params []svcsdktypes.Parameter,
+) error {
+	if err := checkParameterCount(params); err != nil {
+		return err
+	}
+	byName := map[string]svcsdktypes.Parameter{}
+	for _, d := range defaults {
+		byName[aws.ToString(d.ParameterName)] = d
+	}
+	for _, p := range params {
+		if _, ok := pg.Parameters[aws.ToString(p.ParameterName)]; !ok {
+			return NewAPIError(
+				"InvalidParameterValue",
+				fmt.Sprintf("unknown parameter %s", aws.ToString(p.ParameterName)),
+			)
+		}
+	}
+	for _, p := range params {
+		name := aws.ToString(p.ParameterName)
+		if d, ok := byName[name]; ok {
+			pg.Parameters[name] = d
+		} else {
+			delete(pg.Parameters, name)
+		}
+	}
+	return nil
+}
+
+// checkParameterCount returns the error RDS returns when a single call
+// modifies or resets more than 20 parameters.
+func checkParameterCount(params []svcsdktypes.Parameter) error {
+	if len(params) > maxParametersPerCall {
+		return NewAPIError(
+			"InvalidParameterValue",
+			fmt.Sprintf(
+				"a maximum of %d parameters can be modified in a single request, got %d",
+				maxParametersPerCall, len(params),
+			),
+		)
+	}
+	return nil
+}
+
+// paginate returns the page of items starting at the supplied marker and
+// the marker of the next page, if any.
+func paginate[T any](
+	items []T,
+	marker *string,
+	pageSize int,
+) ([]T, *string) {
+	start := 0
+	if marker != nil {
+		fmt.Sscanf(*marker, "%d", &start)
+	}
+	if start >= len(items) {
+		return []T{}, nil
+	}
+	end := start + pageSize
+	if end >= len(items) {
+		return items[start:], nil
+	}
+	return items[start:end], aws.String(fmt.Sprintf("%d", end))
+}
+
+// pageSize returns the size of the pages of a Describe call with the
+// supplied MaxRecords.
+func (f *RDS) pageSize(maxRecords *int32) int {
+	if maxRecords != nil {
+		return int(*maxRecords)
+	}
+	return f.PageSize
+}
+
+// filterValues returns the values of the named filter, and false if the
+// filters don't include it.
+func filterValues(filters []svcsdktypes.Filter, name string) ([]string, bool) {
+	for _, filter := range filters {
+		if aws.ToString(filter.Name) == name {
+			return filter.Values, true
+		}
+	}
+	return nil, false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// EngineDefaultParameter returns an engine default parameter suitable for
+// seeding RDS.EngineDefaults. applyType is either "static" or "dynamic".
+func EngineDefaultParameter(
+	name string,
+	value *string,
+	applyType string,
+	isModifiable bool,
+) svcsdktypes.Parameter {
+	return svcsdktypes.Parameter{
+		ParameterName:  aws.String(name),
+		ParameterValue: value,
+		ApplyType:      aws.String(applyType),
+		IsModifiable:   aws.Bool(isModifiable),
+		Source:         aws.String("engine-default"),
+	}
+}
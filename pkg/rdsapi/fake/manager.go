@@ -0,0 +1,62 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fake
+
+import (
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/go-logr/logr"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// NewSDKAPI is a rdsapi.ClientConstructor that returns f whatever the client
+// configuration. Setting it as the RDS API constructor of a resource manager
+// factory makes the factory produce resource managers backed by f.
+func (f *RDS) NewSDKAPI(
+	cfg aws.Config,
+	accountID ackv1alpha1.AWSAccountID,
+	metrics util.APICallRecorder,
+) rdsapi.API {
+	return f
+}
+
+// ManagerFor returns the resource manager that the supplied factory produces
+// for the account and the region of f, in the aws partition. The factory is
+// expected to use NewSDKAPI as its RDS API constructor and to have the
+// default Dependencies. ManagerFor panics if the factory returns an error.
+func (f *RDS) ManagerFor(
+	factory acktypes.AWSResourceManagerFactory,
+) acktypes.AWSResourceManager {
+	rm, err := factory.ManagerFor(
+		ackcfg.Config{Partition: "aws"},
+		aws.Config{Region: f.Region},
+		logr.Discard(),
+		ackmetrics.NewMetrics("rds"),
+		nil,
+		ackv1alpha1.AWSAccountID(f.AccountID),
+		ackv1alpha1.AWSRegion(f.Region),
+		"",
+	)
+	if err != nil {
+		panic(fmt.Sprintf("fake: producing a resource manager: %v", err))
+	}
+	return rm
+}
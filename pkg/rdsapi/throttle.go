@@ -0,0 +1,160 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi
+
+import (
+	"context"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	// DefaultRate is the number of calls per second each RDS API operation
+	// is limited to, in an AWS account and region.
+	DefaultRate = 10.0
+	// DefaultBurst is the number of calls each RDS API operation can make
+	// at once before being limited to DefaultRate.
+	DefaultBurst = 20
+)
+
+// The throttled calls are recorded with the metrics of the resource
+// managers, as ack_outbound_api_requests_total with one of these op_type
+// labels. They are recorded without their error, which the resource managers
+// record with the call itself.
+const (
+	// OpTypeRateLimited is the op_type of the calls the client-side rate
+	// limiter delayed.
+	OpTypeRateLimited = "RATE_LIMITED"
+	// OpTypeThrottled is the op_type of the calls RDS rejected with a
+	// throttling error, after the SDK retries.
+	OpTypeThrottled = "THROTTLED"
+)
+
+// RateLimit is the token bucket configuration of an RDS API operation.
+type RateLimit struct {
+	// Rate is the number of calls per second. Zero disables the limit.
+	Rate float64
+	// Burst is the number of calls that can be made at once.
+	Burst int
+}
+
+// RateLimiter limits the rate of the calls to each RDS API operation. Calls
+// to the same operation in the same scope, an AWS account and region, share
+// a token bucket whatever resource manager makes them.
+type RateLimiter struct {
+	defaultLimit RateLimit
+	// operationLimits overrides the default limit of some operations.
+	operationLimits map[string]RateLimit
+
+	mu sync.Mutex
+	// buckets contains the token bucket of each scope and operation.
+	buckets map[string]*rate.Limiter
+}
+
+// NewRateLimiter returns a RateLimiter that limits every operation to
+// defaultLimit, except the operations of operationLimits.
+func NewRateLimiter(
+	defaultLimit RateLimit,
+	operationLimits map[string]RateLimit,
+) *RateLimiter {
+	limits := make(map[string]RateLimit, len(operationLimits))
+	for op, limit := range operationLimits {
+		limits[op] = limit
+	}
+	return &RateLimiter{
+		defaultLimit:    defaultLimit,
+		operationLimits: limits,
+		buckets:         map[string]*rate.Limiter{},
+	}
+}
+
+// bucket returns the token bucket of the supplied operation in the supplied
+// scope, or nil if the operation isn't limited.
+func (l *RateLimiter) bucket(scope string, op string) *rate.Limiter {
+	limit, ok := l.operationLimits[op]
+	if !ok {
+		limit = l.defaultLimit
+	}
+	if limit.Rate <= 0 {
+		return nil
+	}
+	key := scope + "/" + op
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = rate.NewLimiter(rate.Limit(limit.Rate), max(limit.Burst, 1))
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// Wait blocks until a call to the supplied operation in the supplied scope
+// is allowed, or the context is done. Returns true if the call had to wait.
+func (l *RateLimiter) Wait(ctx context.Context, scope string, op string) (bool, error) {
+	b := l.bucket(scope, op)
+	if b == nil {
+		return false, nil
+	}
+	if b.Allow() {
+		return false, nil
+	}
+	return true, b.Wait(ctx)
+}
+
+// isThrottle returns true if the supplied error is a throttling error from
+// RDS.
+func isThrottle(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
+// middleware returns the SDK client option that waits on the rate limiter
+// before each call in the supplied scope, and records the calls that had to
+// wait and the calls RDS throttled with the supplied metrics.
+func (l *RateLimiter) middleware(
+	scope string,
+	metrics util.APICallRecorder,
+) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+			"RDSRateLimiter",
+			func(
+				ctx context.Context,
+				in middleware.InitializeInput,
+				next middleware.InitializeHandler,
+			) (middleware.InitializeOutput, middleware.Metadata, error) {
+				op := awsmiddleware.GetOperationName(ctx)
+				waited, err := l.Wait(ctx, scope, op)
+				if waited {
+					metrics.RecordAPICall(OpTypeRateLimited, op, nil)
+				}
+				if err != nil {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, err
+				}
+				out, metadata, err := next.HandleInitialize(ctx, in)
+				if err != nil && isThrottle(err) {
+					metrics.RecordAPICall(OpTypeThrottled, op, nil)
+				}
+				return out, metadata, err
+			},
+		), middleware.After)
+	}
+}
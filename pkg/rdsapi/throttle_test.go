@@ -0,0 +1,120 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package rdsapi_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+)
+
+// callRecorder counts the API calls it records by op_type and op_id.
+type callRecorder map[string]int
+
+func (r callRecorder) RecordAPICall(opType string, opID string, err error) {
+	r[opType+"/"+opID]++
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := rdsapi.NewRateLimiter(
+		rdsapi.RateLimit{Rate: 20, Burst: 1},
+		map[string]rdsapi.RateLimit{"DescribeDBProxies": {Rate: 0}},
+	)
+	ctx := context.Background()
+	wait := func(scope string, op string) bool {
+		waited, err := limiter.Wait(ctx, scope, op)
+		require.NoError(t, err)
+		return waited
+	}
+
+	start := time.Now()
+	assert.False(t, wait("111111111111/us-west-2", "DescribeDBInstances"))
+	assert.True(t, wait("111111111111/us-west-2", "DescribeDBInstances"))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// Other operations, scopes and unlimited operations have their own
+	// buckets.
+	start = time.Now()
+	assert.False(t, wait("111111111111/us-west-2", "DescribeDBClusters"))
+	assert.False(t, wait("222222222222/us-west-2", "DescribeDBInstances"))
+	for i := 0; i < 5; i++ {
+		assert.False(t, wait("111111111111/us-west-2", "DescribeDBProxies"))
+	}
+	assert.Less(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestRateLimiterWait_ContextDone(t *testing.T) {
+	limiter := rdsapi.NewRateLimiter(rdsapi.RateLimit{Rate: 0.001, Burst: 1}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := limiter.Wait(ctx, "scope", "ModifyDBInstance")
+	require.NoError(t, err)
+	cancel()
+	waited, err := limiter.Wait(ctx, "scope", "ModifyDBInstance")
+	assert.True(t, waited)
+	assert.Error(t, err)
+}
+
+// throttlingClient is an HTTP client that answers every request with an RDS
+// throttling error.
+type throttlingClient struct{}
+
+func (throttlingClient) Do(req *http.Request) (*http.Response, error) {
+	body := `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code>` +
+		`<Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`
+	return &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{"Content-Type": []string{"text/xml"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestNewFromConfig_RecordsThrottling(t *testing.T) {
+	previous := rdsapi.DefaultClientOptions()
+	rdsapi.SetDefaultClientOptions(rdsapi.ClientOptions{
+		RateLimiter: rdsapi.NewRateLimiter(rdsapi.RateLimit{Rate: 100, Burst: 1}, nil),
+	})
+	defer rdsapi.SetDefaultClientOptions(previous)
+
+	metrics := callRecorder{}
+	api := rdsapi.NewFromConfig(aws.Config{
+		Region: "us-west-2",
+		Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+		}),
+		HTTPClient:       throttlingClient{},
+		RetryMaxAttempts: 1,
+	}, "111111111111", metrics)
+
+	// The first call is throttled by RDS, the second one by the rate limiter
+	// too.
+	for i := 0; i < 2; i++ {
+		_, err := api.DescribeDBSubnetGroups(context.Background(), &svcsdk.DescribeDBSubnetGroupsInput{})
+		require.Error(t, err)
+	}
+	assert.Equal(t, callRecorder{
+		"THROTTLED/DescribeDBSubnetGroups":    2,
+		"RATE_LIMITED/DescribeDBSubnetGroups": 1,
+	}, metrics)
+}
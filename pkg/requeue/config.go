@@ -0,0 +1,123 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package requeue
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	flagRequeueDefaultInterval   = "requeue-default-interval"
+	flagRequeueStatusInterval    = "requeue-status-interval"
+	flagRequeueMaxInterval       = "requeue-max-interval"
+	flagRequeueBackoffMultiplier = "requeue-backoff-multiplier"
+	flagRequeueJitter            = "requeue-jitter"
+)
+
+// Config contains the controller flags of the requeue policy.
+type Config struct {
+	DefaultInterval   time.Duration
+	StatusIntervals   []string
+	MaxInterval       time.Duration
+	BackoffMultiplier float64
+	Jitter            float64
+}
+
+// BindFlags defines the requeue policy flags.
+func (cfg *Config) BindFlags() {
+	flag.DurationVar(
+		&cfg.DefaultInterval, flagRequeueDefaultInterval,
+		DefaultInterval,
+		"The base interval before checking again on a resource that cannot be modified"+
+			" because of its status, for the statuses that have no interval of their own.",
+	)
+	flag.StringArrayVar(
+		&cfg.StatusIntervals, flagRequeueStatusInterval,
+		[]string{},
+		"The base interval of a status, as status=duration, e.g. creating=2m."+
+			" Overrides the built-in interval of the status. Can be repeated.",
+	)
+	flag.DurationVar(
+		&cfg.MaxInterval, flagRequeueMaxInterval,
+		DefaultMaxInterval,
+		"The maximum interval the backoff grows to while a resource keeps waiting in the same status.",
+	)
+	flag.Float64Var(
+		&cfg.BackoffMultiplier, flagRequeueBackoffMultiplier,
+		DefaultBackoffMultiplier,
+		"The factor the interval is multiplied by after each consecutive wait in the same status."+
+			" 1 disables the backoff.",
+	)
+	flag.Float64Var(
+		&cfg.Jitter, flagRequeueJitter,
+		DefaultJitter,
+		"The fraction of the interval, between 0 and 1, that is randomly added or removed.",
+	)
+}
+
+// NewPolicy validates the flags and returns the Policy they configure. The
+// status intervals of the flags override DefaultStatusIntervals.
+func (cfg *Config) NewPolicy() (*Policy, error) {
+	if cfg.DefaultInterval <= 0 {
+		return nil, fmt.Errorf("invalid value for flag '%s': must be positive", flagRequeueDefaultInterval)
+	}
+	if cfg.MaxInterval <= 0 {
+		return nil, fmt.Errorf("invalid value for flag '%s': must be positive", flagRequeueMaxInterval)
+	}
+	if cfg.BackoffMultiplier < 1 {
+		return nil, fmt.Errorf("invalid value for flag '%s': must be at least 1", flagRequeueBackoffMultiplier)
+	}
+	if cfg.Jitter < 0 || cfg.Jitter >= 1 {
+		return nil, fmt.Errorf("invalid value for flag '%s': must be between 0 and 1", flagRequeueJitter)
+	}
+	intervals := make(map[string]time.Duration, len(DefaultStatusIntervals))
+	for status, interval := range DefaultStatusIntervals {
+		intervals[status] = interval
+	}
+	for _, entry := range cfg.StatusIntervals {
+		status, interval, err := parseStatusInterval(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for flag '%s': %v", flagRequeueStatusInterval, err)
+		}
+		intervals[status] = interval
+	}
+	return NewPolicy(
+		cfg.DefaultInterval,
+		intervals,
+		cfg.MaxInterval,
+		cfg.BackoffMultiplier,
+		cfg.Jitter,
+	), nil
+}
+
+// parseStatusInterval parses a status=duration entry.
+func parseStatusInterval(entry string) (string, time.Duration, error) {
+	status, value, found := strings.Cut(entry, "=")
+	status = strings.TrimSpace(status)
+	if !found || status == "" {
+		return "", 0, fmt.Errorf("expected status=duration, got %q", entry)
+	}
+	interval, err := time.ParseDuration(strings.TrimSpace(value))
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid duration for status %q: %v", status, err)
+	}
+	if interval <= 0 {
+		return "", 0, fmt.Errorf("duration for status %q must be positive", status)
+	}
+	return status, interval, nil
+}
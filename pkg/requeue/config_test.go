@@ -0,0 +1,69 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package requeue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func defaultConfig() Config {
+	return Config{
+		DefaultInterval:   DefaultInterval,
+		MaxInterval:       DefaultMaxInterval,
+		BackoffMultiplier: DefaultBackoffMultiplier,
+		Jitter:            DefaultJitter,
+	}
+}
+
+func TestConfigNewPolicy(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.StatusIntervals = []string{"creating=5m", " my-status = 45s "}
+	p, err := cfg.NewPolicy()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, p.baseInterval("creating"))
+	assert.Equal(t, 45*time.Second, p.baseInterval("my-status"))
+	assert.Equal(t, DefaultStatusIntervals["rebooting"], p.baseInterval("rebooting"))
+	assert.Equal(t, DefaultInterval, p.baseInterval("unknown"))
+	// The built-in intervals aren't changed by the flags.
+	assert.Equal(t, time.Minute, DefaultStatusIntervals["creating"])
+}
+
+func TestConfigNewPolicy_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Config)
+	}{
+		{"zero default interval", func(c *Config) { c.DefaultInterval = 0 }},
+		{"negative max interval", func(c *Config) { c.MaxInterval = -time.Second }},
+		{"multiplier below 1", func(c *Config) { c.BackoffMultiplier = 0.5 }},
+		{"negative jitter", func(c *Config) { c.Jitter = -0.1 }},
+		{"jitter of 1", func(c *Config) { c.Jitter = 1 }},
+		{"status interval without duration", func(c *Config) { c.StatusIntervals = []string{"creating"} }},
+		{"status interval without status", func(c *Config) { c.StatusIntervals = []string{"=1m"} }},
+		{"status interval with bad duration", func(c *Config) { c.StatusIntervals = []string{"creating=soon"} }},
+		{"status interval with zero duration", func(c *Config) { c.StatusIntervals = []string{"creating=0s"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := defaultConfig()
+			tt.modify(&cfg)
+			_, err := cfg.NewPolicy()
+			assert.Error(t, err)
+		})
+	}
+}
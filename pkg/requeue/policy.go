@@ -0,0 +1,232 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package requeue chooses how long the controller waits before checking
+// again on a resource that cannot be modified because of its status.
+package requeue
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultInterval is the base interval of the statuses that have no
+	// interval of their own.
+	DefaultInterval = ackrequeue.DefaultRequeueAfterDuration
+	// DefaultMaxInterval caps the interval the backoff grows to.
+	DefaultMaxInterval = 5 * time.Minute
+	// DefaultBackoffMultiplier multiplies the interval after each
+	// consecutive wait in the same status.
+	DefaultBackoffMultiplier = 2.0
+	// DefaultJitter is the fraction of the interval that is randomly added
+	// or removed, so that resources waiting together don't all requeue
+	// together.
+	DefaultJitter = 0.1
+)
+
+// DefaultStatusIntervals are the base intervals of the statuses that
+// usually last much longer, or much shorter, than DefaultInterval.
+var DefaultStatusIntervals = map[string]time.Duration{
+	"backing-up":           time.Minute,
+	"copying":              time.Minute,
+	"creating":             time.Minute,
+	"maintenance":          time.Minute,
+	"modifying":            30 * time.Second,
+	"rebooting":            15 * time.Second,
+	"starting":             30 * time.Second,
+	"stopping":             30 * time.Second,
+	"storage-optimization": 2 * time.Minute,
+	"upgrading":            time.Minute,
+}
+
+var requeueAfterSeconds = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "ack_rds_requeue_after_seconds",
+		Help:    "Interval chosen before checking again on a resource that cannot be modified because of its status.",
+		Buckets: []float64{5, 15, 30, 60, 120, 300, 600, 1200},
+	},
+	[]string{
+		"kind",
+		"status",
+	},
+)
+
+// Collectors returns the Prometheus collectors of the requeue intervals, so
+// that they can be registered with controller-runtime's metrics.Registry.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		requeueAfterSeconds,
+	}
+}
+
+// Policy chooses the interval before a resource that waits in a status is
+// checked again. The interval starts at the base interval of the status and
+// grows exponentially, up to a cap, while the resource keeps waiting in the
+// same status. A random jitter is then applied to it.
+type Policy struct {
+	defaultInterval   time.Duration
+	statusIntervals   map[string]time.Duration
+	maxInterval       time.Duration
+	backoffMultiplier float64
+	jitter            float64
+
+	mu sync.Mutex
+	// waits contains the consecutive waits of each resource, by key.
+	waits map[string]*wait
+	// lastPrune is the last time waits were pruned of the resources that
+	// stopped waiting.
+	lastPrune time.Time
+	now       func() time.Time
+	random    func() float64
+}
+
+// wait records the consecutive waits of a resource in a status.
+type wait struct {
+	status string
+	count  int
+	// seen is the time of the last wait.
+	seen time.Time
+	// interval is the interval chosen for the last wait, without jitter.
+	interval time.Duration
+}
+
+// NewDefaultPolicy returns a Policy with the default intervals, backoff and
+// jitter.
+func NewDefaultPolicy() *Policy {
+	return NewPolicy(
+		DefaultInterval,
+		DefaultStatusIntervals,
+		DefaultMaxInterval,
+		DefaultBackoffMultiplier,
+		DefaultJitter,
+	)
+}
+
+// NewPolicy returns a Policy that uses the supplied base intervals, by
+// status, and defaultInterval for the other statuses.
+func NewPolicy(
+	defaultInterval time.Duration,
+	statusIntervals map[string]time.Duration,
+	maxInterval time.Duration,
+	backoffMultiplier float64,
+	jitter float64,
+) *Policy {
+	intervals := make(map[string]time.Duration, len(statusIntervals))
+	for status, interval := range statusIntervals {
+		intervals[status] = interval
+	}
+	return &Policy{
+		defaultInterval:   defaultInterval,
+		statusIntervals:   intervals,
+		maxInterval:       maxInterval,
+		backoffMultiplier: backoffMultiplier,
+		jitter:            jitter,
+		waits:             map[string]*wait{},
+		now:               time.Now,
+		random:            rand.Float64,
+	}
+}
+
+// baseInterval returns the interval of the first wait in the supplied
+// status.
+func (p *Policy) baseInterval(status string) time.Duration {
+	if interval, ok := p.statusIntervals[status]; ok {
+		return interval
+	}
+	return p.defaultInterval
+}
+
+// Interval returns the interval before the resource with the supplied key,
+// which waits in the supplied status, is checked again.
+//
+// A wait is consecutive to the previous wait of the resource when the status
+// is the same and it happens within twice the previous interval. Otherwise
+// the backoff starts again from the base interval of the status. The cap
+// never lowers the base interval of a status.
+func (p *Policy) Interval(key string, status string) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.prune(now)
+
+	base := p.baseInterval(status)
+	w, ok := p.waits[key]
+	if !ok || w.status != status || now.Sub(w.seen) > 2*w.interval {
+		w = &wait{status: status}
+		p.waits[key] = w
+	}
+	interval := float64(base) * math.Pow(p.backoffMultiplier, float64(w.count))
+	limit := math.Max(float64(p.maxInterval), float64(base))
+	if interval > limit {
+		interval = limit
+	}
+	w.count++
+	w.seen = now
+	w.interval = time.Duration(interval)
+
+	interval *= 1 + p.jitter*(2*p.random()-1)
+	return time.Duration(interval)
+}
+
+// prune forgets the waits of the resources that stopped waiting, at most
+// once per maximum interval.
+func (p *Policy) prune(now time.Time) {
+	if now.Sub(p.lastPrune) < p.maxInterval {
+		return
+	}
+	for key, w := range p.waits {
+		if now.Sub(w.seen) > 2*w.interval {
+			delete(p.waits, key)
+		}
+	}
+	p.lastPrune = now
+}
+
+// NeededAfter returns a `ackrequeue.RequeueNeededAfter` with the interval the
+// policy chooses for the supplied resource, of the supplied kind, waiting in
+// the supplied status. The interval is logged and recorded in the
+// ack_rds_requeue_after_seconds metric.
+func (p *Policy) NeededAfter(
+	ctx context.Context,
+	kind string,
+	obj metav1.Object,
+	status string,
+	err error,
+) *ackrequeue.RequeueNeededAfter {
+	key := kind + "/" + obj.GetNamespace() + "/" + obj.GetName()
+	interval := p.Interval(key, status)
+
+	rlog := ackrtlog.FromContext(ctx)
+	rlog.Debug(
+		"waiting for resource status to change",
+		"status", status,
+		"requeue_after", interval.String(),
+	)
+	requeueAfterSeconds.With(
+		prometheus.Labels{
+			"kind":   kind,
+			"status": status,
+		},
+	).Observe(interval.Seconds())
+	return ackrequeue.NeededAfter(err, interval)
+}
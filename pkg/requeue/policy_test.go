@@ -0,0 +1,134 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package requeue
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newTestPolicy returns a policy whose random source always returns the
+// supplied value and whose clock only moves when the returned function is
+// called.
+func newTestPolicy(jitter float64, random float64) (*Policy, func(time.Duration)) {
+	p := NewPolicy(
+		30*time.Second,
+		map[string]time.Duration{
+			"creating":  time.Minute,
+			"rebooting": 10 * time.Second,
+			"upgrading": 10 * time.Minute,
+		},
+		4*time.Minute,
+		2,
+		jitter,
+	)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+	p.random = func() float64 { return random }
+	return p, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestPolicyInterval_Backoff(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	var got []time.Duration
+	for i := 0; i < 5; i++ {
+		interval := p.Interval("DBInstance/ns/db", "creating")
+		got = append(got, interval)
+		advance(interval)
+	}
+	assert.Equal(t, []time.Duration{
+		time.Minute,
+		2 * time.Minute,
+		4 * time.Minute,
+		4 * time.Minute,
+		4 * time.Minute,
+	}, got)
+}
+
+func TestPolicyInterval_DefaultInterval(t *testing.T) {
+	p, _ := newTestPolicy(0, 0)
+	assert.Equal(t, 30*time.Second, p.Interval("DBCluster/ns/cluster", "resetting-master-credentials"))
+}
+
+func TestPolicyInterval_BaseIntervalAboveCap(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	assert.Equal(t, 10*time.Minute, p.Interval("DBInstance/ns/db", "upgrading"))
+	advance(10 * time.Minute)
+	assert.Equal(t, 10*time.Minute, p.Interval("DBInstance/ns/db", "upgrading"))
+}
+
+func TestPolicyInterval_ResetsOnStatusChange(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/db", "creating"))
+	advance(time.Minute)
+	assert.Equal(t, 2*time.Minute, p.Interval("DBInstance/ns/db", "creating"))
+	advance(2 * time.Minute)
+	assert.Equal(t, 10*time.Second, p.Interval("DBInstance/ns/db", "rebooting"))
+}
+
+func TestPolicyInterval_ResetsAfterGap(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/db", "creating"))
+	advance(time.Minute)
+	assert.Equal(t, 2*time.Minute, p.Interval("DBInstance/ns/db", "creating"))
+	// The resource became available and went back to the same status later
+	// on, the backoff starts again.
+	advance(time.Hour)
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/db", "creating"))
+}
+
+func TestPolicyInterval_ResourcesAreIndependent(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/a", "creating"))
+	advance(time.Minute)
+	assert.Equal(t, 2*time.Minute, p.Interval("DBInstance/ns/a", "creating"))
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/b", "creating"))
+	assert.Equal(t, time.Minute, p.Interval("DBCluster/ns/a", "creating"))
+}
+
+func TestPolicyInterval_Jitter(t *testing.T) {
+	p, _ := newTestPolicy(0.1, 0)
+	assert.Equal(t, 54*time.Second, p.Interval("DBInstance/ns/a", "creating"))
+	p, _ = newTestPolicy(0.1, 1)
+	assert.Equal(t, 66*time.Second, p.Interval("DBInstance/ns/a", "creating"))
+	p, _ = newTestPolicy(0.1, 0.5)
+	assert.Equal(t, time.Minute, p.Interval("DBInstance/ns/a", "creating"))
+}
+
+func TestPolicyInterval_Prune(t *testing.T) {
+	p, advance := newTestPolicy(0, 0)
+	p.Interval("DBInstance/ns/a", "creating")
+	p.Interval("DBInstance/ns/b", "creating")
+	advance(10 * time.Minute)
+	p.Interval("DBInstance/ns/b", "creating")
+	assert.NotContains(t, p.waits, "DBInstance/ns/a")
+	assert.Contains(t, p.waits, "DBInstance/ns/b")
+}
+
+func TestNeededAfter(t *testing.T) {
+	p, _ := newTestPolicy(0, 0)
+
+	obj := &metav1.ObjectMeta{Namespace: "ns", Name: "db"}
+	err := p.NeededAfter(context.Background(), "DBInstance", obj, "creating", errors.New("creating"))
+	require.NotNil(t, err)
+	assert.Equal(t, time.Minute, err.Duration())
+	assert.Equal(t, "creating", err.Error())
+	assert.Contains(t, p.waits, "DBInstance/ns/db")
+}
@@ -0,0 +1,544 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package blue_green_deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	smithy "github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// The RDS API doesn't define an enum for the status of a blue/green
+// deployment. These are the values documented for BlueGreenDeployment.Status.
+const (
+	StatusAvailable            = "AVAILABLE"
+	StatusDeleting             = "DELETING"
+	StatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	StatusProvisioning         = "PROVISIONING"
+	StatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	StatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	StatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	// switchoverDeltaPath is the path of the difference added to the delta
+	// when a switchover is requested and the blue/green deployment hasn't
+	// been switched over.
+	switchoverDeltaPath = "Spec.Switchover"
+	// deleteSourceDeltaPath is the path of the difference added to the delta
+	// when the blue/green deployment has been switched over and its source
+	// database is still to be deleted.
+	deleteSourceDeltaPath = "Spec.DeleteSourceAfterSwitchover"
+
+	// statusDBDeleting is the status of a DB instance or DB cluster that is
+	// being deleted.
+	statusDBDeleting = "deleting"
+)
+
+var (
+	// TerminalStatuses are the status strings that are terminal states for a
+	// blue/green deployment.
+	TerminalStatuses = []string{
+		StatusInvalidConfiguration,
+		StatusSwitchoverFailed,
+	}
+
+	// immutableFields are the fields that can't be changed once the
+	// blue/green deployment exists. RDS has no API to modify a blue/green
+	// deployment.
+	immutableFields = []string{
+		"Spec.Name",
+		"Spec.Source",
+		"Spec.TargetAllocatedStorage",
+		"Spec.TargetDBClusterParameterGroupName",
+		"Spec.TargetDBInstanceClass",
+		"Spec.TargetDBParameterGroupName",
+		"Spec.TargetEngineVersion",
+		"Spec.TargetIOPS",
+		"Spec.TargetStorageThroughput",
+		"Spec.TargetStorageType",
+		"Spec.UpgradeTargetStorageConfig",
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("blue/green deployment in 'DELETING' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+	requeueWaitWhileSourceDeleting = ackrequeue.NeededAfter(
+		errors.New("source database of the blue/green deployment is being deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanSwitchover returns a `ackrequeue.RequeueNeededAfter`
+// struct explaining the blue/green deployment cannot be switched over until
+// it reaches an available status.
+func requeueWaitUntilCanSwitchover(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"blue/green deployment in '%s' state, cannot be switched over until '%s'.",
+		status, StatusAvailable,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// blueGreenDeploymentHasTerminalStatus returns whether the supplied
+// blue/green deployment is in a terminal state
+func blueGreenDeploymentHasTerminalStatus(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range TerminalStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// blueGreenDeploymentAvailable returns true if the supplied blue/green
+// deployment is in the available status and can be switched over
+func blueGreenDeploymentAvailable(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusAvailable
+}
+
+// blueGreenDeploymentDeleting returns true if the supplied blue/green
+// deployment is in the process of being deleted
+func blueGreenDeploymentDeleting(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusDeleting
+}
+
+// blueGreenDeploymentSwitchedOver returns true if the supplied blue/green
+// deployment has been switched over
+func blueGreenDeploymentSwitchedOver(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusSwitchoverCompleted
+}
+
+// blueGreenDeploymentSettled returns true if the supplied blue/green
+// deployment is in a status it only leaves when the controller acts on it
+func blueGreenDeploymentSettled(r *resource) bool {
+	return blueGreenDeploymentAvailable(r) || blueGreenDeploymentSwitchedOver(r)
+}
+
+// blueGreenDeploymentStatusMessage returns a message describing the status
+// of the supplied blue/green deployment, including the status details
+// reported by RDS, if any.
+func blueGreenDeploymentStatusMessage(r *resource) string {
+	msg := "blue/green deployment is in '" + aws.ToString(r.ko.Status.Status) + "' status"
+	if details := aws.ToString(r.ko.Status.StatusDetails); details != "" {
+		msg += ": " + details
+	}
+	return msg
+}
+
+// immutableFieldChanged returns a terminal error naming the first field in
+// the delta that can't be changed once the blue/green deployment exists, or
+// nil if there is no such field.
+func immutableFieldChanged(delta *ackcompare.Delta) error {
+	for _, path := range immutableFields {
+		if delta.DifferentAt(path) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%s can't be changed after the blue/green deployment is created", path,
+			))
+		}
+	}
+	return nil
+}
+
+// setARN sets the ARN of the supplied blue/green deployment from its
+// identifier. RDS doesn't return the ARN of a blue/green deployment, which
+// is needed to manage its tags.
+func (rm *resourceManager) setARN(ko *svcapitypes.BlueGreenDeployment) {
+	if ko.Status.BlueGreenDeploymentIdentifier == nil {
+		return
+	}
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	arn := ackv1alpha1.AWSResourceName(
+		rm.ARNFromName("deployment:" + *ko.Status.BlueGreenDeploymentIdentifier),
+	)
+	ko.Status.ACKResourceMetadata.ARN = &arn
+}
+
+// setDeleteBlueGreenDeploymentInput clears DeleteTarget from the input of
+// DeleteBlueGreenDeployment once the blue/green deployment has been switched
+// over, since the green environment is then the production one and RDS
+// rejects the option.
+func setDeleteBlueGreenDeploymentInput(
+	r *resource,
+	input *svcsdk.DeleteBlueGreenDeploymentInput,
+) {
+	if blueGreenDeploymentSwitchedOver(r) {
+		input.DeleteTarget = nil
+	}
+}
+
+// customUpdate acts on the declarative switchover and source deletion of the
+// blue/green deployment and syncs its tags. Other fields can't be changed.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	res := desired.ko.DeepCopy()
+	res.Status = latest.ko.Status
+
+	if blueGreenDeploymentDeleting(latest) {
+		msg := "blue/green deployment is currently being deleted"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, requeueWaitWhileDeleting
+	}
+	if blueGreenDeploymentHasTerminalStatus(latest) {
+		return nil, ackerr.NewTerminalError(errors.New(blueGreenDeploymentStatusMessage(latest)))
+	}
+	if err = immutableFieldChanged(delta); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt(switchoverDeltaPath) {
+		if !blueGreenDeploymentAvailable(latest) {
+			msg := "blue/green deployment cannot be switched over while in '" + *latest.ko.Status.Status + "' status"
+			ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+			return &resource{res}, requeueWaitUntilCanSwitchover(latest)
+		}
+		if err = rm.switchover(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		msg := "blue/green deployment is being switched over"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
+	if delta.DifferentAt(deleteSourceDeltaPath) {
+		deleted, err := rm.deleteSourceDatabase(ctx, latest)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted {
+			msg := "source database of the blue/green deployment is being deleted"
+			ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+			return &resource{res}, requeueWaitWhileSourceDeleting
+		}
+		res.Status.SourceDeleted = aws.Bool(true)
+	}
+	return &resource{res}, nil
+}
+
+// switchover switches the blue/green deployment over, making the databases
+// in the green environment the production databases.
+func (rm *resourceManager) switchover(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.switchover")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: latest.ko.Status.BlueGreenDeploymentIdentifier,
+	}
+	if desired.ko.Spec.SwitchoverTimeout != nil {
+		timeout := *desired.ko.Spec.SwitchoverTimeout
+		if timeout > math.MaxInt32 || timeout < math.MinInt32 {
+			return fmt.Errorf("error: field SwitchoverTimeout is of type int32")
+		}
+		input.SwitchoverTimeout = aws.Int32(int32(timeout))
+	}
+	_, err = rm.sdkapi.SwitchoverBlueGreenDeployment(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "SwitchoverBlueGreenDeployment", err)
+	return err
+}
+
+// sourceMembers returns the identifiers of the DB instances and DB clusters
+// in the blue environment of the supplied blue/green deployment. A member
+// that is also reported as a target is never returned, so that the
+// production databases can't be mistaken for the ones to delete.
+func sourceMembers(r *resource) (instanceIDs []string, clusterIDs []string) {
+	targets := map[string]bool{}
+	if r.ko.Status.Target != nil {
+		targets[*r.ko.Status.Target] = true
+	}
+	for _, detail := range r.ko.Status.SwitchoverDetails {
+		if detail != nil && detail.TargetMember != nil {
+			targets[*detail.TargetMember] = true
+		}
+	}
+	for _, detail := range r.ko.Status.SwitchoverDetails {
+		if detail == nil || detail.SourceMember == nil || targets[*detail.SourceMember] {
+			continue
+		}
+		parsed, err := arn.Parse(*detail.SourceMember)
+		if err != nil {
+			continue
+		}
+		resourceType, id, found := strings.Cut(parsed.Resource, ":")
+		if !found {
+			continue
+		}
+		switch resourceType {
+		case "db":
+			instanceIDs = append(instanceIDs, id)
+		case "cluster":
+			clusterIDs = append(clusterIDs, id)
+		}
+	}
+	return instanceIDs, clusterIDs
+}
+
+// deleteSourceDatabase deletes the DB instances and DB clusters in the blue
+// environment of a switched over blue/green deployment, without taking a
+// final snapshot. The DB clusters are deleted once their DB instances are
+// gone. It returns true once all of them have been deleted.
+func (rm *resourceManager) deleteSourceDatabase(
+	ctx context.Context,
+	r *resource,
+) (deleted bool, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.deleteSourceDatabase")
+	defer func() { exit(err) }()
+
+	instanceIDs, clusterIDs := sourceMembers(r)
+	deleted = true
+	for _, id := range instanceIDs {
+		instanceDeleted, err := rm.deleteSourceDBInstance(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		deleted = deleted && instanceDeleted
+	}
+	if !deleted {
+		return false, nil
+	}
+	for _, id := range clusterIDs {
+		clusterDeleted, err := rm.deleteSourceDBCluster(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		deleted = deleted && clusterDeleted
+	}
+	return deleted, nil
+}
+
+// deleteSourceDBInstance deletes the supplied DB instance unless it is
+// already being deleted. It returns true once the DB instance is gone.
+func (rm *resourceManager) deleteSourceDBInstance(
+	ctx context.Context,
+	id string,
+) (bool, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	resp, err := rm.sdkapi.DescribeDBInstances(
+		ctx,
+		&svcsdk.DescribeDBInstancesInput{
+			DBInstanceIdentifier: aws.String(id),
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "DescribeDBInstances", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "DBInstanceNotFound" {
+			return true, nil
+		}
+		return false, err
+	}
+	if len(resp.DBInstances) == 0 {
+		return true, nil
+	}
+	if aws.ToString(resp.DBInstances[0].DBInstanceStatus) == statusDBDeleting {
+		return false, nil
+	}
+	rlog.Info("deleting source DB instance of blue/green deployment", "db_instance_identifier", id)
+	_, err = rm.sdkapi.DeleteDBInstance(
+		ctx,
+		&svcsdk.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+			SkipFinalSnapshot:    aws.Bool(true),
+		},
+	)
+	rm.metrics.RecordAPICall("DELETE", "DeleteDBInstance", err)
+	return false, err
+}
+
+// deleteSourceDBCluster deletes the supplied DB cluster unless it is already
+// being deleted. It returns true once the DB cluster is gone.
+func (rm *resourceManager) deleteSourceDBCluster(
+	ctx context.Context,
+	id string,
+) (bool, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	resp, err := rm.sdkapi.DescribeDBClusters(
+		ctx,
+		&svcsdk.DescribeDBClustersInput{
+			DBClusterIdentifier: aws.String(id),
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "DescribeDBClusters", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "DBClusterNotFoundFault" {
+			return true, nil
+		}
+		return false, err
+	}
+	if len(resp.DBClusters) == 0 {
+		return true, nil
+	}
+	if aws.ToString(resp.DBClusters[0].Status) == statusDBDeleting {
+		return false, nil
+	}
+	rlog.Info("deleting source DB cluster of blue/green deployment", "db_cluster_identifier", id)
+	_, err = rm.sdkapi.DeleteDBCluster(
+		ctx,
+		&svcsdk.DeleteDBClusterInput{
+			DBClusterIdentifier: aws.String(id),
+			SkipFinalSnapshot:   aws.Bool(true),
+		},
+	)
+	rm.metrics.RecordAPICall("DELETE", "DeleteDBCluster", err)
+	return false, err
+}
+
+// compareSource adds a difference to the delta if the supplied resources
+// have different source databases. RDS renames the source database when the
+// blue/green deployment is switched over, after which the observed source
+// no longer matches the desired one and isn't compared.
+func compareSource(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if blueGreenDeploymentSwitchedOver(latest) {
+		return
+	}
+	if aws.ToString(desired.ko.Spec.Source) != aws.ToString(latest.ko.Spec.Source) {
+		delta.Add("Spec.Source", desired.ko.Spec.Source, latest.ko.Spec.Source)
+	}
+}
+
+// compareSwitchover adds a difference to the delta if a switchover is
+// requested and the blue/green deployment isn't being or hasn't been
+// switched over.
+func compareSwitchover(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if !aws.ToBool(desired.ko.Spec.Switchover) {
+		return
+	}
+	status := aws.ToString(latest.ko.Status.Status)
+	if status == StatusSwitchoverInProgress || status == StatusSwitchoverCompleted {
+		return
+	}
+	delta.Add(switchoverDeltaPath, desired.ko.Spec.Switchover, latest.ko.Status.Status)
+}
+
+// compareDeleteSourceAfterSwitchover adds a difference to the delta if the
+// blue/green deployment has been switched over and its source database is
+// to be deleted but hasn't been yet.
+func compareDeleteSourceAfterSwitchover(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if !aws.ToBool(desired.ko.Spec.DeleteSourceAfterSwitchover) ||
+		!blueGreenDeploymentSwitchedOver(latest) ||
+		aws.ToBool(latest.ko.Status.SourceDeleted) {
+		return
+	}
+	delta.Add(
+		deleteSourceDeltaPath,
+		desired.ko.Spec.DeleteSourceAfterSwitchover,
+		latest.ko.Status.SourceDeleted,
+	)
+}
+
+// syncTags keeps the resource's tags in sync
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+}
+
+// compareTags adds a difference to the delta if the supplied resources have
+// different tag collections, as reconciled by the supplied TagReconciler
+func compareTags(
+	tags *util.TagReconciler,
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
+}
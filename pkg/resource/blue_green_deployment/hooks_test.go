@@ -0,0 +1,370 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package blue_green_deployment
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+)
+
+const (
+	testSourceARN = "arn:aws:rds:us-west-2:111111111111:db:db-a"
+	testID        = "bgd-upgrade"
+)
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	api.DBInstances["db-a"] = &svcsdktypes.DBInstance{
+		DBInstanceIdentifier: aws.String("db-a"),
+		DBInstanceArn:        aws.String(testSourceARN),
+		DBInstanceStatus:     aws.String(fake.StatusAvailable),
+	}
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+// descriptorDelta returns the difference between the supplied resources, as
+// computed by the descriptor of a factory with the default Dependencies.
+func descriptorDelta(a, b *resource) *ackcompare.Delta {
+	rd := &resourceDescriptor{deps: svcresource.DefaultDependencies()}
+	return rd.Delta(a, b)
+}
+
+func deploymentResource() *resource {
+	return &resource{ko: &svcapitypes.BlueGreenDeployment{
+		Spec: svcapitypes.BlueGreenDeploymentSpec{
+			Name:                aws.String("upgrade"),
+			Source:              aws.String(testSourceARN),
+			TargetEngineVersion: aws.String("16.4"),
+			Tags: []*svcapitypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+// createDeployment creates the test blue/green deployment, moves it to the
+// supplied status and returns the desired and latest resources.
+func createDeployment(
+	t *testing.T,
+	rm *resourceManager,
+	api *fake.RDS,
+	status string,
+) (*resource, *resource) {
+	ctx := context.Background()
+	created, err := rm.sdkCreate(ctx, deploymentResource())
+	require.NoError(t, err)
+	api.BlueGreenDeployments[testID].Status = aws.String(status)
+	latest, err := rm.sdkFind(ctx, created)
+	require.NoError(t, err)
+	api.Calls = nil
+	return &resource{ko: latest.ko.DeepCopy()}, latest
+}
+
+func TestSdkCreateAndFind(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	created, err := rm.sdkCreate(ctx, deploymentResource())
+	require.NoError(t, err)
+	assert.Equal(t, testID, *created.ko.Status.BlueGreenDeploymentIdentifier)
+	assert.Equal(t, StatusProvisioning, *created.ko.Status.Status)
+	assert.Equal(t,
+		"arn:aws:rds:us-west-2:111111111111:deployment:"+testID,
+		string(*created.ko.Status.ACKResourceMetadata.ARN),
+	)
+	require.NotNil(t, syncedCondition(created))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(created).Status)
+	input := api.CallsTo("CreateBlueGreenDeployment")[0].(*svcsdk.CreateBlueGreenDeploymentInput)
+	assert.Equal(t, "16.4", *input.TargetEngineVersion)
+
+	api.BlueGreenDeployments[testID].Status = aws.String(StatusAvailable)
+	r := deploymentResource()
+	r.ko.Status.BlueGreenDeploymentIdentifier = aws.String(testID)
+	latest, err := rm.sdkFind(ctx, r)
+	require.NoError(t, err)
+	assert.Nil(t, syncedCondition(latest))
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+	require.Len(t, latest.ko.Status.SwitchoverDetails, 1)
+	assert.Equal(t, testSourceARN, *latest.ko.Status.SwitchoverDetails[0].SourceMember)
+
+	_, err = rm.sdkFind(ctx, deploymentResource())
+	assert.Equal(t, ackerr.NotFound, err)
+}
+
+func TestNewResourceDelta_Switchover(t *testing.T) {
+	tests := []struct {
+		name       string
+		switchover *bool
+		status     string
+		want       bool
+	}{
+		{"not requested", nil, StatusAvailable, false},
+		{"requested", aws.Bool(true), StatusAvailable, true},
+		{"requested while provisioning", aws.Bool(true), StatusProvisioning, true},
+		{"in progress", aws.Bool(true), StatusSwitchoverInProgress, false},
+		{"completed", aws.Bool(true), StatusSwitchoverCompleted, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := deploymentResource()
+			desired.ko.Spec.Switchover = tt.switchover
+			latest := deploymentResource()
+			latest.ko.Status.Status = aws.String(tt.status)
+			assert.Equal(t, tt.want, newResourceDelta(desired, latest).DifferentAt(switchoverDeltaPath))
+		})
+	}
+}
+
+func TestNewResourceDelta_SourceRenamedAfterSwitchover(t *testing.T) {
+	desired := deploymentResource()
+	latest := deploymentResource()
+	latest.ko.Spec.Source = aws.String(testSourceARN + "-old1")
+	assert.True(t, newResourceDelta(desired, latest).DifferentAt("Spec.Source"))
+
+	latest.ko.Status.Status = aws.String(StatusSwitchoverCompleted)
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt("Spec.Source"))
+}
+
+func TestCustomUpdate_Switchover(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired, latest := createDeployment(t, rm, api, StatusProvisioning)
+	desired.ko.Spec.Switchover = aws.Bool(true)
+	desired.ko.Spec.SwitchoverTimeout = aws.Int64(600)
+
+	updated, err := rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Error(t, err)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(updated).Status)
+	assert.Empty(t, api.CallsTo("SwitchoverBlueGreenDeployment"))
+
+	api.BlueGreenDeployments[testID].Status = aws.String(StatusAvailable)
+	latest, err = rm.sdkFind(ctx, latest)
+	require.NoError(t, err)
+	updated, err = rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(updated).Status)
+	calls := api.CallsTo("SwitchoverBlueGreenDeployment")
+	require.Len(t, calls, 1)
+	assert.Equal(t, int32(600), *calls[0].(*svcsdk.SwitchoverBlueGreenDeploymentInput).SwitchoverTimeout)
+	assert.Equal(t, StatusSwitchoverInProgress, *api.BlueGreenDeployments[testID].Status)
+}
+
+func TestCustomUpdate_DeleteSourceAfterSwitchover(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired, latest := createDeployment(t, rm, api, StatusSwitchoverCompleted)
+	desired.ko.Spec.Switchover = aws.Bool(true)
+	desired.ko.Spec.DeleteSourceAfterSwitchover = aws.Bool(true)
+
+	updated, err := rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Error(t, err)
+	assert.Nil(t, updated.ko.Status.SourceDeleted)
+	calls := api.CallsTo("DeleteDBInstance")
+	require.Len(t, calls, 1)
+	input := calls[0].(*svcsdk.DeleteDBInstanceInput)
+	assert.Equal(t, "db-a", *input.DBInstanceIdentifier)
+	assert.True(t, *input.SkipFinalSnapshot)
+
+	// The DB instance is being deleted, so it isn't deleted again.
+	api.Calls = nil
+	_, err = rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Error(t, err)
+	assert.Equal(t, []string{"DescribeDBInstances"}, api.Operations())
+
+	delete(api.DBInstances, "db-a")
+	updated, err = rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.True(t, *updated.ko.Status.SourceDeleted)
+	assert.False(t, newResourceDelta(desired, updated).DifferentAt(deleteSourceDeltaPath))
+}
+
+func TestSourceMembers(t *testing.T) {
+	r := deploymentResource()
+	r.ko.Status.Target = aws.String("arn:aws:rds:us-west-2:111111111111:cluster:prod")
+	r.ko.Status.SwitchoverDetails = []*svcapitypes.SwitchoverDetail{
+		{
+			SourceMember: aws.String("arn:aws:rds:us-west-2:111111111111:cluster:prod-old1"),
+			TargetMember: aws.String("arn:aws:rds:us-west-2:111111111111:cluster:prod"),
+		},
+		{
+			SourceMember: aws.String("arn:aws:rds:us-west-2:111111111111:db:prod-1-old1"),
+			TargetMember: aws.String("arn:aws:rds:us-west-2:111111111111:db:prod-1"),
+		},
+		// A source member that is also a target is never deleted.
+		{
+			SourceMember: aws.String("arn:aws:rds:us-west-2:111111111111:db:prod-1"),
+		},
+	}
+	instances, clusters := sourceMembers(r)
+	assert.Equal(t, []string{"prod-1-old1"}, instances)
+	assert.Equal(t, []string{"prod-old1"}, clusters)
+}
+
+func TestCustomUpdate_ImmutableField(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired, latest := createDeployment(t, rm, api, StatusAvailable)
+	desired.ko.Spec.TargetEngineVersion = aws.String("17.0")
+
+	_, err := rm.customUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+	assert.Empty(t, api.Operations())
+}
+
+func TestCustomUpdate_Tags(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired, latest := createDeployment(t, rm, api, StatusAvailable)
+	desired.ko.Spec.Tags = []*svcapitypes.Tag{
+		{Key: aws.String("env"), Value: aws.String("prod")},
+	}
+
+	_, err := rm.customUpdate(ctx, desired, latest, descriptorDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"RemoveTagsFromResource", "AddTagsToResource"}, api.Operations())
+	latest, err = rm.sdkFind(ctx, latest)
+	require.NoError(t, err)
+	assert.Equal(t, "env", *latest.ko.Spec.Tags[0].Key)
+}
+
+func TestSdkDelete(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired, latest := createDeployment(t, rm, api, StatusSwitchoverCompleted)
+	desired.ko.Spec.DeleteTarget = aws.Bool(true)
+	latest.ko.Spec.DeleteTarget = aws.Bool(true)
+
+	_, err := rm.sdkDelete(ctx, latest)
+	require.NoError(t, err)
+	input := api.CallsTo("DeleteBlueGreenDeployment")[0].(*svcsdk.DeleteBlueGreenDeploymentInput)
+	assert.Nil(t, input.DeleteTarget)
+	assert.Equal(t, StatusDeleting, *api.BlueGreenDeployments[testID].Status)
+
+	latest, err = rm.sdkFind(ctx, latest)
+	require.NoError(t, err)
+	api.Calls = nil
+	_, err = rm.sdkDelete(ctx, latest)
+	assert.Error(t, err)
+	assert.Empty(t, api.Operations())
+}
+
+// fakeReader is a client.Reader that returns the objects it holds by name.
+type fakeReader struct {
+	client.Reader
+	objects map[string]client.Object
+}
+
+func (r *fakeReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	src, ok := r.objects[key.Name]
+	if !ok {
+		return ackerr.NotFound
+	}
+	switch o := obj.(type) {
+	case *svcapitypes.DBInstance:
+		*o = *src.(*svcapitypes.DBInstance)
+	case *svcapitypes.DBCluster:
+		*o = *src.(*svcapitypes.DBCluster)
+	}
+	return nil
+}
+
+func TestResolveReferences(t *testing.T) {
+	rm, _ := newTestManager()
+	ctx := context.Background()
+	synced := []*ackv1alpha1.Condition{{
+		Type:   ackv1alpha1.ConditionTypeResourceSynced,
+		Status: corev1.ConditionTrue,
+	}}
+	instanceARN := ackv1alpha1.AWSResourceName(testSourceARN)
+	clusterARN := ackv1alpha1.AWSResourceName("arn:aws:rds:us-west-2:111111111111:cluster:cluster-a")
+	reader := &fakeReader{objects: map[string]client.Object{
+		"instance": &svcapitypes.DBInstance{
+			Status: svcapitypes.DBInstanceStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &instanceARN},
+				Conditions:          synced,
+			},
+		},
+		"cluster": &svcapitypes.DBCluster{
+			Status: svcapitypes.DBClusterStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &clusterARN},
+				Conditions:          synced,
+			},
+		},
+	}}
+	refTo := func(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+		return &ackv1alpha1.AWSResourceReferenceWrapper{
+			From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+		}
+	}
+
+	r := deploymentResource()
+	r.ko.Spec.Source = nil
+	r.ko.Spec.SourceDBInstanceRef = refTo("instance")
+	resolved, hasRefs, err := rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.True(t, hasRefs)
+	assert.Equal(t, testSourceARN, *rm.concreteResource(resolved).ko.Spec.Source)
+
+	r = deploymentResource()
+	r.ko.Spec.Source = nil
+	r.ko.Spec.SourceDBClusterRef = refTo("cluster")
+	resolved, _, err = rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.Equal(t, string(clusterARN), *rm.concreteResource(resolved).ko.Spec.Source)
+
+	r = deploymentResource()
+	r.ko.Spec.Source = nil
+	r.ko.Spec.SourceDBClusterRef = refTo("cluster")
+	r.ko.Spec.SourceDBInstanceRef = refTo("instance")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+
+	r = deploymentResource()
+	r.ko.Spec.SourceDBInstanceRef = refTo("instance")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	// restartGenerationDeltaPath and failoverGenerationDeltaPath are the
+	// delta paths that customPreCompare uses to ask customUpdate to reboot
+	// or fail over the DB cluster.
+	restartGenerationDeltaPath  = "Spec.RestartGeneration"
+	failoverGenerationDeltaPath = "Spec.FailoverGeneration"
+)
+
+// errRebootAuroraCluster is returned when a restart generation is requested
+// for an Aurora DB cluster. RebootDBCluster only applies to Multi-AZ DB
+// clusters; the instances of an Aurora DB cluster are rebooted one by one.
+var errRebootAuroraCluster = errors.New(
+	"restartGeneration is only supported for Multi-AZ DB clusters, " +
+		"set restartGeneration on the DBInstance resources of the Aurora DB cluster instead",
+)
+
+// initActionGenerations records the action generations of a DB cluster that
+// is about to be created as already executed. A newly created DB cluster
+// has nothing to reboot or fail over.
+func initActionGenerations(r *resource) {
+	r.ko.Status.LastRestartGeneration = r.ko.Spec.RestartGeneration
+	r.ko.Status.LastFailoverGeneration = r.ko.Spec.FailoverGeneration
+}
+
+// compareActionGenerations adds a difference to the delta for each action
+// generation the desired DB cluster requests that the latest DB cluster has
+// not executed yet.
+func compareActionGenerations(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	requested := desired.ko.Spec.RestartGeneration
+	last := latest.ko.Status.LastRestartGeneration
+	if util.GenerationRequested(requested, last) {
+		delta.Add(restartGenerationDeltaPath, requested, last)
+	}
+	requested = desired.ko.Spec.FailoverGeneration
+	last = latest.ko.Status.LastFailoverGeneration
+	if util.GenerationRequested(requested, last) {
+		delta.Add(failoverGenerationDeltaPath, requested, last)
+	}
+}
+
+// clusterWriter returns the identifier of the writer instance of the
+// supplied DB cluster, or an empty string if it has none.
+func clusterWriter(r *resource) string {
+	for _, m := range r.ko.Status.DBClusterMembers {
+		if m != nil && m.IsClusterWriter != nil && *m.IsClusterWriter &&
+			m.DBInstanceIdentifier != nil {
+			return *m.DBInstanceIdentifier
+		}
+	}
+	return ""
+}
+
+// runRequestedAction executes one of the actions the delta requests, a reboot
+// before a failover, and records its generation in the desired DB cluster's
+// Status. Only one action is run per reconciliation since each
+// leaves the DB cluster unavailable until it completes.
+func (rm *resourceManager) runRequestedAction(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.runRequestedAction")
+	defer func() { exit(err) }()
+
+	var msg string
+	if delta.DifferentAt(restartGenerationDeltaPath) {
+		if isAuroraEngine(desired.ko.Spec.Engine) {
+			return nil, ackerr.NewTerminalError(errRebootAuroraCluster)
+		}
+		_, err = rm.sdkapi.RebootDBCluster(
+			ctx,
+			&svcsdk.RebootDBClusterInput{
+				DBClusterIdentifier: latest.ko.Spec.DBClusterIdentifier,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RebootDBCluster", err)
+		if err != nil {
+			return nil, err
+		}
+		desired.ko.Status.LastRestartGeneration = desired.ko.Spec.RestartGeneration
+		msg = fmt.Sprintf(
+			"DB cluster is rebooting for restart generation %d",
+			*desired.ko.Spec.RestartGeneration,
+		)
+	} else {
+		target := desired.ko.Spec.FailoverTargetDBInstanceIdentifier
+		if target != nil && *target == clusterWriter(latest) {
+			rlog.Debug("failover target is already the writer, skipping failover",
+				"target", *target)
+		} else {
+			_, err = rm.sdkapi.FailoverDBCluster(
+				ctx,
+				&svcsdk.FailoverDBClusterInput{
+					DBClusterIdentifier:        latest.ko.Spec.DBClusterIdentifier,
+					TargetDBInstanceIdentifier: target,
+				},
+			)
+			rm.metrics.RecordAPICall("UPDATE", "FailoverDBCluster", err)
+			if err != nil {
+				return nil, err
+			}
+		}
+		desired.ko.Status.LastFailoverGeneration = desired.ko.Spec.FailoverGeneration
+		msg = fmt.Sprintf(
+			"DB cluster is failing over for failover generation %d",
+			*desired.ko.Spec.FailoverGeneration,
+		)
+	}
+	// Setting resource synced condition to false will trigger a requeue of
+	// the resource. No need to return a requeue error here.
+	ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+	return desired, nil
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+// newActionCluster returns an available DB cluster of the supplied engine
+// with "db-1" as its writer and "db-2" as its reader.
+func newActionCluster(engine string) *resource {
+	return &resource{
+		ko: &svcapitypes.DBCluster{
+			Spec: svcapitypes.DBClusterSpec{
+				DBClusterIdentifier: aws.String("cluster"),
+				Engine:              aws.String(engine),
+			},
+			Status: svcapitypes.DBClusterStatus{
+				Status: aws.String(StatusAvailable),
+				DBClusterMembers: []*svcapitypes.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("db-1"), IsClusterWriter: aws.Bool(true)},
+					{DBInstanceIdentifier: aws.String("db-2"), IsClusterWriter: aws.Bool(false)},
+				},
+			},
+		},
+	}
+}
+
+func TestCustomUpdate_RequestedActions(t *testing.T) {
+	tests := []struct {
+		name         string
+		engine       string
+		restart      *int64
+		failover     *int64
+		target       *string
+		wantOps      []string
+		wantRestart  *int64
+		wantFailover *int64
+		wantTerminal bool
+	}{
+		{
+			name:        "Multi-AZ DB cluster is rebooted",
+			engine:      "postgres",
+			restart:     aws.Int64(1),
+			wantOps:     []string{"RebootDBCluster"},
+			wantRestart: aws.Int64(1),
+		},
+		{
+			name:         "Aurora DB cluster cannot be rebooted",
+			engine:       "aurora-postgresql",
+			restart:      aws.Int64(1),
+			wantTerminal: true,
+		},
+		{
+			name:         "failover to the requested target",
+			engine:       "aurora-postgresql",
+			failover:     aws.Int64(1),
+			target:       aws.String("db-2"),
+			wantOps:      []string{"FailoverDBCluster"},
+			wantFailover: aws.Int64(1),
+		},
+		{
+			name:         "failover to the current writer is skipped",
+			engine:       "aurora-postgresql",
+			failover:     aws.Int64(1),
+			target:       aws.String("db-1"),
+			wantFailover: aws.Int64(1),
+		},
+		{
+			name:        "reboot runs before failover",
+			engine:      "postgres",
+			restart:     aws.Int64(1),
+			failover:    aws.Int64(1),
+			wantOps:     []string{"RebootDBCluster"},
+			wantRestart: aws.Int64(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBClusters["cluster"] = &svcsdktypes.DBCluster{
+				DBClusterIdentifier: aws.String("cluster"),
+				Status:              aws.String(fake.StatusAvailable),
+				DBClusterMembers: []svcsdktypes.DBClusterMember{
+					{DBInstanceIdentifier: aws.String("db-1"), IsClusterWriter: aws.Bool(true)},
+					{DBInstanceIdentifier: aws.String("db-2"), IsClusterWriter: aws.Bool(false)},
+				},
+			}
+			rm := &resourceManager{
+				metrics: ackmetrics.NewMetrics("rds"),
+				sdkapi:  api,
+			}
+			desired := newActionCluster(tt.engine)
+			desired.ko.Spec.RestartGeneration = tt.restart
+			desired.ko.Spec.FailoverGeneration = tt.failover
+			desired.ko.Spec.FailoverTargetDBInstanceIdentifier = tt.target
+			latest := newActionCluster(tt.engine)
+			delta := ackcompare.NewDelta()
+			compareActionGenerations(delta, desired, latest)
+
+			updated, err := rm.customUpdate(context.Background(), desired, latest, delta)
+			if tt.wantTerminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+				assert.Empty(t, api.Operations())
+				return
+			}
+			require.NoError(t, err)
+			if len(tt.wantOps) == 0 {
+				assert.Empty(t, api.Operations())
+			} else {
+				assert.Equal(t, tt.wantOps, api.Operations())
+			}
+			assert.Equal(t, tt.wantRestart, updated.ko.Status.LastRestartGeneration)
+			assert.Equal(t, tt.wantFailover, updated.ko.Status.LastFailoverGeneration)
+			if tt.target != nil && len(tt.wantOps) > 0 {
+				input := api.CallsTo("FailoverDBCluster")[0].(*svcsdk.FailoverDBClusterInput)
+				assert.Equal(t, *tt.target, *input.TargetDBInstanceIdentifier)
+				assert.True(t, *api.DBClusters["cluster"].DBClusterMembers[1].IsClusterWriter)
+			}
+
+			// Once recorded, the generations are not executed again.
+			delta = ackcompare.NewDelta()
+			compareActionGenerations(delta, desired, updated)
+			assert.False(t, delta.DifferentAt(restartGenerationDeltaPath))
+			if tt.wantFailover != nil {
+				assert.False(t, delta.DifferentAt(failoverGenerationDeltaPath))
+			}
+		})
+	}
+}
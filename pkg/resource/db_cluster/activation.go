@@ -0,0 +1,119 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// activationStateDeltaPath is the delta path that customPreCompare uses to
+// ask customUpdate to start or stop the DB cluster.
+const activationStateDeltaPath = "Spec.ActivationState"
+
+// msgCannotModifyWhileStopped is the Synced condition message of a stopped
+// DB cluster with changes that can't be applied until it is started.
+const msgCannotModifyWhileStopped = "DB cluster cannot be modified while stopped, " +
+	"set activationState to '" + util.ActivationStateStarted + "' to apply the changes"
+
+// requeueWaitWhileStopped is returned when a stopped DB cluster has changes
+// that can only be applied once it is started. Changing the activation state
+// triggers a reconcile by itself, so there is no need to poll often.
+var requeueWaitWhileStopped = ackrequeue.NeededAfter(
+	errors.New("DB cluster in 'stopped' state, cannot be modified until it is started."),
+	10*time.Minute,
+)
+
+// clusterStopped returns true if the supplied DB cluster is stopped.
+func clusterStopped(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	return *r.ko.Status.Status == StatusStopped
+}
+
+// stoppedAsDesired returns true if the latest DB cluster is stopped and the
+// desired DB cluster doesn't ask for it to be started.
+func stoppedAsDesired(desired *resource, latest *resource) bool {
+	return clusterStopped(latest) &&
+		!util.WantsStarted(desired.ko.Spec.ActivationState)
+}
+
+// compareActivationState adds a difference to the delta when the status of
+// the latest DB cluster doesn't match the desired activation state. A
+// stopping DB cluster counts as stopped, the starting direction is left to
+// the availability checks in customUpdate.
+func compareActivationState(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	state := desired.ko.Spec.ActivationState
+	status := latest.ko.Status.Status
+	if state == nil || status == nil {
+		return
+	}
+	stoppedOrStopping := *status == StatusStopped || *status == StatusStopping
+	if (util.WantsStarted(state) && stoppedOrStopping) ||
+		(util.WantsStopped(state) && !stoppedOrStopping) {
+		delta.Add(activationStateDeltaPath, state, status)
+	}
+}
+
+// startDBCluster calls the RDS StartDBCluster API for the supplied DB
+// cluster.
+func (rm *resourceManager) startDBCluster(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.startDBCluster")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.StartDBCluster(
+		ctx,
+		&svcsdk.StartDBClusterInput{
+			DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "StartDBCluster", err)
+	return err
+}
+
+// stopDBCluster calls the RDS StopDBCluster API for the supplied DB cluster.
+func (rm *resourceManager) stopDBCluster(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.stopDBCluster")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.StopDBCluster(
+		ctx,
+		&svcsdk.StopDBClusterInput{
+			DBClusterIdentifier: r.ko.Spec.DBClusterIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "StopDBCluster", err)
+	return err
+}
@@ -0,0 +1,107 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestCustomUpdate_ActivationState(t *testing.T) {
+	tests := []struct {
+		name        string
+		state       *string
+		status      string
+		modify      bool
+		wantOps     []string
+		wantRequeue bool
+	}{
+		{
+			name:    "available DB cluster is stopped",
+			state:   aws.String(util.ActivationStateStopped),
+			status:  fake.StatusAvailable,
+			wantOps: []string{"StopDBCluster"},
+		},
+		{
+			name:    "modifications are applied before stopping",
+			state:   aws.String(util.ActivationStateStopped),
+			status:  fake.StatusAvailable,
+			modify:  true,
+			wantOps: []string{"ModifyDBCluster"},
+		},
+		{
+			name:    "stopped DB cluster is started",
+			state:   aws.String(util.ActivationStateStarted),
+			status:  fake.StatusStopped,
+			wantOps: []string{"StartDBCluster"},
+		},
+		{
+			name:        "stopped DB cluster with modifications waits to be started",
+			status:      fake.StatusStopped,
+			modify:      true,
+			wantRequeue: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBClusters["cluster"] = &svcsdktypes.DBCluster{
+				DBClusterIdentifier: aws.String("cluster"),
+				Status:              aws.String(tt.status),
+			}
+			rm := &resourceManager{
+				metrics: ackmetrics.NewMetrics("rds"),
+				sdkapi:  api,
+			}
+			desired := &resource{ko: &svcapitypes.DBCluster{
+				Spec: svcapitypes.DBClusterSpec{
+					DBClusterIdentifier: aws.String("cluster"),
+					Engine:              aws.String("aurora-postgresql"),
+					ActivationState:     tt.state,
+				},
+			}}
+			latest := desired.DeepCopy().(*resource)
+			latest.ko.Status.Status = aws.String(tt.status)
+			delta := ackcompare.NewDelta()
+			if tt.modify {
+				desired.ko.Spec.DeletionProtection = aws.Bool(true)
+				delta.Add("Spec.DeletionProtection", desired.ko.Spec.DeletionProtection, nil)
+			}
+			compareActivationState(delta, desired, latest)
+
+			_, err := rm.customUpdate(context.Background(), desired, latest, delta)
+			if tt.wantRequeue {
+				assert.Equal(t, requeueWaitWhileStopped, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if len(tt.wantOps) == 0 {
+				assert.Empty(t, api.Operations())
+			} else {
+				assert.Equal(t, tt.wantOps, api.Operations())
+			}
+		})
+	}
+}
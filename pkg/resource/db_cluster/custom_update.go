@@ -57,13 +57,34 @@ func (rm *resourceManager) customUpdate(
 	if clusterCreating(latest) {
 		msg := "DB cluster is currently being created"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if clusterStopped(latest) {
+		if delta.DifferentAt(activationStateDeltaPath) {
+			if err = rm.startDBCluster(ctx, latest); err != nil {
+				return nil, err
+			}
+			msg := "DB cluster is being started"
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+			return desired, nil
+		}
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		if !delta.DifferentExcept("Spec.Tags") {
+			return desired, nil
+		}
+		msg := msgCannotModifyWhileStopped
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileStopped
 	}
 	if !clusterAvailable(latest) {
 		msg := "DB cluster is not available for modification in '" +
 			*latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if clusterHasTerminalStatus(latest) {
 		msg := "DB cluster is in '" + *latest.ko.Status.Status + "' status"
@@ -71,13 +92,61 @@ func (rm *resourceManager) customUpdate(
 		ackcondition.SetSynced(desired, corev1.ConditionTrue, nil, nil)
 		return desired, nil
 	}
+	if delta.DifferentAt(roleAssociationsDeltaPath) {
+		// Roles are associated and disassociated with their own API calls,
+		// ahead of any other change to the DB cluster.
+		if err = rm.syncRoleAssociations(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt(activationStateDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, activationStateDeltaPath, pendingRebootDeltaPath) {
+		// A stopped DB cluster can't be modified, so it is only stopped once
+		// there is nothing else to change. Parameter changes pending a reboot
+		// take effect when it is started again.
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		if err = rm.stopDBCluster(ctx, latest); err != nil {
+			return nil, err
+		}
+		msg := "DB cluster is being stopped"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, nil
+	}
+	if delta.DifferentAt(restartGenerationDeltaPath) ||
+		delta.DifferentAt(failoverGenerationDeltaPath) {
+		// Requested actions run ahead of any modification, which is picked
+		// up by a later reconciliation once the DB cluster is available.
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		return rm.runRequestedAction(ctx, desired, latest, delta)
+	}
+	if delta.DifferentAt(pendingRebootDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, pendingRebootDeltaPath) {
+		// Only reboot once there is nothing else to modify. The
+		// ModifyDBCluster call below may itself leave changes pending a
+		// reboot, which are then picked up by a later reconciliation.
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		return rm.rebootForPendingParameters(ctx, desired, latest)
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
-	} else if !delta.DifferentExcept("Spec.Tags") {
-		// If the only difference between the desired and latest is in the
-		// Spec.Tags field, we can skip the modify db cluster call.
+	} else if !delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath) {
+		// If the only differences between the desired and latest are in the
+		// Spec.Tags and Spec.RoleAssociations fields, we can skip the modify
+		// db cluster call.
 		return desired, nil
 	}
 
@@ -42,7 +42,6 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
-	compareTags(delta, a, b)
 
 	// Handle special case for StorageType field for Aurora engines
 	// When StorageType is set to "aurora" (default), the API doesn't return it
@@ -73,6 +72,10 @@ func newResourceDelta(
 	}
 
 	compareSecretReferenceChanges(delta, a, b)
+	comparePendingReboot(delta, a, b)
+	compareActionGenerations(delta, a, b)
+	compareActivationState(delta, a, b)
+	compareRoleAssociations(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage) {
 		delta.Add("Spec.AllocatedStorage", a.ko.Spec.AllocatedStorage, b.ko.Spec.AllocatedStorage)
@@ -269,6 +272,9 @@ func newResourceDelta(
 			delta.Add("Spec.GlobalClusterIdentifier", a.ko.Spec.GlobalClusterIdentifier, b.ko.Spec.GlobalClusterIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.GlobalClusterIdentifierRef, b.ko.Spec.GlobalClusterIdentifierRef) {
+		delta.Add("Spec.GlobalClusterIdentifierRef", a.ko.Spec.GlobalClusterIdentifierRef, b.ko.Spec.GlobalClusterIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.IOPS, b.ko.Spec.IOPS) {
 		delta.Add("Spec.IOPS", a.ko.Spec.IOPS, b.ko.Spec.IOPS)
 	} else if a.ko.Spec.IOPS != nil && b.ko.Spec.IOPS != nil {
@@ -348,6 +354,9 @@ func newResourceDelta(
 			delta.Add("Spec.OptionGroupName", a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef) {
+		delta.Add("Spec.OptionGroupRef", a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.PerformanceInsightsKMSKeyID, b.ko.Spec.PerformanceInsightsKMSKeyID) {
 		delta.Add("Spec.PerformanceInsightsKMSKeyID", a.ko.Spec.PerformanceInsightsKMSKeyID, b.ko.Spec.PerformanceInsightsKMSKeyID)
 	} else if a.ko.Spec.PerformanceInsightsKMSKeyID != nil && b.ko.Spec.PerformanceInsightsKMSKeyID != nil {
@@ -407,6 +416,9 @@ func newResourceDelta(
 			delta.Add("Spec.ReplicationSourceIdentifier", a.ko.Spec.ReplicationSourceIdentifier, b.ko.Spec.ReplicationSourceIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.ReplicationSourceIdentifierRef, b.ko.Spec.ReplicationSourceIdentifierRef) {
+		delta.Add("Spec.ReplicationSourceIdentifierRef", a.ko.Spec.ReplicationSourceIdentifierRef, b.ko.Spec.ReplicationSourceIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.RestoreToTime, b.ko.Spec.RestoreToTime) {
 		delta.Add("Spec.RestoreToTime", a.ko.Spec.RestoreToTime, b.ko.Spec.RestoreToTime)
 	} else if a.ko.Spec.RestoreToTime != nil && b.ko.Spec.RestoreToTime != nil {
@@ -499,6 +511,9 @@ func newResourceDelta(
 			delta.Add("Spec.SnapshotIdentifier", a.ko.Spec.SnapshotIdentifier, b.ko.Spec.SnapshotIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SnapshotIdentifierRef, b.ko.Spec.SnapshotIdentifierRef) {
+		delta.Add("Spec.SnapshotIdentifierRef", a.ko.Spec.SnapshotIdentifierRef, b.ko.Spec.SnapshotIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBClusterIdentifier, b.ko.Spec.SourceDBClusterIdentifier) {
 		delta.Add("Spec.SourceDBClusterIdentifier", a.ko.Spec.SourceDBClusterIdentifier, b.ko.Spec.SourceDBClusterIdentifier)
 	} else if a.ko.Spec.SourceDBClusterIdentifier != nil && b.ko.Spec.SourceDBClusterIdentifier != nil {
@@ -506,6 +521,9 @@ func newResourceDelta(
 			delta.Add("Spec.SourceDBClusterIdentifier", a.ko.Spec.SourceDBClusterIdentifier, b.ko.Spec.SourceDBClusterIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SourceDBClusterIdentifierRef, b.ko.Spec.SourceDBClusterIdentifierRef) {
+		delta.Add("Spec.SourceDBClusterIdentifierRef", a.ko.Spec.SourceDBClusterIdentifierRef, b.ko.Spec.SourceDBClusterIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion) {
 		delta.Add("Spec.SourceRegion", a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion)
 	} else if a.ko.Spec.SourceRegion != nil && b.ko.Spec.SourceRegion != nil {
@@ -26,7 +26,6 @@ import (
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
-	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
@@ -94,7 +93,10 @@ var requeueWaitWhileDeleting = ackrequeue.NeededAfter(
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
 // explaining the DB instance cannot be modified until it reaches an available
 // status.
-func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+func (rm *resourceManager) requeueWaitUntilCanModify(
+	ctx context.Context,
+	r *resource,
+) *ackrequeue.RequeueNeededAfter {
 	if r.ko.Status.Status == nil {
 		return nil
 	}
@@ -103,9 +105,8 @@ func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
 		"DB cluster in '%s' state, cannot be modified until '%s'.",
 		status, StatusAvailable,
 	)
-	return ackrequeue.NeededAfter(
-		errors.New(msg),
-		ackrequeue.DefaultRequeueAfterDuration,
+	return rm.requeuePolicy.NeededAfter(
+		ctx, GroupKind.Kind, r.ko, status, errors.New(msg),
 	)
 }
 
@@ -172,23 +173,6 @@ func clearAuroraAllocatedStorage(ko *svcapitypes.DBCluster) {
 }
 
 // syncTags keeps the resource's tags in sync
-//
-// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
-// following ways:
-//
-//  1. The names of the tagging API operations are different. Other APIs use the
-//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
-//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
-//     `RemoveTagsFromResource`.
-//
-//  2. Even though the name of the `ListTagsForResource` API call is the same,
-//     the structure of the input and the output are different from other APIs.
-//     For the input, instead of a `ResourceArn` field, RDS names the field
-//     `ResourceName`, but actually expects an ARN, not the cluster
-//     name.  This is the same for the `AddTagsToResource` and
-//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
-//     called `TagList` instead of `Tags` but is otherwise the same struct with
-//     a `Key` and `Value` member field.
 func (rm *resourceManager) syncTags(
 	ctx context.Context,
 	desired *resource,
@@ -199,101 +183,23 @@ func (rm *resourceManager) syncTags(
 	defer func() { exit(err) }()
 
 	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
-
-	toAdd, toDelete := util.ComputeTagsDelta(
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
 		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
 	)
-
-	if len(toDelete) > 0 {
-		rlog.Debug("removing tags from cluster", "tags", toDelete)
-		_, err = rm.sdkapi.RemoveTagsFromResource(
-			ctx,
-			&svcsdk.RemoveTagsFromResourceInput{
-				ResourceName: arn,
-				TagKeys:      toDelete,
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
-		if err != nil {
-			return err
-		}
-	}
-
-	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
-	// with a new value overwrites any existing tag with the same key. So, we
-	// don't need to do anything to "update" a Tag. Simply including it in the
-	// AddTagsToResource call is enough.
-	if len(toAdd) > 0 {
-		rlog.Debug("adding tags to cluster", "tags", toAdd)
-		_, err = rm.sdkapi.AddTagsToResource(
-			ctx,
-			&svcsdk.AddTagsToResourceInput{
-				ResourceName: arn,
-				Tags:         sdkTagsFromResourceTags(toAdd),
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// getTags retrieves the resource's associated tags
-func (rm *resourceManager) getTags(
-	ctx context.Context,
-	resourceARN string,
-) ([]*svcapitypes.Tag, error) {
-	resp, err := rm.sdkapi.ListTagsForResource(
-		ctx,
-		&svcsdk.ListTagsForResourceInput{
-			ResourceName: &resourceARN,
-		},
-	)
-	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
-	if err != nil {
-		return nil, err
-	}
-	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
-	for _, tag := range resp.TagList {
-		tags = append(tags, &svcapitypes.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
-	}
-	return tags, nil
 }
 
 // compareTags adds a difference to the delta if the supplied resources have
-// different tag collections
+// different tag collections, as reconciled by the supplied TagReconciler
 func compareTags(
+	tags *util.TagReconciler,
 	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
-	if len(a.ko.Spec.Tags) != len(b.ko.Spec.Tags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-	} else if len(a.ko.Spec.Tags) > 0 {
-		if !util.EqualTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
-			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-		}
-	}
-}
-
-// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
-// array.
-func sdkTagsFromResourceTags(
-	rTags []*svcapitypes.Tag,
-) []svcsdktypes.Tag {
-	tags := make([]svcsdktypes.Tag, len(rTags))
-	for i := range rTags {
-		tags[i] = svcsdktypes.Tag{
-			Key:   rTags[i].Key,
-			Value: rTags[i].Value,
-		}
-	}
-	return tags
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
 }
 
 // function to create restoreDbClusterFromSnapshot payload and call restoreDbClusterFromSnapshot API
@@ -0,0 +1,66 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"errors"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+)
+
+// syncMasterUserSecret copies the master user secret RDS manages in Secrets
+// Manager for the supplied DB cluster to the Secret of its
+// MasterUserSecretSyncName, if set, and records the version copied in
+// Status.MasterUserSecretVersionID. While the master user secret is being
+// rotated, the DB cluster isn't synced so that it is checked again soon and
+// the new version is copied once the rotation completes.
+//
+// It runs on every read, so it is skipped unless the DB cluster is available
+// and not being deleted.
+func (rm *resourceManager) syncMasterUserSecret(
+	ctx context.Context,
+	ko *svcapitypes.DBCluster,
+) error {
+	mus := ko.Status.MasterUserSecret
+	if aws.ToString(ko.Spec.MasterUserSecretSyncName) == "" ||
+		mus == nil || mus.SecretARN == nil {
+		return nil
+	}
+	if ko.DeletionTimestamp != nil || !clusterAvailable(&resource{ko}) {
+		return nil
+	}
+	syncer := rm.masterUserSecretSyncer
+	if syncer == nil {
+		return errors.New("master user secret can't be copied: no syncer is configured")
+	}
+	versionID, err := syncer.Sync(
+		ctx, ko, *mus.SecretARN, *ko.Spec.MasterUserSecretSyncName,
+		ko.Status.MasterUserSecretVersionID,
+	)
+	if err != nil {
+		return err
+	}
+	ko.Status.MasterUserSecretVersionID = versionID
+	if secrets.Rotating(mus.SecretStatus) {
+		msg := "master user secret is being rotated"
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
+	return nil
+}
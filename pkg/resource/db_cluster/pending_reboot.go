@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// pendingRebootDeltaPath is the delta path that customPreCompare uses to ask
+// customUpdate to reboot a DB cluster whose members have cluster parameter
+// group changes pending a reboot.
+const pendingRebootDeltaPath = "Status.DBClusterMembers"
+
+// pendingRebootMembers returns the members of the supplied DB cluster that
+// only pick up changes to the DB cluster parameter group after they are
+// rebooted. Readers are returned before the writer.
+func pendingRebootMembers(r *resource) []*svcapitypes.DBClusterMember {
+	readers := []*svcapitypes.DBClusterMember{}
+	writers := []*svcapitypes.DBClusterMember{}
+	for _, m := range r.ko.Status.DBClusterMembers {
+		if m == nil || m.DBInstanceIdentifier == nil || m.DBClusterParameterGroupStatus == nil {
+			continue
+		}
+		if *m.DBClusterParameterGroupStatus != util.ApplyMethodPendingReboot {
+			continue
+		}
+		if m.IsClusterWriter != nil && *m.IsClusterWriter {
+			writers = append(writers, m)
+		} else {
+			readers = append(readers, m)
+		}
+	}
+	return append(readers, writers...)
+}
+
+// memberIdentifiers returns the DB instance identifiers of the supplied DB
+// cluster members.
+func memberIdentifiers(members []*svcapitypes.DBClusterMember) []string {
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, *m.DBInstanceIdentifier)
+	}
+	return ids
+}
+
+// setPendingRebootCondition sets the PendingReboot advisory condition on the
+// supplied DB cluster when any of its members have DB cluster parameter
+// group changes pending a reboot, and removes it otherwise.
+func setPendingRebootCondition(r *resource) {
+	pending := pendingRebootMembers(r)
+	if len(pending) == 0 {
+		util.SetPendingRebootCondition(r, nil)
+		return
+	}
+	msg := fmt.Sprintf(
+		"changes to the DB cluster parameter group take effect after DB instance(s) %s are rebooted",
+		strings.Join(memberIdentifiers(pending), ", "),
+	)
+	util.SetPendingRebootCondition(r, &msg)
+}
+
+// comparePendingReboot adds a difference to the delta when the desired DB
+// cluster is opted into being rebooted and the latest DB cluster has members
+// with DB cluster parameter group changes pending a reboot.
+func comparePendingReboot(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if !util.RebootOnPendingParameters(desired.ko.GetAnnotations()) {
+		return
+	}
+	if pending := pendingRebootMembers(latest); len(pending) > 0 {
+		delta.Add(pendingRebootDeltaPath, nil, memberIdentifiers(pending))
+	}
+}
+
+// rebootForPendingParameters reboots the supplied DB cluster so that its
+// members pick up pending DB cluster parameter group changes.
+//
+// Multi-AZ DB clusters are rebooted as a whole with RebootDBCluster. Aurora
+// DB clusters cannot be, so their pending members are rebooted one at a time
+// with RebootDBInstance, readers before the writer, waiting for each to be
+// available again before rebooting the next. This keeps the cluster serving
+// while the reboots roll through it.
+func (rm *resourceManager) rebootForPendingParameters(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rebootForPendingParameters")
+	defer func() { exit(err) }()
+
+	var msg string
+	if !isAuroraEngine(latest.ko.Spec.Engine) {
+		_, err = rm.sdkapi.RebootDBCluster(
+			ctx,
+			&svcsdk.RebootDBClusterInput{
+				DBClusterIdentifier: latest.ko.Spec.DBClusterIdentifier,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RebootDBCluster", err)
+		if err != nil {
+			return nil, err
+		}
+		msg = "DB cluster is rebooting to apply pending parameter group changes"
+	} else {
+		member := pendingRebootMembers(latest)[0]
+		var resp *svcsdk.DescribeDBInstancesOutput
+		resp, err = rm.sdkapi.DescribeDBInstances(
+			ctx,
+			&svcsdk.DescribeDBInstancesInput{
+				DBInstanceIdentifier: member.DBInstanceIdentifier,
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "DescribeDBInstances", err)
+		if err != nil {
+			return nil, err
+		}
+		if len(resp.DBInstances) == 0 ||
+			resp.DBInstances[0].DBInstanceStatus == nil ||
+			*resp.DBInstances[0].DBInstanceStatus != StatusAvailable {
+			msg = fmt.Sprintf(
+				"waiting for DB instance %s to be available before rebooting it to apply pending parameter group changes",
+				*member.DBInstanceIdentifier,
+			)
+		} else {
+			_, err = rm.sdkapi.RebootDBInstance(
+				ctx,
+				&svcsdk.RebootDBInstanceInput{
+					DBInstanceIdentifier: member.DBInstanceIdentifier,
+				},
+			)
+			rm.metrics.RecordAPICall("UPDATE", "RebootDBInstance", err)
+			if err != nil {
+				return nil, err
+			}
+			msg = fmt.Sprintf(
+				"DB instance %s is rebooting to apply pending parameter group changes",
+				*member.DBInstanceIdentifier,
+			)
+		}
+	}
+	// Setting resource synced condition to false will trigger a requeue of
+	// the resource. No need to return a requeue error here.
+	ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+	return desired, nil
+}
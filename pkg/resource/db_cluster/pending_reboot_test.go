@@ -0,0 +1,140 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// newPendingRebootCluster returns an available DB cluster of the supplied
+// engine whose writer ("db-1") and reader ("db-2") both have DB cluster
+// parameter group changes pending a reboot.
+func newPendingRebootCluster(engine string) *resource {
+	return &resource{
+		ko: &svcapitypes.DBCluster{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				svcapitypes.RebootOnPendingParametersAnnotation: "true",
+			}},
+			Spec: svcapitypes.DBClusterSpec{
+				DBClusterIdentifier: aws.String("cluster"),
+				Engine:              aws.String(engine),
+			},
+			Status: svcapitypes.DBClusterStatus{
+				Status: aws.String(StatusAvailable),
+				DBClusterMembers: []*svcapitypes.DBClusterMember{
+					{
+						DBInstanceIdentifier:          aws.String("db-1"),
+						IsClusterWriter:               aws.Bool(true),
+						DBClusterParameterGroupStatus: aws.String(util.ApplyMethodPendingReboot),
+					},
+					{
+						DBInstanceIdentifier:          aws.String("db-2"),
+						IsClusterWriter:               aws.Bool(false),
+						DBClusterParameterGroupStatus: aws.String(util.ApplyMethodPendingReboot),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetPendingRebootCondition(t *testing.T) {
+	r := newPendingRebootCluster("aurora-postgresql")
+
+	setPendingRebootCondition(r)
+	cond := ackcondition.AdvisoryWithReason(r, util.ConditionReasonPendingReboot)
+	require.NotNil(t, cond)
+	assert.Contains(t, *cond.Message, "db-2, db-1")
+
+	for _, m := range r.ko.Status.DBClusterMembers {
+		m.DBClusterParameterGroupStatus = aws.String("in-sync")
+	}
+	setPendingRebootCondition(r)
+	assert.Nil(t, ackcondition.AdvisoryWithReason(r, util.ConditionReasonPendingReboot))
+}
+
+func TestCustomUpdate_RebootForPendingParameters(t *testing.T) {
+	tests := []struct {
+		name         string
+		engine       string
+		readerStatus string
+		wantOps      []string
+		wantRebooted string
+	}{
+		{
+			name:         "Aurora reader is rebooted before the writer",
+			engine:       "aurora-postgresql",
+			readerStatus: fake.StatusAvailable,
+			wantOps:      []string{"DescribeDBInstances", "RebootDBInstance"},
+			wantRebooted: "db-2",
+		},
+		{
+			name:         "Aurora member that is not available is waited for",
+			engine:       "aurora-postgresql",
+			readerStatus: fake.StatusRebooting,
+			wantOps:      []string{"DescribeDBInstances"},
+		},
+		{
+			name:    "Multi-AZ DB cluster is rebooted as a whole",
+			engine:  "postgres",
+			wantOps: []string{"RebootDBCluster"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBClusters["cluster"] = &svcsdktypes.DBCluster{
+				DBClusterIdentifier: aws.String("cluster"),
+				Status:              aws.String(fake.StatusAvailable),
+			}
+			api.DBInstances["db-2"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db-2"),
+				DBInstanceStatus:     aws.String(tt.readerStatus),
+			}
+			rm := &resourceManager{
+				metrics: ackmetrics.NewMetrics("rds"),
+				sdkapi:  api,
+			}
+			desired := newPendingRebootCluster(tt.engine)
+			latest := newPendingRebootCluster(tt.engine)
+			delta := ackcompare.NewDelta()
+			comparePendingReboot(delta, desired, latest)
+
+			_, err := rm.customUpdate(context.Background(), desired, latest, delta)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantOps, api.Operations())
+			if tt.wantRebooted != "" {
+				calls := api.CallsTo("RebootDBInstance")
+				assert.Equal(t, tt.wantRebooted, *calls[0].(*svcsdk.RebootDBInstanceInput).DBInstanceIdentifier)
+			}
+			assert.False(t, ackcondition.Synced(desired).Status == "True")
+		})
+	}
+}
@@ -64,6 +64,10 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.DBSubnetGroupName = nil
 	}
 
+	if ko.Spec.GlobalClusterIdentifierRef != nil {
+		ko.Spec.GlobalClusterIdentifier = nil
+	}
+
 	if ko.Spec.KMSKeyRef != nil {
 		ko.Spec.KMSKeyID = nil
 	}
@@ -76,10 +80,32 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.MonitoringRoleARN = nil
 	}
 
+	if ko.Spec.OptionGroupRef != nil {
+		ko.Spec.OptionGroupName = nil
+	}
+
 	if ko.Spec.PerformanceInsightsKMSKeyRef != nil {
 		ko.Spec.PerformanceInsightsKMSKeyID = nil
 	}
 
+	if ko.Spec.ReplicationSourceIdentifierRef != nil {
+		ko.Spec.ReplicationSourceIdentifier = nil
+	}
+
+	for f0idx, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil {
+			ko.Spec.RoleAssociations[f0idx].RoleARN = nil
+		}
+	}
+
+	if ko.Spec.SnapshotIdentifierRef != nil {
+		ko.Spec.SnapshotIdentifier = nil
+	}
+
+	if ko.Spec.SourceDBClusterIdentifierRef != nil {
+		ko.Spec.SourceDBClusterIdentifier = nil
+	}
+
 	if len(ko.Spec.VPCSecurityGroupRefs) > 0 {
 		ko.Spec.VPCSecurityGroupIDs = nil
 	}
@@ -115,6 +141,12 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForGlobalClusterIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForKMSKeyID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -133,12 +165,42 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForOptionGroupName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForPerformanceInsightsKMSKeyID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForReplicationSourceIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForRoleAssociations_RoleARN(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForSnapshotIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForSourceDBClusterIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForVPCSecurityGroupIDs(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -160,6 +222,10 @@ func validateReferenceFields(ko *svcapitypes.DBCluster) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBSubnetGroupName", "DBSubnetGroupRef")
 	}
 
+	if ko.Spec.GlobalClusterIdentifierRef != nil && ko.Spec.GlobalClusterIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("GlobalClusterIdentifier", "GlobalClusterIdentifierRef")
+	}
+
 	if ko.Spec.KMSKeyRef != nil && ko.Spec.KMSKeyID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("KMSKeyID", "KMSKeyRef")
 	}
@@ -172,10 +238,32 @@ func validateReferenceFields(ko *svcapitypes.DBCluster) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("MonitoringRoleARN", "MonitoringRoleRef")
 	}
 
+	if ko.Spec.OptionGroupRef != nil && ko.Spec.OptionGroupName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("OptionGroupName", "OptionGroupRef")
+	}
+
 	if ko.Spec.PerformanceInsightsKMSKeyRef != nil && ko.Spec.PerformanceInsightsKMSKeyID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("PerformanceInsightsKMSKeyID", "PerformanceInsightsKMSKeyRef")
 	}
 
+	if ko.Spec.ReplicationSourceIdentifierRef != nil && ko.Spec.ReplicationSourceIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("ReplicationSourceIdentifier", "ReplicationSourceIdentifierRef")
+	}
+
+	for _, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil && f0iter.RoleARN != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("RoleAssociations.RoleARN", "RoleAssociations.RoleRef")
+		}
+	}
+
+	if ko.Spec.SnapshotIdentifierRef != nil && ko.Spec.SnapshotIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("SnapshotIdentifier", "SnapshotIdentifierRef")
+	}
+
+	if ko.Spec.SourceDBClusterIdentifierRef != nil && ko.Spec.SourceDBClusterIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("SourceDBClusterIdentifier", "SourceDBClusterIdentifierRef")
+	}
+
 	if len(ko.Spec.VPCSecurityGroupRefs) > 0 && len(ko.Spec.VPCSecurityGroupIDs) > 0 {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("VPCSecurityGroupIDs", "VPCSecurityGroupRefs")
 	}
@@ -364,6 +452,97 @@ func getReferencedResourceState_DBSubnetGroup(
 	return nil
 }
 
+// resolveReferenceForGlobalClusterIdentifier reads the resource referenced
+// from GlobalClusterIdentifierRef field and sets the GlobalClusterIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForGlobalClusterIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.GlobalClusterIdentifierRef != nil && ko.Spec.GlobalClusterIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.GlobalClusterIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: GlobalClusterIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.GlobalCluster{}
+		if err := getReferencedResourceState_GlobalCluster(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.GlobalClusterIdentifier = (*string)(obj.Status.ACKResourceMetadata.ARN)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_GlobalCluster looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_GlobalCluster(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.GlobalCluster,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"GlobalCluster",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"GlobalCluster",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"GlobalCluster",
+			namespace, name)
+	}
+	if obj.Status.ACKResourceMetadata == nil || obj.Status.ACKResourceMetadata.ARN == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"GlobalCluster",
+			namespace, name,
+			"Status.ACKResourceMetadata.ARN")
+	}
+	return nil
+}
+
 // resolveReferenceForKMSKeyID reads the resource referenced
 // from KMSKeyRef field and sets the KMSKeyID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -583,6 +762,97 @@ func getReferencedResourceState_Role(
 	return nil
 }
 
+// resolveReferenceForOptionGroupName reads the resource referenced
+// from OptionGroupRef field and sets the OptionGroupName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForOptionGroupName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.OptionGroupRef != nil && ko.Spec.OptionGroupRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.OptionGroupRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: OptionGroupRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.OptionGroup{}
+		if err := getReferencedResourceState_OptionGroup(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.OptionGroupName = (*string)(obj.Spec.Name)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_OptionGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_OptionGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.OptionGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"OptionGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"OptionGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"OptionGroup",
+			namespace, name)
+	}
+	if obj.Spec.Name == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"OptionGroup",
+			namespace, name,
+			"Spec.Name")
+	}
+	return nil
+}
+
 // resolveReferenceForPerformanceInsightsKMSKeyID reads the resource referenced
 // from PerformanceInsightsKMSKeyRef field and sets the PerformanceInsightsKMSKeyID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -620,6 +890,264 @@ func (rm *resourceManager) resolveReferenceForPerformanceInsightsKMSKeyID(
 	return hasReferences, nil
 }
 
+// resolveReferenceForReplicationSourceIdentifier reads the resource referenced
+// from ReplicationSourceIdentifierRef field and sets the ReplicationSourceIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForReplicationSourceIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.ReplicationSourceIdentifierRef != nil && ko.Spec.ReplicationSourceIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.ReplicationSourceIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: ReplicationSourceIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBCluster{}
+		if err := getReferencedResourceState_DBCluster(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.ReplicationSourceIdentifier = (*string)(obj.Status.ACKResourceMetadata.ARN)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBCluster looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBCluster(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBCluster,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBCluster",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBCluster",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBCluster",
+			namespace, name)
+	}
+	if obj.Status.ACKResourceMetadata == nil || obj.Status.ACKResourceMetadata.ARN == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBCluster",
+			namespace, name,
+			"Status.ACKResourceMetadata.ARN")
+	}
+	return nil
+}
+
+// resolveReferenceForRoleAssociations_RoleARN reads the resource referenced
+// from RoleAssociations.RoleRef field and sets the RoleAssociations.RoleARN
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoleAssociations_RoleARN(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil && f0iter.RoleRef.From != nil {
+			hasReferences = true
+			arr := f0iter.RoleRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: RoleAssociations.RoleRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &iamapitypes.Role{}
+			if err := getReferencedResourceState_Role(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.RoleAssociations[f0idx].RoleARN = (*string)(obj.Status.ACKResourceMetadata.ARN)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// resolveReferenceForSnapshotIdentifier reads the resource referenced
+// from SnapshotIdentifierRef field and sets the SnapshotIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForSnapshotIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.SnapshotIdentifierRef != nil && ko.Spec.SnapshotIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.SnapshotIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: SnapshotIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBClusterSnapshot{}
+		if err := getReferencedResourceState_DBClusterSnapshot(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.SnapshotIdentifier = (*string)(obj.Spec.DBClusterSnapshotIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBClusterSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBClusterSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBClusterSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBClusterSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBClusterSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBClusterSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.DBClusterSnapshotIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBClusterSnapshot",
+			namespace, name,
+			"Spec.DBClusterSnapshotIdentifier")
+	}
+	return nil
+}
+
+// resolveReferenceForSourceDBClusterIdentifier reads the resource referenced
+// from SourceDBClusterIdentifierRef field and sets the SourceDBClusterIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForSourceDBClusterIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBCluster,
+) (hasReferences bool, err error) {
+	if ko.Spec.SourceDBClusterIdentifierRef != nil && ko.Spec.SourceDBClusterIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.SourceDBClusterIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: SourceDBClusterIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBCluster{}
+		if err := getReferencedResourceState_DBCluster(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.SourceDBClusterIdentifier = (*string)(obj.Spec.DBClusterIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
 // resolveReferenceForVPCSecurityGroupIDs reads the resource referenced
 // from VPCSecurityGroupRefs field and sets the VPCSecurityGroupIDs
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// fakeReader is a client.Reader that returns the objects it holds by name.
+type fakeReader struct {
+	client.Reader
+	objects map[string]client.Object
+}
+
+func (r *fakeReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	src, ok := r.objects[key.Name]
+	if !ok {
+		return ackerr.NotFound
+	}
+	switch o := obj.(type) {
+	case *svcapitypes.DBCluster:
+		*o = *src.(*svcapitypes.DBCluster)
+	case *svcapitypes.DBClusterSnapshot:
+		*o = *src.(*svcapitypes.DBClusterSnapshot)
+	case *svcapitypes.GlobalCluster:
+		*o = *src.(*svcapitypes.GlobalCluster)
+	}
+	return nil
+}
+
+func syncedConditions() []*ackv1alpha1.Condition {
+	return []*ackv1alpha1.Condition{{
+		Type:   ackv1alpha1.ConditionTypeResourceSynced,
+		Status: corev1.ConditionTrue,
+	}}
+}
+
+func refTo(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+func TestResolveReferences_GlobalClusterAndSources(t *testing.T) {
+	rm := &resourceManager{}
+	ctx := context.Background()
+	sourceARN := ackv1alpha1.AWSResourceName("arn:aws:rds:us-east-1:111111111111:cluster:primary")
+	globalARN := ackv1alpha1.AWSResourceName("arn:aws:rds::111111111111:global-cluster:global-a")
+	reader := &fakeReader{objects: map[string]client.Object{
+		// A global cluster managed from another region, whose ARN is in the
+		// status of the adopted resource.
+		"global": &svcapitypes.GlobalCluster{
+			Spec: svcapitypes.GlobalClusterSpec{GlobalClusterIdentifier: aws.String("global-a")},
+			Status: svcapitypes.GlobalClusterStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &globalARN},
+				Conditions:          syncedConditions(),
+			},
+		},
+		// A DB cluster of another region, whose ARN is in the status of the
+		// adopted resource.
+		"primary": &svcapitypes.DBCluster{
+			Spec: svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("primary")},
+			Status: svcapitypes.DBClusterStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &sourceARN},
+				Conditions:          syncedConditions(),
+			},
+		},
+		"snapshot": &svcapitypes.DBClusterSnapshot{
+			Spec:   svcapitypes.DBClusterSnapshotSpec{DBClusterSnapshotIdentifier: aws.String("snap-a")},
+			Status: svcapitypes.DBClusterSnapshotStatus{Conditions: syncedConditions()},
+		},
+		"pending-global": &svcapitypes.GlobalCluster{
+			Spec: svcapitypes.GlobalClusterSpec{GlobalClusterIdentifier: aws.String("global-b")},
+		},
+		"global-without-arn": &svcapitypes.GlobalCluster{
+			Spec:   svcapitypes.GlobalClusterSpec{GlobalClusterIdentifier: aws.String("global-c")},
+			Status: svcapitypes.GlobalClusterStatus{Conditions: syncedConditions()},
+		},
+		"pending-primary": &svcapitypes.DBCluster{
+			Spec:   svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("primary-b")},
+			Status: svcapitypes.DBClusterStatus{Conditions: syncedConditions()},
+		},
+	}}
+	newCluster := func() *resource {
+		return &resource{ko: &svcapitypes.DBCluster{
+			Spec: svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("secondary")},
+		}}
+	}
+
+	r := newCluster()
+	r.ko.Spec.GlobalClusterIdentifierRef = refTo("global")
+	r.ko.Spec.ReplicationSourceIdentifierRef = refTo("primary")
+	r.ko.Spec.SnapshotIdentifierRef = refTo("snapshot")
+	r.ko.Spec.SourceDBClusterIdentifierRef = refTo("primary")
+	resolved, hasRefs, err := rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.True(t, hasRefs)
+	spec := rm.concreteResource(resolved).ko.Spec
+	assert.Equal(t, string(globalARN), aws.ToString(spec.GlobalClusterIdentifier))
+	assert.Equal(t, string(sourceARN), aws.ToString(spec.ReplicationSourceIdentifier))
+	assert.Equal(t, "snap-a", aws.ToString(spec.SnapshotIdentifier))
+	assert.Equal(t, "primary", aws.ToString(spec.SourceDBClusterIdentifier))
+
+	spec = rm.concreteResource(rm.ClearResolvedReferences(resolved)).ko.Spec
+	assert.Nil(t, spec.GlobalClusterIdentifier)
+	assert.Nil(t, spec.ReplicationSourceIdentifier)
+	assert.Nil(t, spec.SnapshotIdentifier)
+	assert.Nil(t, spec.SourceDBClusterIdentifier)
+
+	// A global cluster that isn't synced yet isn't resolved, so the
+	// secondary waits for it.
+	r = newCluster()
+	r.ko.Spec.GlobalClusterIdentifierRef = refTo("pending-global")
+	_, hasRefs, err = rm.ResolveReferences(ctx, reader, r)
+	assert.True(t, hasRefs)
+	assert.Error(t, err)
+	assert.Nil(t, r.ko.Spec.GlobalClusterIdentifier)
+
+	// Neither is a global cluster or a replication source without an ARN.
+	r = newCluster()
+	r.ko.Spec.GlobalClusterIdentifierRef = refTo("global-without-arn")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+
+	r = newCluster()
+	r.ko.Spec.ReplicationSourceIdentifierRef = refTo("pending-primary")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+	assert.Nil(t, r.ko.Spec.ReplicationSourceIdentifier)
+
+	// References and identifiers can't both be set.
+	r = newCluster()
+	r.ko.Spec.SnapshotIdentifier = aws.String("snap-a")
+	r.ko.Spec.SnapshotIdentifierRef = refTo("snapshot")
+	assert.Error(t, validateReferenceFields(r.ko))
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"sort"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// roleAssociationsDeltaPath is the delta path that customPreCompare uses to
+// ask customUpdate to add or remove IAM roles of the DB cluster.
+const roleAssociationsDeltaPath = "Spec.RoleAssociations"
+
+// roleAssociation is an IAM role associated with a DB cluster for a feature.
+// The feature name is empty for roles that aren't associated for a specific
+// feature.
+type roleAssociation struct {
+	roleARN     string
+	featureName string
+}
+
+// desiredRoleAssociations returns the role associations in the spec of the
+// supplied DB cluster. Entries without a role ARN are skipped.
+func desiredRoleAssociations(r *resource) map[roleAssociation]struct{} {
+	set := map[roleAssociation]struct{}{}
+	for _, role := range r.ko.Spec.RoleAssociations {
+		if role == nil || aws.ToString(role.RoleARN) == "" {
+			continue
+		}
+		set[roleAssociation{
+			roleARN:     *role.RoleARN,
+			featureName: aws.ToString(role.FeatureName),
+		}] = struct{}{}
+	}
+	return set
+}
+
+// latestRoleAssociations returns the roles reported in the status of the
+// supplied DB cluster, whatever the status of their association.
+func latestRoleAssociations(r *resource) map[roleAssociation]struct{} {
+	set := map[roleAssociation]struct{}{}
+	for _, role := range r.ko.Status.AssociatedRoles {
+		if role == nil || aws.ToString(role.RoleARN) == "" {
+			continue
+		}
+		set[roleAssociation{
+			roleARN:     *role.RoleARN,
+			featureName: aws.ToString(role.FeatureName),
+		}] = struct{}{}
+	}
+	return set
+}
+
+// computeRoleAssociationsDelta returns, in a stable order, the role
+// associations to add to and to remove from the latest DB cluster to match
+// the desired one.
+func computeRoleAssociationsDelta(
+	desired *resource,
+	latest *resource,
+) (toAdd []roleAssociation, toRemove []roleAssociation) {
+	desiredSet := desiredRoleAssociations(desired)
+	latestSet := latestRoleAssociations(latest)
+	for role := range desiredSet {
+		if _, ok := latestSet[role]; !ok {
+			toAdd = append(toAdd, role)
+		}
+	}
+	for role := range latestSet {
+		if _, ok := desiredSet[role]; !ok {
+			toRemove = append(toRemove, role)
+		}
+	}
+	sortRoleAssociations(toAdd)
+	sortRoleAssociations(toRemove)
+	return toAdd, toRemove
+}
+
+func sortRoleAssociations(roles []roleAssociation) {
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].roleARN != roles[j].roleARN {
+			return roles[i].roleARN < roles[j].roleARN
+		}
+		return roles[i].featureName < roles[j].featureName
+	})
+}
+
+// compareRoleAssociations adds a difference to the delta when the roles
+// associated with the latest DB cluster don't match the desired role
+// associations. Role associations aren't managed when the desired list is
+// not set.
+func compareRoleAssociations(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if desired.ko.Spec.RoleAssociations == nil {
+		return
+	}
+	toAdd, toRemove := computeRoleAssociationsDelta(desired, latest)
+	if len(toAdd) > 0 || len(toRemove) > 0 {
+		delta.Add(
+			roleAssociationsDeltaPath,
+			desired.ko.Spec.RoleAssociations,
+			latest.ko.Status.AssociatedRoles,
+		)
+	}
+}
+
+// syncRoleAssociations removes the roles that are associated with the latest
+// DB cluster but not desired, and then adds the desired roles that aren't
+// associated yet.
+func (rm *resourceManager) syncRoleAssociations(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncRoleAssociations")
+	defer func() { exit(err) }()
+
+	toAdd, toRemove := computeRoleAssociationsDelta(desired, latest)
+	for _, role := range toRemove {
+		rlog.Debug("removing role from cluster", "role", role.roleARN, "feature", role.featureName)
+		input := &svcsdk.RemoveRoleFromDBClusterInput{
+			DBClusterIdentifier: latest.ko.Spec.DBClusterIdentifier,
+			RoleArn:             aws.String(role.roleARN),
+		}
+		if role.featureName != "" {
+			input.FeatureName = aws.String(role.featureName)
+		}
+		_, err = rm.sdkapi.RemoveRoleFromDBCluster(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveRoleFromDBCluster", err)
+		if err != nil {
+			return err
+		}
+	}
+	for _, role := range toAdd {
+		rlog.Debug("adding role to cluster", "role", role.roleARN, "feature", role.featureName)
+		input := &svcsdk.AddRoleToDBClusterInput{
+			DBClusterIdentifier: latest.ko.Spec.DBClusterIdentifier,
+			RoleArn:             aws.String(role.roleARN),
+		}
+		if role.featureName != "" {
+			input.FeatureName = aws.String(role.featureName)
+		}
+		_, err = rm.sdkapi.AddRoleToDBCluster(ctx, input)
+		rm.metrics.RecordAPICall("UPDATE", "AddRoleToDBCluster", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+const (
+	testS3ImportRoleARN = "arn:aws:iam::123456789012:role/s3-import"
+	testLambdaRoleARN   = "arn:aws:iam::123456789012:role/lambda"
+)
+
+func roleResource(
+	desired []*svcapitypes.AddRoleToDBClusterInput,
+	associated []*svcapitypes.DBClusterRole,
+) *resource {
+	return &resource{ko: &svcapitypes.DBCluster{
+		Spec: svcapitypes.DBClusterSpec{
+			DBClusterIdentifier: aws.String("cluster"),
+			Engine:              aws.String("aurora-postgresql"),
+			RoleAssociations:    desired,
+		},
+		Status: svcapitypes.DBClusterStatus{
+			Status:          aws.String(fake.StatusAvailable),
+			AssociatedRoles: associated,
+		},
+	}}
+}
+
+func TestCompareRoleAssociations(t *testing.T) {
+	tests := []struct {
+		name       string
+		desired    []*svcapitypes.AddRoleToDBClusterInput
+		associated []*svcapitypes.DBClusterRole
+		wantDiff   bool
+	}{
+		{
+			name: "not managed",
+			associated: []*svcapitypes.DBClusterRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), Status: aws.String("ACTIVE")},
+			},
+		},
+		{
+			name:    "empty list removes all roles",
+			desired: []*svcapitypes.AddRoleToDBClusterInput{},
+			associated: []*svcapitypes.DBClusterRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), Status: aws.String("ACTIVE")},
+			},
+			wantDiff: true,
+		},
+		{
+			name: "same roles in another order",
+			desired: []*svcapitypes.AddRoleToDBClusterInput{
+				{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda")},
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import")},
+			},
+			associated: []*svcapitypes.DBClusterRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import"), Status: aws.String("ACTIVE")},
+				{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("PENDING")},
+			},
+		},
+		{
+			name: "feature name differs",
+			desired: []*svcapitypes.AddRoleToDBClusterInput{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Export")},
+			},
+			associated: []*svcapitypes.DBClusterRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import"), Status: aws.String("ACTIVE")},
+			},
+			wantDiff: true,
+		},
+		{
+			name: "role without feature name",
+			desired: []*svcapitypes.AddRoleToDBClusterInput{
+				{RoleARN: aws.String(testS3ImportRoleARN)},
+			},
+			associated: []*svcapitypes.DBClusterRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String(""), Status: aws.String("ACTIVE")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			compareRoleAssociations(delta, roleResource(tt.desired, nil), roleResource(nil, tt.associated))
+			assert.Equal(t, tt.wantDiff, delta.DifferentAt(roleAssociationsDeltaPath))
+		})
+	}
+}
+
+func TestCustomUpdate_RoleAssociations(t *testing.T) {
+	api := fake.New()
+	api.DBClusters["cluster"] = &svcsdktypes.DBCluster{
+		DBClusterIdentifier: aws.String("cluster"),
+		Status:              aws.String(fake.StatusAvailable),
+		AssociatedRoles: []svcsdktypes.DBClusterRole{
+			{RoleArn: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("ACTIVE")},
+		},
+	}
+	rm := &resourceManager{
+		metrics: ackmetrics.NewMetrics("rds"),
+		sdkapi:  api,
+	}
+	desired := roleResource([]*svcapitypes.AddRoleToDBClusterInput{
+		{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import")},
+	}, nil)
+	latest := roleResource(nil, []*svcapitypes.DBClusterRole{
+		{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("ACTIVE")},
+	})
+	delta := ackcompare.NewDelta()
+	compareRoleAssociations(delta, desired, latest)
+
+	_, err := rm.customUpdate(context.Background(), desired, latest, delta)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"RemoveRoleFromDBCluster", "AddRoleToDBCluster"}, api.Operations())
+
+	remove := api.CallsTo("RemoveRoleFromDBCluster")[0].(*svcsdk.RemoveRoleFromDBClusterInput)
+	assert.Equal(t, testLambdaRoleARN, *remove.RoleArn)
+	assert.Equal(t, "Lambda", *remove.FeatureName)
+	add := api.CallsTo("AddRoleToDBCluster")[0].(*svcsdk.AddRoleToDBClusterInput)
+	assert.Equal(t, "cluster", *add.DBClusterIdentifier)
+	assert.Equal(t, testS3ImportRoleARN, *add.RoleArn)
+	assert.Equal(t, "s3Import", *add.FeatureName)
+
+	roles := api.DBClusters["cluster"].AssociatedRoles
+	require.Len(t, roles, 1)
+	assert.Equal(t, testS3ImportRoleARN, *roles[0].RoleArn)
+}
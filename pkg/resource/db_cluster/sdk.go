@@ -574,9 +574,9 @@ func (rm *resourceManager) sdkFind(
 				}
 				f70 = append(f70, f70elem)
 			}
-			ko.Status.TagList = f70
+			ko.Spec.Tags = f70
 		} else {
-			ko.Status.TagList = nil
+			ko.Spec.Tags = nil
 		}
 		if elem.VpcSecurityGroups != nil {
 			f71 := []*svcapitypes.VPCSecurityGroupMembership{}
@@ -602,21 +602,18 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
-	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
-		if err != nil {
-			return nil, err
-		}
-		ko.Spec.Tags = tags
-	}
-	if !clusterAvailable(&resource{ko}) {
+	if !clusterAvailable(&resource{ko}) && !stoppedAsDesired(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
+	setPendingRebootCondition(&resource{ko})
+	if err := rm.syncMasterUserSecret(ctx, ko); err != nil {
+		return nil, err
+	}
+	rm.syncConnectionDetails(ctx, ko)
 	clearAuroraAllocatedStorage(ko)
 	if len(r.ko.Spec.VPCSecurityGroupIDs) > 0 {
 		// If the desired resource has security groups specified then update the spec of the latest resource with the
@@ -682,6 +679,7 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	initActionGenerations(desired)
 	// if request has SnapshotIdentifier spec, create request will call RestoreDBClusterFromSnapshotWithContext
 	// instead of normal create api
 	if desired.ko.Spec.SnapshotIdentifier != nil {
@@ -41,7 +41,6 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
-	compareTags(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.DBClusterEndpointIdentifier, b.ko.Spec.DBClusterEndpointIdentifier) {
 		delta.Add("Spec.DBClusterEndpointIdentifier", a.ko.Spec.DBClusterEndpointIdentifier, b.ko.Spec.DBClusterEndpointIdentifier)
@@ -74,6 +73,9 @@ func newResourceDelta(
 			delta.Add("Spec.ExcludedMembers", a.ko.Spec.ExcludedMembers, b.ko.Spec.ExcludedMembers)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.ExcludedMemberRefs, b.ko.Spec.ExcludedMemberRefs) {
+		delta.Add("Spec.ExcludedMemberRefs", a.ko.Spec.ExcludedMemberRefs, b.ko.Spec.ExcludedMemberRefs)
+	}
 	if len(a.ko.Spec.StaticMembers) != len(b.ko.Spec.StaticMembers) {
 		delta.Add("Spec.StaticMembers", a.ko.Spec.StaticMembers, b.ko.Spec.StaticMembers)
 	} else if len(a.ko.Spec.StaticMembers) > 0 {
@@ -81,6 +83,9 @@ func newResourceDelta(
 			delta.Add("Spec.StaticMembers", a.ko.Spec.StaticMembers, b.ko.Spec.StaticMembers)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.StaticMemberRefs, b.ko.Spec.StaticMemberRefs) {
+		delta.Add("Spec.StaticMemberRefs", a.ko.Spec.StaticMemberRefs, b.ko.Spec.StaticMemberRefs)
+	}
 
 	return delta
 }
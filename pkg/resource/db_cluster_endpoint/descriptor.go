@@ -25,6 +25,7 @@ import (
 	k8sctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
 )
 
 const (
@@ -42,6 +43,8 @@ var (
 // resourceDescriptor implements the
 // `aws-service-operator-k8s/pkg/types.AWSResourceDescriptor` interface
 type resourceDescriptor struct {
+	// deps are the Dependencies of the factory that produced the descriptor
+	deps svcresource.Dependencies
 }
 
 // GroupVersionKind returns a Kubernetes schema.GroupVersionKind struct that
@@ -69,7 +72,9 @@ func (d *resourceDescriptor) ResourceFromRuntimeObject(
 // Delta returns an `ackcompare.Delta` object containing the difference between
 // one `AWSResource` and another.
 func (d *resourceDescriptor) Delta(a, b acktypes.AWSResource) *ackcompare.Delta {
-	return newResourceDelta(a.(*resource), b.(*resource))
+	delta := newResourceDelta(a.(*resource), b.(*resource))
+	compareTags(d.deps.TagReconciler, delta, a.(*resource), b.(*resource))
+	return delta
 }
 
 // IsManaged returns true if the supplied AWSResource is under the management
@@ -23,8 +23,6 @@ import (
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
-	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
-	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
@@ -71,45 +69,10 @@ func (rm *resourceManager) syncTags(
 	defer func() { exit(err) }()
 
 	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
-
-	toAdd, toDelete := util.ComputeTagsDelta(
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
 		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
 	)
-
-	if len(toDelete) > 0 {
-		rlog.Debug("removing tags from subnet group", "tags", toDelete)
-		_, err = rm.sdkapi.RemoveTagsFromResource(
-			ctx,
-			&svcsdk.RemoveTagsFromResourceInput{
-				ResourceName: arn,
-				TagKeys:      toDelete,
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
-		if err != nil {
-			return err
-		}
-	}
-
-	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
-	// with a new value overwrites any existing tag with the same key. So, we
-	// don't need to do anything to "update" a Tag. Simply including it in the
-	// AddTagsToResource call is enough.
-	if len(toAdd) > 0 {
-		rlog.Debug("adding tags to subnet group", "tags", toAdd)
-		_, err = rm.sdkapi.AddTagsToResource(
-			ctx,
-			&svcsdk.AddTagsToResourceInput{
-				ResourceName: arn,
-				Tags:         sdkTagsFromResourceTags(toAdd),
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
 }
 
 // getTags retrieves the resource's associated tags
@@ -117,53 +80,18 @@ func (rm *resourceManager) getTags(
 	ctx context.Context,
 	resourceARN string,
 ) ([]*svcapitypes.Tag, error) {
-	resp, err := rm.sdkapi.ListTagsForResource(
-		ctx,
-		&svcsdk.ListTagsForResourceInput{
-			ResourceName: &resourceARN,
-		},
-	)
-	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
-	if err != nil {
-		return nil, err
-	}
-	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
-	for _, tag := range resp.TagList {
-		tags = append(tags, &svcapitypes.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
-	}
-	return tags, nil
+	return rm.tagReconciler.Get(ctx, rm.sdkapi, rm.metrics, resourceARN)
 }
 
 // compareTags adds a difference to the delta if the supplied resources have
-// different tag collections
+// different tag collections, as reconciled by the supplied TagReconciler
 func compareTags(
+	tags *util.TagReconciler,
 	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
-	if len(a.ko.Spec.Tags) != len(b.ko.Spec.Tags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-	} else if len(a.ko.Spec.Tags) > 0 {
-		if !util.EqualTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
-			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-		}
-	}
-}
-
-// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
-// array.
-func sdkTagsFromResourceTags(
-	rTags []*svcapitypes.Tag,
-) []svcsdktypes.Tag {
-	tags := make([]svcsdktypes.Tag, len(rTags))
-	for i := range rTags {
-		tags[i] = svcsdktypes.Tag{
-			Key:   rTags[i].Key,
-			Value: rTags[i].Value,
-		}
-	}
-	return tags
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
 }
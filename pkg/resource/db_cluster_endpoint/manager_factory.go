@@ -26,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
 	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
 )
 
@@ -35,12 +36,27 @@ type resourceManagerFactory struct {
 	sync.RWMutex
 	// rmCache contains resource managers for a particular AWS account ID
 	rmCache map[string]*resourceManager
+	// newSDKAPI constructs the RDS API handed to each resource manager
+	newSDKAPI rdsapi.ClientConstructor
+	// deps are handed to each resource manager
+	deps svcresource.Dependencies
 }
 
 // ResourcePrototype returns an AWSResource that resource managers produced by
 // this factory will handle
 func (f *resourceManagerFactory) ResourceDescriptor() acktypes.AWSResourceDescriptor {
-	return &resourceDescriptor{}
+	f.RLock()
+	defer f.RUnlock()
+	return &resourceDescriptor{deps: f.deps}
+}
+
+// SetDependencies replaces the Dependencies handed to the resource managers
+// and the descriptors this factory produces
+func (f *resourceManagerFactory) SetDependencies(deps svcresource.Dependencies) {
+	f.Lock()
+	defer f.Unlock()
+	f.deps = deps
+	f.rmCache = map[string]*resourceManager{}
 }
 
 // ManagerFor returns a resource manager object that can manage resources for a
@@ -70,7 +86,10 @@ func (f *resourceManagerFactory) ManagerFor(
 	f.Lock()
 	defer f.Unlock()
 
-	rm, err := newResourceManager(cfg, clientcfg, log, metrics, rr, id, region)
+	rm, err := newResourceManager(
+		cfg, clientcfg, log, metrics, rr, id, region, f.newSDKAPI(clientcfg, id, metrics),
+		f.deps,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -86,12 +105,14 @@ func (f *resourceManagerFactory) IsAdoptable() bool {
 // RequeueOnSuccessSeconds returns true if the resource should be requeued after specified seconds
 // Default is false which means resource will not be requeued after success.
 func (f *resourceManagerFactory) RequeueOnSuccessSeconds() int {
-	return 0
+	return 60
 }
 
 func newResourceManagerFactory() *resourceManagerFactory {
 	return &resourceManagerFactory{
-		rmCache: map[string]*resourceManager{},
+		rmCache:   map[string]*resourceManager{},
+		newSDKAPI: rdsapi.NewFromConfig,
+		deps:      svcresource.DefaultDependencies(),
 	}
 }
 
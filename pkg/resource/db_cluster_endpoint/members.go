@@ -0,0 +1,198 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_endpoint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// memberStatusAvailable is the status of the DB instances that can be
+// selected as members of a custom endpoint.
+const memberStatusAvailable = "available"
+
+// validateMemberSelectors validates the StaticMemberSelector and
+// ExcludedMemberSelector fields, which can't be set with the members they
+// select. It is called from the references_pre_resolve hook, before the
+// members are resolved.
+func validateMemberSelectors(ko *svcapitypes.DBClusterEndpoint) error {
+	if len(ko.Spec.ExcludedMemberSelector) > 0 && len(ko.Spec.ExcludedMembers) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("ExcludedMembers", "ExcludedMemberSelector")
+	}
+	if len(ko.Spec.StaticMemberSelector) > 0 && len(ko.Spec.StaticMembers) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("StaticMembers", "StaticMemberSelector")
+	}
+	return nil
+}
+
+// resolveMemberSelectors adds the DB instances of the DBInstance resources
+// selected by StaticMemberSelector and ExcludedMemberSelector to
+// StaticMembers and ExcludedMembers. Only the DB instances of the endpoint's
+// DB cluster are selected. Static members must also be available, so that
+// the endpoint never points at a DB instance that is still being created,
+// while excluded members are selected whatever their status: RDS adds the
+// DB instances that aren't excluded to the endpoint once they are available.
+// Returns a boolean indicating whether the endpoint has member selectors, or
+// an error
+//
+// The references config of the code generator can't express label
+// selectors, so this is called from the references_post_resolve hook
+// instead of being generated.
+func (rm *resourceManager) resolveMemberSelectors(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBClusterEndpoint,
+) (hasSelectors bool, err error) {
+	if len(ko.Spec.StaticMemberSelector) == 0 && len(ko.Spec.ExcludedMemberSelector) == 0 {
+		return false, nil
+	}
+	clusterID := aws.ToString(ko.Spec.DBClusterIdentifier)
+
+	if len(ko.Spec.StaticMemberSelector) > 0 {
+		members, err := listSelectedMembers(
+			ctx, apiReader, ko.GetNamespace(), clusterID, ko.Spec.StaticMemberSelector, true,
+		)
+		if err != nil {
+			return true, err
+		}
+		ko.Spec.StaticMembers = mergeMembers(ko.Spec.StaticMembers, members)
+		// An endpoint without static members contains every DB instance of
+		// the DB cluster, so it waits for a DB instance to be selected.
+		if len(ko.Spec.StaticMembers) == 0 {
+			return true, ackrequeue.NeededAfter(
+				fmt.Errorf(
+					"no available DB instance of DB cluster %s matches StaticMemberSelector",
+					clusterID,
+				),
+				ackrequeue.DefaultRequeueAfterDuration,
+			)
+		}
+	}
+	if len(ko.Spec.ExcludedMemberSelector) > 0 {
+		members, err := listSelectedMembers(
+			ctx, apiReader, ko.GetNamespace(), clusterID, ko.Spec.ExcludedMemberSelector, false,
+		)
+		if err != nil {
+			return true, err
+		}
+		ko.Spec.ExcludedMembers = mergeMembers(ko.Spec.ExcludedMembers, members)
+	}
+	return true, nil
+}
+
+// listSelectedMembers returns the identifiers of the DB instances of the
+// supplied DB cluster that are managed by the DBInstance resources of the
+// namespace with the supplied labels, only the available ones if
+// availableOnly is true.
+func listSelectedMembers(
+	ctx context.Context,
+	apiReader client.Reader,
+	namespace string,
+	clusterID string,
+	selector map[string]*string,
+	availableOnly bool,
+) ([]*string, error) {
+	instances := &svcapitypes.DBInstanceList{}
+	err := apiReader.List(
+		ctx, instances,
+		client.InNamespace(namespace),
+		client.MatchingLabels(aws.ToStringMap(selector)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	members := []*string{}
+	for i := range instances.Items {
+		instance := &instances.Items[i]
+		if instance.Spec.DBInstanceIdentifier == nil {
+			continue
+		}
+		if availableOnly && (instance.DeletionTimestamp != nil ||
+			aws.ToString(instance.Status.DBInstanceStatus) != memberStatusAvailable) {
+			continue
+		}
+		instanceClusterID, err := getMemberClusterIdentifier(ctx, apiReader, instance)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(aws.ToString(instanceClusterID), clusterID) {
+			members = append(members, instance.Spec.DBInstanceIdentifier)
+		}
+	}
+	return members, nil
+}
+
+// getMemberClusterIdentifier returns the identifier of the DB cluster of the
+// supplied DBInstance. The identifier resolved from a DBClusterIdentifierRef
+// isn't saved in the spec of the DBInstance, so it is read from the
+// referenced DBCluster.
+func getMemberClusterIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	instance *svcapitypes.DBInstance,
+) (*string, error) {
+	if instance.Spec.DBClusterIdentifier != nil {
+		return instance.Spec.DBClusterIdentifier, nil
+	}
+	ref := instance.Spec.DBClusterIdentifierRef
+	if ref == nil || ref.From == nil || aws.ToString(ref.From.Name) == "" {
+		return nil, nil
+	}
+	namespace := instance.GetNamespace()
+	if aws.ToString(ref.From.Namespace) != "" {
+		namespace = *ref.From.Namespace
+	}
+	cluster := &svcapitypes.DBCluster{}
+	err := apiReader.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      *ref.From.Name,
+	}, cluster)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cluster.Spec.DBClusterIdentifier, nil
+}
+
+// mergeMembers returns the supplied members and the selected ones, without
+// duplicates and sorted, so that the members of the endpoint are stable.
+func mergeMembers(members []*string, selected []*string) []*string {
+	seen := map[string]bool{}
+	res := []*string{}
+	for _, member := range append(append([]*string{}, members...), selected...) {
+		if member == nil || seen[*member] {
+			continue
+		}
+		seen[*member] = true
+		res = append(res, member)
+	}
+	sort.Slice(res, func(i, j int) bool { return *res[i] < *res[j] })
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
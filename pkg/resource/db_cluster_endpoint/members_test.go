@@ -0,0 +1,203 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_endpoint
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// fakeReader is a client.Reader that returns the DB clusters and DB instances
+// it holds.
+type fakeReader struct {
+	client.Reader
+	clusters  map[string]*svcapitypes.DBCluster
+	instances []*svcapitypes.DBInstance
+}
+
+func (r *fakeReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	switch o := obj.(type) {
+	case *svcapitypes.DBCluster:
+		if c, ok := r.clusters[key.Name]; ok {
+			*o = *c
+			return nil
+		}
+	case *svcapitypes.DBInstance:
+		for _, i := range r.instances {
+			if i.Name == key.Name {
+				*o = *i
+				return nil
+			}
+		}
+	}
+	return apierrors.NewNotFound(schema.GroupResource{}, key.Name)
+}
+
+func (r *fakeReader) List(
+	ctx context.Context,
+	list client.ObjectList,
+	opts ...client.ListOption,
+) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	selector := listOpts.LabelSelector
+	if selector == nil {
+		selector = labels.Everything()
+	}
+	res := list.(*svcapitypes.DBInstanceList)
+	for _, i := range r.instances {
+		if i.Namespace == listOpts.Namespace && selector.Matches(labels.Set(i.Labels)) {
+			res.Items = append(res.Items, *i)
+		}
+	}
+	return nil
+}
+
+func syncedConditions() []*ackv1alpha1.Condition {
+	return []*ackv1alpha1.Condition{{
+		Type:   ackv1alpha1.ConditionTypeResourceSynced,
+		Status: corev1.ConditionTrue,
+	}}
+}
+
+func memberInstance(
+	name string,
+	role string,
+	clusterID string,
+	status string,
+) *svcapitypes.DBInstance {
+	return &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"role": role},
+		},
+		Spec: svcapitypes.DBInstanceSpec{
+			DBInstanceIdentifier: aws.String(name),
+			DBClusterIdentifier:  aws.String(clusterID),
+		},
+		Status: svcapitypes.DBInstanceStatus{
+			DBInstanceStatus: aws.String(status),
+			Conditions:       syncedConditions(),
+		},
+	}
+}
+
+func endpointResource() *resource {
+	return &resource{ko: &svcapitypes.DBClusterEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "analytics", Namespace: "default"},
+		Spec: svcapitypes.DBClusterEndpointSpec{
+			DBClusterEndpointIdentifier: aws.String("analytics"),
+			DBClusterIdentifier:         aws.String("cluster-a"),
+			EndpointType:                aws.String("READER"),
+		},
+	}}
+}
+
+func TestResolveReferences_MemberSelectors(t *testing.T) {
+	rm := &resourceManager{}
+	ctx := context.Background()
+	byRef := memberInstance("reader-d", "analytics", "", memberStatusAvailable)
+	byRef.Spec.DBClusterIdentifier = nil
+	byRef.Spec.DBClusterIdentifierRef = &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("cluster")},
+	}
+	reader := &fakeReader{
+		clusters: map[string]*svcapitypes.DBCluster{
+			"cluster": {Spec: svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("cluster-a")}},
+		},
+		instances: []*svcapitypes.DBInstance{
+			memberInstance("reader-c", "analytics", "cluster-a", memberStatusAvailable),
+			memberInstance("reader-a", "analytics", "cluster-a", memberStatusAvailable),
+			memberInstance("reader-b", "analytics", "cluster-a", "creating"),
+			memberInstance("other", "analytics", "cluster-b", memberStatusAvailable),
+			memberInstance("writer", "writer", "cluster-a", memberStatusAvailable),
+			byRef,
+		},
+	}
+
+	r := endpointResource()
+	r.ko.Spec.StaticMemberSelector = map[string]*string{"role": aws.String("analytics")}
+	resolved, hasRefs, err := rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.True(t, hasRefs)
+	// Only the available DB instances of the endpoint's DB cluster are
+	// selected.
+	assert.Equal(t,
+		[]string{"reader-a", "reader-c", "reader-d"},
+		aws.ToStringSlice(rm.concreteResource(resolved).ko.Spec.StaticMembers),
+	)
+	cleared := rm.ClearResolvedReferences(resolved)
+	assert.Nil(t, rm.concreteResource(cleared).ko.Spec.StaticMembers)
+
+	// Referenced and selected members are merged.
+	r = endpointResource()
+	r.ko.Spec.ExcludedMemberSelector = map[string]*string{"role": aws.String("writer")}
+	r.ko.Spec.ExcludedMemberRefs = []*ackv1alpha1.AWSResourceReferenceWrapper{{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("reader-a")},
+	}}
+	resolved, _, err = rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"reader-a", "writer"},
+		aws.ToStringSlice(rm.concreteResource(resolved).ko.Spec.ExcludedMembers),
+	)
+
+	// Excluded members are selected whatever their status, so that RDS
+	// doesn't add them to the endpoint once they are available.
+	r = endpointResource()
+	r.ko.Spec.ExcludedMemberSelector = map[string]*string{"role": aws.String("analytics")}
+	resolved, _, err = rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.Equal(t,
+		[]string{"reader-a", "reader-b", "reader-c", "reader-d"},
+		aws.ToStringSlice(rm.concreteResource(resolved).ko.Spec.ExcludedMembers),
+	)
+
+	// The endpoint waits while no DB instance is selected, as it would
+	// otherwise contain every DB instance of the DB cluster.
+	r = endpointResource()
+	r.ko.Spec.StaticMemberSelector = map[string]*string{"role": aws.String("reporting")}
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	assert.ErrorAs(t, err, &requeueErr)
+
+	// Selectors and identifiers can't both be set.
+	r = endpointResource()
+	r.ko.Spec.StaticMembers = aws.StringSlice([]string{"reader-a"})
+	r.ko.Spec.StaticMemberSelector = map[string]*string{"role": aws.String("analytics")}
+	assert.Error(t, validateMemberSelectors(r.ko))
+	_, hasReferences, err := rm.ResolveReferences(ctx, reader, r)
+	assert.True(t, hasReferences)
+	assert.Error(t, err)
+}
@@ -16,6 +16,7 @@ package db_cluster_parameter_group
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
@@ -83,32 +84,22 @@ func (rm *resourceManager) customUpdate(
 		}
 	}
 	if delta.DifferentAt("Spec.ParameterOverrides") {
-		if err = rm.syncParameters(ctx, desired, latest); err != nil {
-			return nil, err
+		ko := desired.ko.DeepCopy()
+		var statuses []*svcapitypes.Parameter
+		statuses, err = rm.syncParameters(ctx, desired, latest)
+		setParameterOverrideStatuses(ko, statuses)
+		if err != nil {
+			// Return the statuses of the batches that were applied before
+			// the failure so they are patched onto the resource instead of
+			// the group silently being left half-applied.
+			return &resource{ko}, err
 		}
-		return desired, requeueWaitAfterParameterSync
+		return &resource{ko}, requeueWaitAfterParameterSync
 	}
 	return desired, nil
 }
 
 // syncTags keeps the resource's tags in sync
-//
-// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
-// following ways:
-//
-//  1. The names of the tagging API operations are different. Other APIs use the
-//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
-//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
-//     `RemoveTagsFromResource`.
-//
-//  2. Even though the name of the `ListTagsForResource` API call is the same,
-//     the structure of the input and the output are different from other APIs.
-//     For the input, instead of a `ResourceArn` field, RDS names the field
-//     `ResourceName`, but actually expects an ARN, not the parameter group
-//     name.  This is the same for the `AddTagsToResource` and
-//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
-//     called `TagList` instead of `Tags` but is otherwise the same struct with
-//     a `Key` and `Value` member field.
 func (rm *resourceManager) syncTags(
 	ctx context.Context,
 	desired *resource,
@@ -119,45 +110,10 @@ func (rm *resourceManager) syncTags(
 	defer func() { exit(err) }()
 
 	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
-
-	toAdd, toDelete := util.ComputeTagsDelta(
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
 		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
 	)
-
-	if len(toDelete) > 0 {
-		rlog.Debug("removing tags from cluster parameter group", "tags", toDelete)
-		_, err = rm.sdkapi.RemoveTagsFromResource(
-			ctx,
-			&svcsdk.RemoveTagsFromResourceInput{
-				ResourceName: arn,
-				TagKeys:      toDelete,
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
-		if err != nil {
-			return err
-		}
-	}
-
-	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
-	// with a new value overwrites any existing tag with the same key. So, we
-	// don't need to do anything to "update" a Tag. Simply including it in the
-	// AddTagsToResource call is enough.
-	if len(toAdd) > 0 {
-		rlog.Debug("adding tags to cluster parameter group", "tags", toAdd)
-		_, err = rm.sdkapi.AddTagsToResource(
-			ctx,
-			&svcsdk.AddTagsToResourceInput{
-				ResourceName: arn,
-				Tags:         sdkTagsFromResourceTags(toAdd),
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
 }
 
 // getTags retrieves the resource's associated tags
@@ -165,63 +121,38 @@ func (rm *resourceManager) getTags(
 	ctx context.Context,
 	resourceARN string,
 ) ([]*svcapitypes.Tag, error) {
-	resp, err := rm.sdkapi.ListTagsForResource(
-		ctx,
-		&svcsdk.ListTagsForResourceInput{
-			ResourceName: &resourceARN,
-		},
-	)
-	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
-	if err != nil {
-		return nil, err
-	}
-	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
-	for _, tag := range resp.TagList {
-		tags = append(tags, &svcapitypes.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
-	}
-	return tags, nil
+	return rm.tagReconciler.Get(ctx, rm.sdkapi, rm.metrics, resourceARN)
 }
 
 // compareTags adds a difference to the delta if the supplied resources have
-// different tag collections
+// different tag collections, as reconciled by the supplied TagReconciler
 func compareTags(
+	tags *util.TagReconciler,
 	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
-	if len(a.ko.Spec.Tags) != len(b.ko.Spec.Tags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-	} else if len(a.ko.Spec.Tags) > 0 {
-		if !util.EqualTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
-			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-		}
-	}
-}
-
-// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
-// array.
-func sdkTagsFromResourceTags(
-	rTags []*svcapitypes.Tag,
-) []svcsdktypes.Tag {
-	tags := make([]svcsdktypes.Tag, len(rTags))
-	for i := range rTags {
-		tags[i] = svcsdktypes.Tag{
-			Key:   rTags[i].Key,
-			Value: rTags[i].Value,
-		}
-	}
-	return tags
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
 }
 
-// syncParameters keeps the resource's parameters in sync
+// syncParameters keeps the resource's parameters in sync and returns the
+// resource's parameter override statuses updated with the parameters that
+// were reset or modified.
 //
 // RDS does not have a DeleteParameter or DeleteParameterFromParameterGroup API
 // call. Instead, you need to call ResetDBClusterParameterGroup with a list of
 // DB Cluster Parameters that you want RDS to reset to a default value.
 //
+// ResetDBClusterParameterGroup and ModifyDBClusterParameterGroup only accept
+// 20 parameters at a time, so both the deleted and modified parameter sets
+// are "chunked" and sent in parameter name order. Every parameter is
+// validated before the first batch is sent. If a batch fails, the returned
+// statuses still reflect the batches that were applied before it and the
+// returned error says which batch failed. The next reconciliation only sends
+// the parameters that still differ.
+//
 // Note(rushmash91): This function uses fallback parameter validation to work around an AWS API
 // limitation where DescribeEngineDefaultClusterParameters may not return all valid
 // cluster parameters (e.g., MySQL logging parameters like slow_query_log). See
@@ -230,7 +161,7 @@ func (rm *resourceManager) syncParameters(
 	ctx context.Context,
 	desired *resource,
 	latest *resource,
-) (err error) {
+) (statuses []*svcapitypes.Parameter, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.syncParameters")
 	defer func() { exit(err) }()
@@ -243,26 +174,125 @@ func (rm *resourceManager) syncParameters(
 	// In the create code paths, we pass a nil latest...
 	if latest != nil {
 		latestOverrides = latest.ko.Spec.ParameterOverrides
+		for _, st := range latest.ko.Status.ParameterOverrideStatuses {
+			statuses = append(statuses, st.DeepCopy())
+		}
 	}
 
 	toModify, _, toDelete := util.GetParametersDifference(
 		desiredOverrides, latestOverrides,
 	)
 
+	var resetChunks, modifyChunks [][]svcsdktypes.Parameter
 	if len(toDelete) > 0 {
-		err = rm.resetParameters(ctx, family, groupName, toDelete)
-		if err != nil {
-			return err
+		for _, chunk := range util.ChunkParameters(toDelete, maxResetParametersSize) {
+			inputParams, err := rm.newResetParameters(ctx, family, chunk)
+			if err != nil {
+				return statuses, err
+			}
+			resetChunks = append(resetChunks, inputParams)
 		}
 	}
-
 	if len(toModify) > 0 {
-		err = rm.modifyParameters(ctx, family, groupName, toModify)
-		if err != nil {
-			return err
+		for _, chunk := range util.ChunkParameters(toModify, maxResetParametersSize) {
+			inputParams, err := rm.newModifyParameters(ctx, family, chunk)
+			if err != nil {
+				return statuses, err
+			}
+			modifyChunks = append(modifyChunks, inputParams)
 		}
 	}
-	return nil
+
+	for i, chunk := range resetChunks {
+		if err = rm.resetParameters(ctx, groupName, chunk); err != nil {
+			return statuses, newErrParameterBatch(
+				"reset", i+1, len(resetChunks), err,
+			)
+		}
+		statuses = removeParameterStatuses(statuses, chunk)
+	}
+	for i, chunk := range modifyChunks {
+		if err = rm.modifyParameters(ctx, groupName, chunk); err != nil {
+			return statuses, newErrParameterBatch(
+				"modify", i+1, len(modifyChunks), err,
+			)
+		}
+		statuses = setParameterStatuses(statuses, chunk)
+	}
+	return statuses, nil
+}
+
+// newErrParameterBatch returns an error describing which batch of a chunked
+// parameter reset or modification failed.
+func newErrParameterBatch(
+	action string,
+	batch int,
+	batches int,
+	err error,
+) error {
+	return fmt.Errorf(
+		"failed to %s parameter batch %d of %d: %w",
+		action, batch, batches, err,
+	)
+}
+
+// removeParameterStatuses returns the supplied parameter statuses without the
+// parameters that were reset.
+func removeParameterStatuses(
+	statuses []*svcapitypes.Parameter,
+	reset []svcsdktypes.Parameter,
+) []*svcapitypes.Parameter {
+	resetNames := map[string]bool{}
+	for _, p := range reset {
+		resetNames[*p.ParameterName] = true
+	}
+	kept := []*svcapitypes.Parameter{}
+	for _, st := range statuses {
+		if st.ParameterName != nil && resetNames[*st.ParameterName] {
+			continue
+		}
+		kept = append(kept, st)
+	}
+	return kept
+}
+
+// setParameterStatuses returns the supplied parameter statuses with the
+// values and apply methods of the parameters that were modified.
+func setParameterStatuses(
+	statuses []*svcapitypes.Parameter,
+	modified []svcsdktypes.Parameter,
+) []*svcapitypes.Parameter {
+	for _, p := range modified {
+		applyMethod := aws.String(string(p.ApplyMethod))
+		found := false
+		for _, st := range statuses {
+			if st.ParameterName != nil && *st.ParameterName == *p.ParameterName {
+				st.ParameterValue = p.ParameterValue
+				st.ApplyMethod = applyMethod
+				found = true
+				break
+			}
+		}
+		if !found {
+			statuses = append(statuses, &svcapitypes.Parameter{
+				ParameterName:  p.ParameterName,
+				ParameterValue: p.ParameterValue,
+				ApplyMethod:    applyMethod,
+			})
+		}
+	}
+	return statuses
+}
+
+// setParameterOverrideStatuses sets the parameter override statuses of the
+// supplied cluster parameter group, along with the names of the overrides that only
+// take effect after a reboot.
+func setParameterOverrideStatuses(
+	ko *svcapitypes.DBClusterParameterGroup,
+	statuses []*svcapitypes.Parameter,
+) {
+	ko.Status.ParameterOverrideStatuses = statuses
+	ko.Status.StaticParameterOverrides = util.StaticParameters(statuses)
 }
 
 // getParameters retrieves parameters that are either in the desired spec or
@@ -351,43 +381,93 @@ func stringPtrEqual(a, b *string) bool {
 	return *a == *b
 }
 
-// resetParameters calls the RDS ResetDBClusterParameterGroup API call
-func (rm *resourceManager) resetParameters(
+// newResetParameters validates a set of no more than 20 parameters to reset
+// and returns them as input for ResetDBClusterParameterGroup.
+func (rm *resourceManager) newResetParameters(
 	ctx context.Context,
 	family *string,
-	groupName *string,
 	toDelete util.Parameters,
-) (err error) {
-	rlog := ackrtlog.FromContext(ctx)
-	exit := rlog.Trace("rm.resetParameters")
-	defer func() { exit(err) }()
-
-	var pMeta *util.ParamMeta
+) ([]svcsdktypes.Parameter, error) {
 	inputParams := []svcsdktypes.Parameter{}
-	for paramName, _ := range toDelete {
+	for _, paramName := range sortedParameterNames(toDelete) {
 		// default to this if something goes wrong looking up parameter
 		// defaults
 		applyMethod := svcsdktypes.ApplyMethodImmediate
-		pMeta, err = rm.getParameterMeta(ctx, *family, paramName)
+		pMeta, err := rm.getParameterMeta(ctx, *family, paramName)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !pMeta.IsModifiable {
-			return util.NewErrUnmodifiableParameter(paramName)
+			return nil, util.NewErrUnmodifiableParameter(paramName)
 		}
 		if !pMeta.IsDynamic {
 			applyMethod = svcsdktypes.ApplyMethodPendingReboot
 		}
 		p := svcsdktypes.Parameter{
 			ParameterName: aws.String(paramName),
-			ApplyMethod:   svcsdktypes.ApplyMethod(applyMethod),
+			ApplyMethod:   applyMethod,
 		}
 		inputParams = append(inputParams, p)
 	}
+	return inputParams, nil
+}
+
+// newModifyParameters validates a set of no more than 20 parameters to
+// modify and returns them as input for ModifyDBClusterParameterGroup.
+func (rm *resourceManager) newModifyParameters(
+	ctx context.Context,
+	family *string,
+	toModify util.Parameters,
+) ([]svcsdktypes.Parameter, error) {
+	inputParams := []svcsdktypes.Parameter{}
+	for _, paramName := range sortedParameterNames(toModify) {
+		// default to "immediate" if something goes wrong looking up defaults
+		applyMethod := svcsdktypes.ApplyMethodImmediate
+		pMeta, err := rm.getParameterMeta(ctx, *family, paramName)
+		if err != nil {
+			return nil, err
+		}
+		if !pMeta.IsModifiable {
+			return nil, util.NewErrUnmodifiableParameter(paramName)
+		}
+		if !pMeta.IsDynamic {
+			applyMethod = svcsdktypes.ApplyMethodPendingReboot
+		}
+		p := svcsdktypes.Parameter{
+			ParameterName:  aws.String(paramName),
+			ParameterValue: toModify[paramName],
+			ApplyMethod:    applyMethod,
+		}
+		inputParams = append(inputParams, p)
+	}
+	return inputParams, nil
+}
+
+// sortedParameterNames returns the names of the supplied parameters in
+// order.
+func sortedParameterNames(params util.Parameters) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resetParameters calls the RDS ResetDBClusterParameterGroup API call with a
+// set of no more than 20 parameters to reset.
+func (rm *resourceManager) resetParameters(
+	ctx context.Context,
+	groupName *string,
+	inputParams []svcsdktypes.Parameter,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.resetParameters")
+	defer func() { exit(err) }()
 
 	rlog.Debug(
 		"resetting parameters from cluster parameter group",
-		"parameters", toDelete,
+		"parameters", inputParams,
 	)
 	_, err = rm.sdkapi.ResetDBClusterParameterGroup(
 		ctx,
@@ -403,43 +483,20 @@ func (rm *resourceManager) resetParameters(
 	return nil
 }
 
-// modifyParameters calls the RDS ModifyDBClusterParameterGroup API call
+// modifyParameters calls the RDS ModifyDBClusterParameterGroup API call with
+// a set of no more than 20 parameters to modify.
 func (rm *resourceManager) modifyParameters(
 	ctx context.Context,
-	family *string,
 	groupName *string,
-	toModify util.Parameters,
+	inputParams []svcsdktypes.Parameter,
 ) (err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.modifyParameters")
 	defer func() { exit(err) }()
 
-	var pMeta *util.ParamMeta
-	inputParams := []svcsdktypes.Parameter{}
-	for paramName, paramValue := range toModify {
-		// default to "immediate" if something goes wrong looking up defaults
-		applyMethod := svcsdktypes.ApplyMethodImmediate
-		pMeta, err = rm.getParameterMeta(ctx, *family, paramName)
-		if err != nil {
-			return err
-		}
-		if !pMeta.IsModifiable {
-			return util.NewErrUnmodifiableParameter(paramName)
-		}
-		if !pMeta.IsDynamic {
-			applyMethod = svcsdktypes.ApplyMethodPendingReboot
-		}
-		p := svcsdktypes.Parameter{
-			ParameterName:  aws.String(paramName),
-			ParameterValue: paramValue,
-			ApplyMethod:    svcsdktypes.ApplyMethod(applyMethod),
-		}
-		inputParams = append(inputParams, p)
-	}
-
 	rlog.Debug(
 		"modifying parameters from parameter group",
-		"parameters", toModify,
+		"parameters", inputParams,
 	)
 	_, err = rm.sdkapi.ModifyDBClusterParameterGroup(
 		ctx,
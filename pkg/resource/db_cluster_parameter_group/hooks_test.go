@@ -0,0 +1,263 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_parameter_group
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const (
+	testFamily    = "aurora-mysql8.0"
+	testGroupName = "test-cpg"
+	// testParamCount is enough parameters to need three batches
+	testParamCount = 45
+)
+
+// testParamName returns the name of the i-th dynamic test parameter.
+func testParamName(i int) string {
+	return fmt.Sprintf("param_%02d", i)
+}
+
+// newTestBackend returns a fake RDS backend with a testGroupName cluster
+// parameter group in the testFamily family. The family has testParamCount
+// dynamic parameters, one static parameter and one unmodifiable parameter.
+func newTestBackend() *fake.RDS {
+	api := fake.New()
+	defaults := []svcsdktypes.Parameter{
+		fake.EngineDefaultParameter("binlog_format", aws.String("OFF"), "static", true),
+		fake.EngineDefaultParameter("aurora_version", nil, "static", false),
+	}
+	for i := 0; i < testParamCount; i++ {
+		defaults = append(defaults, fake.EngineDefaultParameter(
+			testParamName(i), aws.String("0"), "dynamic", true,
+		))
+	}
+	api.EngineDefaults[testFamily] = defaults
+	pg := &fake.ParameterGroup{
+		Family:     testFamily,
+		Parameters: map[string]svcsdktypes.Parameter{},
+	}
+	for _, p := range defaults {
+		pg.Parameters[*p.ParameterName] = p
+	}
+	api.DBClusterParameterGroups[testGroupName] = pg
+	return api
+}
+
+// newTestResourceManager returns the resourceManager that the factory of the
+// package produces with the supplied fake RDS API.
+func newTestResourceManager(api *fake.RDS) *resourceManager {
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager)
+}
+
+func newTestResource(overrides util.Parameters) *resource {
+	return &resource{
+		ko: &svcapitypes.DBClusterParameterGroup{
+			Spec: svcapitypes.DBClusterParameterGroupSpec{
+				Name:               aws.String(testGroupName),
+				Family:             aws.String(testFamily),
+				ParameterOverrides: overrides,
+			},
+		},
+	}
+}
+
+// allTestParams returns an override for every dynamic test parameter.
+func allTestParams(value string) util.Parameters {
+	params := util.Parameters{}
+	for i := 0; i < testParamCount; i++ {
+		params[testParamName(i)] = aws.String(value)
+	}
+	return params
+}
+
+// statusNames returns the names of the supplied parameter statuses.
+func statusNames(statuses []*svcapitypes.Parameter) []string {
+	names := []string{}
+	for _, st := range statuses {
+		names = append(names, *st.ParameterName)
+	}
+	return names
+}
+
+func TestSyncParameters_ModifyIsChunkedInOrder(t *testing.T) {
+	api := newTestBackend()
+	rm := newTestResourceManager(api)
+
+	statuses, err := rm.syncParameters(
+		context.Background(),
+		newTestResource(allTestParams("1")),
+		newTestResource(util.Parameters{}),
+	)
+	require.NoError(t, err)
+
+	calls := api.CallsTo("ModifyDBClusterParameterGroup")
+	require.Len(t, calls, 3)
+	sent := 0
+	for i, want := range []int{20, 20, 5} {
+		params := calls[i].(*svcsdk.ModifyDBClusterParameterGroupInput).Parameters
+		require.Len(t, params, want)
+		for _, p := range params {
+			assert.Equal(t, testParamName(sent), *p.ParameterName)
+			assert.Equal(t, svcsdktypes.ApplyMethodImmediate, p.ApplyMethod)
+			sent++
+		}
+	}
+	assert.Len(t, statuses, testParamCount)
+	assert.Equal(t, "1", *api.DBClusterParameterGroups[testGroupName].Parameters[testParamName(44)].ParameterValue)
+}
+
+func TestSyncParameters_ResetIsChunked(t *testing.T) {
+	api := newTestBackend()
+	rm := newTestResourceManager(api)
+	latest := newTestResource(allTestParams("1"))
+	for i := 0; i < testParamCount; i++ {
+		latest.ko.Status.ParameterOverrideStatuses = append(
+			latest.ko.Status.ParameterOverrideStatuses,
+			&svcapitypes.Parameter{
+				ParameterName:  aws.String(testParamName(i)),
+				ParameterValue: aws.String("1"),
+			},
+		)
+	}
+
+	statuses, err := rm.syncParameters(
+		context.Background(),
+		newTestResource(util.Parameters{}),
+		latest,
+	)
+	require.NoError(t, err)
+
+	assert.Len(t, api.CallsTo("ResetDBClusterParameterGroup"), 3)
+	assert.Empty(t, api.CallsTo("ModifyDBClusterParameterGroup"))
+	assert.Empty(t, statuses)
+	// The latest resource must not be modified in place.
+	assert.Len(t, latest.ko.Status.ParameterOverrideStatuses, testParamCount)
+}
+
+func TestSyncParameters_PartialFailureReportsAppliedBatches(t *testing.T) {
+	api := newTestBackend()
+	calls := 0
+	api.Hooks["ModifyDBClusterParameterGroup"] = func(any) error {
+		calls++
+		if calls == 2 {
+			return fake.NewAPIError("Throttling", "rate exceeded")
+		}
+		return nil
+	}
+	rm := newTestResourceManager(api)
+
+	statuses, err := rm.syncParameters(
+		context.Background(),
+		newTestResource(allTestParams("1")),
+		newTestResource(util.Parameters{}),
+	)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "batch 2 of 3")
+	_, isAWSErr := ackerr.AWSError(err)
+	assert.True(t, isAWSErr, "the RDS error must remain inspectable")
+
+	// Only the first batch was applied and only it is reported.
+	assert.Len(t, api.CallsTo("ModifyDBClusterParameterGroup"), 2)
+	require.Len(t, statuses, 20)
+	assert.Equal(t, testParamName(0), *statuses[0].ParameterName)
+	assert.Equal(t, testParamName(19), *statuses[19].ParameterName)
+	pg := api.DBClusterParameterGroups[testGroupName]
+	assert.Equal(t, "1", *pg.Parameters[testParamName(19)].ParameterValue)
+	assert.Equal(t, "0", *pg.Parameters[testParamName(20)].ParameterValue)
+}
+
+func TestSyncParameters_InvalidParameterSendsNoBatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		extra     string
+		wantError error
+	}{
+		{
+			name:      "unmodifiable parameter",
+			extra:     "aurora_version",
+			wantError: util.ErrUnmodifiableParameter,
+		},
+		{
+			name:      "unknown parameter",
+			extra:     "zz_no_such_param",
+			wantError: util.ErrUnknownParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newTestBackend()
+			rm := newTestResourceManager(api)
+			desired := allTestParams("1")
+			desired[tt.extra] = aws.String("1")
+
+			_, err := rm.syncParameters(
+				context.Background(),
+				newTestResource(desired),
+				newTestResource(util.Parameters{}),
+			)
+			var termErr *ackerr.TerminalError
+			assert.True(t, errors.As(err, &termErr))
+			assert.ErrorIs(t, err, tt.wantError)
+			assert.Empty(t, api.CallsTo("ModifyDBClusterParameterGroup"))
+		})
+	}
+}
+
+func TestCustomUpdate_ParameterOverrideStatuses(t *testing.T) {
+	api := newTestBackend()
+	rm := newTestResourceManager(api)
+	desired := newTestResource(util.Parameters{
+		"binlog_format":  aws.String("ROW"),
+		testParamName(0): aws.String("1"),
+	})
+	latest := newTestResource(util.Parameters{
+		testParamName(1): aws.String("1"),
+	})
+	latest.ko.Status.ParameterOverrideStatuses = []*svcapitypes.Parameter{{
+		ParameterName:  aws.String(testParamName(1)),
+		ParameterValue: aws.String("1"),
+	}}
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.ParameterOverrides", desired.ko.Spec.ParameterOverrides, latest.ko.Spec.ParameterOverrides)
+
+	updated, err := rm.customUpdate(context.Background(), desired, latest, delta)
+	assert.Equal(t, requeueWaitAfterParameterSync, err)
+	require.NotNil(t, updated)
+
+	statuses := updated.ko.Status.ParameterOverrideStatuses
+	assert.ElementsMatch(t, []string{"binlog_format", testParamName(0)}, statusNames(statuses))
+	for _, st := range statuses {
+		if *st.ParameterName == "binlog_format" {
+			assert.Equal(t, string(svcsdktypes.ApplyMethodPendingReboot), *st.ApplyMethod)
+		}
+	}
+}
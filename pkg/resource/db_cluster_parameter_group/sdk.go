@@ -140,7 +140,7 @@ func (rm *resourceManager) sdkFind(
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
-		ko.Status.ParameterOverrideStatuses = paramStatuses
+		setParameterOverrideStatuses(ko, paramStatuses)
 	}
 
 	return &resource{ko}, nil
@@ -222,7 +222,7 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
-	if err = rm.syncParameters(ctx, desired, nil); err != nil {
+	if _, err = rm.syncParameters(ctx, desired, nil); err != nil {
 		return nil, err
 	}
 
@@ -41,7 +41,15 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	compareSharedAccounts(delta, a, b)
 
+	if ackcompare.HasNilDifference(a.ko.Spec.CopyTags, b.ko.Spec.CopyTags) {
+		delta.Add("Spec.CopyTags", a.ko.Spec.CopyTags, b.ko.Spec.CopyTags)
+	} else if a.ko.Spec.CopyTags != nil && b.ko.Spec.CopyTags != nil {
+		if *a.ko.Spec.CopyTags != *b.ko.Spec.CopyTags {
+			delta.Add("Spec.CopyTags", a.ko.Spec.CopyTags, b.ko.Spec.CopyTags)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DBClusterIdentifier, b.ko.Spec.DBClusterIdentifier) {
 		delta.Add("Spec.DBClusterIdentifier", a.ko.Spec.DBClusterIdentifier, b.ko.Spec.DBClusterIdentifier)
 	} else if a.ko.Spec.DBClusterIdentifier != nil && b.ko.Spec.DBClusterIdentifier != nil {
@@ -59,10 +67,29 @@ func newResourceDelta(
 			delta.Add("Spec.DBClusterSnapshotIdentifier", a.ko.Spec.DBClusterSnapshotIdentifier, b.ko.Spec.DBClusterSnapshotIdentifier)
 		}
 	}
-	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
-	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
-	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef) {
+		delta.Add("Spec.KMSKeyRef", a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL) {
+		delta.Add("Spec.PreSignedURL", a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL)
+	} else if a.ko.Spec.PreSignedURL != nil && b.ko.Spec.PreSignedURL != nil {
+		if *a.ko.Spec.PreSignedURL != *b.ko.Spec.PreSignedURL {
+			delta.Add("Spec.PreSignedURL", a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBClusterSnapshotARN, b.ko.Spec.SourceDBClusterSnapshotARN) {
+		delta.Add("Spec.SourceDBClusterSnapshotARN", a.ko.Spec.SourceDBClusterSnapshotARN, b.ko.Spec.SourceDBClusterSnapshotARN)
+	} else if a.ko.Spec.SourceDBClusterSnapshotARN != nil && b.ko.Spec.SourceDBClusterSnapshotARN != nil {
+		if *a.ko.Spec.SourceDBClusterSnapshotARN != *b.ko.Spec.SourceDBClusterSnapshotARN {
+			delta.Add("Spec.SourceDBClusterSnapshotARN", a.ko.Spec.SourceDBClusterSnapshotARN, b.ko.Spec.SourceDBClusterSnapshotARN)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBClusterSnapshotRegion, b.ko.Spec.SourceDBClusterSnapshotRegion) {
+		delta.Add("Spec.SourceDBClusterSnapshotRegion", a.ko.Spec.SourceDBClusterSnapshotRegion, b.ko.Spec.SourceDBClusterSnapshotRegion)
+	} else if a.ko.Spec.SourceDBClusterSnapshotRegion != nil && b.ko.Spec.SourceDBClusterSnapshotRegion != nil {
+		if *a.ko.Spec.SourceDBClusterSnapshotRegion != *b.ko.Spec.SourceDBClusterSnapshotRegion {
+			delta.Add("Spec.SourceDBClusterSnapshotRegion", a.ko.Spec.SourceDBClusterSnapshotRegion, b.ko.Spec.SourceDBClusterSnapshotRegion)
+		}
 	}
 
 	return delta
@@ -0,0 +1,251 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_cluster_snapshot
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+const testSourceARN = "arn:aws:rds:us-east-1:111111111111:cluster-snapshot:source"
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+func copyResource() *resource {
+	return &resource{ko: &svcapitypes.DBClusterSnapshot{
+		Spec: svcapitypes.DBClusterSnapshotSpec{
+			DBClusterSnapshotIdentifier: aws.String("copy"),
+			SourceDBClusterSnapshotARN:  aws.String(testSourceARN),
+			KMSKeyID:                    aws.String("alias/snapshots"),
+			CopyTags:                    aws.Bool(true),
+			Tags: []*svcapitypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSdkCreateCopiesSnapshot(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	created, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CopyDBClusterSnapshot"}, api.Operations())
+
+	input := api.CallsTo("CopyDBClusterSnapshot")[0].(*svcsdk.CopyDBClusterSnapshotInput)
+	assert.Equal(t, testSourceARN, *input.SourceDBClusterSnapshotIdentifier)
+	assert.Equal(t, "copy", *input.TargetDBClusterSnapshotIdentifier)
+	assert.Equal(t, "alias/snapshots", *input.KmsKeyId)
+	assert.True(t, *input.CopyTags)
+	assert.Equal(t, "us-east-1", *input.SourceRegion)
+	assert.Equal(t, "data", *input.Tags[0].Value)
+
+	assert.Nil(t, created.ko.Spec.DBClusterIdentifier)
+	assert.Equal(t, "copying", *created.ko.Status.Status)
+	require.NotNil(t, syncedCondition(created))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(created).Status)
+	assert.Equal(t, "DB cluster snapshot in 'copying' state, 0% complete", *syncedCondition(created).Message)
+}
+
+func TestSdkFindKeepsCopyDBClusterIdentifier(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	api.DBClusterSnapshots["copy"].DBClusterIdentifier = aws.String("source-cluster")
+	api.DBClusterSnapshots["copy"].PercentProgress = aws.Int32(42)
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Nil(t, latest.ko.Spec.DBClusterIdentifier)
+	assert.Equal(t, "DB cluster snapshot in 'copying' state, 42% complete", *syncedCondition(latest).Message)
+
+	delta := newResourceDelta(copyResource(), latest)
+	assert.False(t, delta.DifferentAt("Spec.DBClusterIdentifier"))
+	assert.False(t, delta.DifferentAt("Spec.SourceDBClusterSnapshotARN"))
+
+	api.DBClusterSnapshots["copy"].Status = aws.String(ClusterSnapshotStatusAvailable)
+	latest, err = rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Nil(t, syncedCondition(latest))
+}
+
+func TestSdkFindReadsTagsFromDescribe(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	require.Len(t, latest.ko.Spec.Tags, 1)
+	assert.Equal(t, "team", *latest.ko.Spec.Tags[0].Key)
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+	assert.Empty(t, api.CallsTo("ListTagsForResource"))
+}
+
+func TestValidateCopySource(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    svcapitypes.DBClusterSnapshotSpec
+		wantErr bool
+	}{
+		{
+			name: "cluster",
+			spec: svcapitypes.DBClusterSnapshotSpec{
+				DBClusterIdentifier: aws.String("cluster"),
+			},
+		},
+		{
+			name: "copy",
+			spec: svcapitypes.DBClusterSnapshotSpec{
+				SourceDBClusterSnapshotARN: aws.String(testSourceARN),
+				CopyTags:                   aws.Bool(true),
+			},
+		},
+		{
+			name:    "no source",
+			spec:    svcapitypes.DBClusterSnapshotSpec{},
+			wantErr: true,
+		},
+		{
+			name: "cluster and copy",
+			spec: svcapitypes.DBClusterSnapshotSpec{
+				DBClusterIdentifier:        aws.String("cluster"),
+				SourceDBClusterSnapshotARN: aws.String(testSourceARN),
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy of snapshot identifier",
+			spec: svcapitypes.DBClusterSnapshotSpec{
+				SourceDBClusterSnapshotARN: aws.String("source"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy setting without copy",
+			spec: svcapitypes.DBClusterSnapshotSpec{
+				DBClusterIdentifier: aws.String("cluster"),
+				KMSKeyID:            aws.String("alias/snapshots"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCopySource(&resource{ko: &svcapitypes.DBClusterSnapshot{Spec: tt.spec}})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var terminal *ackerr.TerminalError
+			assert.ErrorAs(t, err, &terminal)
+		})
+	}
+}
+
+func TestImmutableFieldChanged(t *testing.T) {
+	delta := ackcompare.NewDelta()
+	assert.NoError(t, immutableFieldChanged(delta))
+
+	delta.Add("Spec.Tags", nil, nil)
+	assert.NoError(t, immutableFieldChanged(delta))
+
+	delta.Add("Spec.SourceDBClusterSnapshotARN", aws.String("a"), aws.String("b"))
+	err := immutableFieldChanged(delta)
+	var terminal *ackerr.TerminalError
+	require.ErrorAs(t, err, &terminal)
+	assert.Contains(t, err.Error(), "Spec.SourceDBClusterSnapshotARN")
+}
+
+func TestSyncSharedAccounts(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	api.DBClusterSnapshots["copy"].Status = aws.String(ClusterSnapshotStatusAvailable)
+	api.DBClusterSnapshotAttributes["copy"] = map[string][]string{
+		"restore": {"333333333333"},
+	}
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"333333333333"}, aws.ToStringSlice(latest.ko.Spec.SharedAccounts))
+
+	desired := copyResource()
+	delta := newResourceDelta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.SharedAccounts"))
+
+	_, err = rm.customUpdateDBClusterSnapshot(ctx, desired, latest, delta)
+	require.NoError(t, err)
+	calls := api.CallsTo("ModifyDBClusterSnapshotAttribute")
+	require.Len(t, calls, 1)
+	input := calls[0].(*svcsdk.ModifyDBClusterSnapshotAttributeInput)
+	assert.Equal(t, "restore", *input.AttributeName)
+	assert.Equal(t, "copy", *input.DBClusterSnapshotIdentifier)
+	assert.Empty(t, input.ValuesToAdd)
+	assert.Equal(t, []string{"333333333333"}, input.ValuesToRemove)
+
+	latest, err = rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	assert.Nil(t, latest.ko.Spec.SharedAccounts)
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt("Spec.SharedAccounts"))
+}
+
+func TestSyncSharedAccountsWaitsForAvailableSnapshot(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+
+	desired := copyResource()
+	desired.ko.Spec.SharedAccounts = aws.StringSlice([]string{"all"})
+	_, err = rm.customUpdateDBClusterSnapshot(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Error(t, err)
+	assert.Empty(t, api.CallsTo("ModifyDBClusterSnapshotAttribute"))
+}
@@ -150,9 +150,9 @@ func (rm *resourceManager) sdkFind(
 			ko.Status.IAMDatabaseAuthenticationEnabled = nil
 		}
 		if elem.KmsKeyId != nil {
-			ko.Status.KMSKeyID = elem.KmsKeyId
+			ko.Spec.KMSKeyID = elem.KmsKeyId
 		} else {
-			ko.Status.KMSKeyID = nil
+			ko.Spec.KMSKeyID = nil
 		}
 		if elem.LicenseModel != nil {
 			ko.Status.LicenseModel = elem.LicenseModel
@@ -187,9 +187,9 @@ func (rm *resourceManager) sdkFind(
 			ko.Status.SnapshotType = nil
 		}
 		if elem.SourceDBClusterSnapshotArn != nil {
-			ko.Status.SourceDBClusterSnapshotARN = elem.SourceDBClusterSnapshotArn
+			ko.Spec.SourceDBClusterSnapshotARN = elem.SourceDBClusterSnapshotArn
 		} else {
-			ko.Status.SourceDBClusterSnapshotARN = nil
+			ko.Spec.SourceDBClusterSnapshotARN = nil
 		}
 		if elem.Status != nil {
 			ko.Status.Status = elem.Status
@@ -213,9 +213,9 @@ func (rm *resourceManager) sdkFind(
 				}
 				f21 = append(f21, f21elem)
 			}
-			ko.Status.TagList = f21
+			ko.Spec.Tags = f21
 		} else {
-			ko.Status.TagList = nil
+			ko.Spec.Tags = nil
 		}
 		if elem.VpcId != nil {
 			ko.Status.VPCID = elem.VpcId
@@ -231,17 +231,21 @@ func (rm *resourceManager) sdkFind(
 
 	rm.setStatusDefaults(ko)
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
+		sharedAccounts, err := rm.getSharedAccounts(ctx, ko.Spec.DBClusterSnapshotIdentifier)
 		if err != nil {
 			return nil, err
 		}
-		ko.Spec.Tags = tags
+		ko.Spec.SharedAccounts = sharedAccounts
+	}
+	if ko.Spec.SourceDBClusterSnapshotARN != nil {
+		// RDS returns the DB cluster of the source snapshot of a copy, which
+		// isn't the source of this resource.
+		ko.Spec.DBClusterIdentifier = r.ko.Spec.DBClusterIdentifier
 	}
 	if !clusterSnapshotAvailable(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
-		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, clusterSnapshotProgressMessage(&resource{ko}), nil)
 	}
 
 	return &resource{ko}, nil
@@ -288,6 +292,14 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateCopySource(desired); err != nil {
+		return nil, err
+	}
+	// if request has SourceDBClusterSnapshotARN spec, create request will call CopyDBClusterSnapshot
+	// instead of normal create api
+	if desired.ko.Spec.SourceDBClusterSnapshotARN != nil {
+		return rm.copyDBClusterSnapshot(ctx, desired)
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -363,9 +375,9 @@ func (rm *resourceManager) sdkCreate(
 		ko.Status.IAMDatabaseAuthenticationEnabled = nil
 	}
 	if resp.DBClusterSnapshot.KmsKeyId != nil {
-		ko.Status.KMSKeyID = resp.DBClusterSnapshot.KmsKeyId
+		ko.Spec.KMSKeyID = resp.DBClusterSnapshot.KmsKeyId
 	} else {
-		ko.Status.KMSKeyID = nil
+		ko.Spec.KMSKeyID = nil
 	}
 	if resp.DBClusterSnapshot.LicenseModel != nil {
 		ko.Status.LicenseModel = resp.DBClusterSnapshot.LicenseModel
@@ -400,9 +412,9 @@ func (rm *resourceManager) sdkCreate(
 		ko.Status.SnapshotType = nil
 	}
 	if resp.DBClusterSnapshot.SourceDBClusterSnapshotArn != nil {
-		ko.Status.SourceDBClusterSnapshotARN = resp.DBClusterSnapshot.SourceDBClusterSnapshotArn
+		ko.Spec.SourceDBClusterSnapshotARN = resp.DBClusterSnapshot.SourceDBClusterSnapshotArn
 	} else {
-		ko.Status.SourceDBClusterSnapshotARN = nil
+		ko.Spec.SourceDBClusterSnapshotARN = nil
 	}
 	if resp.DBClusterSnapshot.Status != nil {
 		ko.Status.Status = resp.DBClusterSnapshot.Status
@@ -632,3 +644,187 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	// No terminal_errors specified for this resource in generator config
 	return false
 }
+
+// newCopyDBClusterSnapshotInput returns a CopyDBClusterSnapshotInput object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newCopyDBClusterSnapshotInput(
+	r *resource,
+) (*svcsdk.CopyDBClusterSnapshotInput, error) {
+	res := &svcsdk.CopyDBClusterSnapshotInput{}
+
+	if r.ko.Spec.CopyTags != nil {
+		res.CopyTags = r.ko.Spec.CopyTags
+	}
+	if r.ko.Spec.KMSKeyID != nil {
+		res.KmsKeyId = r.ko.Spec.KMSKeyID
+	}
+	if r.ko.Spec.PreSignedURL != nil {
+		res.PreSignedUrl = r.ko.Spec.PreSignedURL
+	}
+	if r.ko.Spec.SourceDBClusterSnapshotARN != nil {
+		res.SourceDBClusterSnapshotIdentifier = r.ko.Spec.SourceDBClusterSnapshotARN
+	}
+	if r.ko.Spec.SourceDBClusterSnapshotRegion != nil {
+		res.SourceRegion = r.ko.Spec.SourceDBClusterSnapshotRegion
+	}
+	if r.ko.Spec.Tags != nil {
+		resf5 := []svcsdktypes.Tag{}
+		for _, resf5iter := range r.ko.Spec.Tags {
+			resf5elem := &svcsdktypes.Tag{}
+			if resf5iter.Key != nil {
+				resf5elem.Key = resf5iter.Key
+			}
+			if resf5iter.Value != nil {
+				resf5elem.Value = resf5iter.Value
+			}
+			resf5 = append(resf5, *resf5elem)
+		}
+		res.Tags = resf5
+	}
+	if r.ko.Spec.DBClusterSnapshotIdentifier != nil {
+		res.TargetDBClusterSnapshotIdentifier = r.ko.Spec.DBClusterSnapshotIdentifier
+	}
+
+	return res, nil
+}
+
+// setResourceFromCopyDBClusterSnapshotOutput sets a resource CopyDBClusterSnapshotOutput type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromCopyDBClusterSnapshotOutput(
+	r *resource,
+	resp *svcsdk.CopyDBClusterSnapshotOutput,
+) {
+
+	if resp.DBClusterSnapshot.AllocatedStorage != nil {
+		allocatedStorageCopy := int64(*resp.DBClusterSnapshot.AllocatedStorage)
+		r.ko.Status.AllocatedStorage = &allocatedStorageCopy
+	} else {
+		r.ko.Status.AllocatedStorage = nil
+	}
+	if resp.DBClusterSnapshot.AvailabilityZones != nil {
+		r.ko.Status.AvailabilityZones = aws.StringSlice(resp.DBClusterSnapshot.AvailabilityZones)
+	} else {
+		r.ko.Status.AvailabilityZones = nil
+	}
+	if resp.DBClusterSnapshot.ClusterCreateTime != nil {
+		r.ko.Status.ClusterCreateTime = &metav1.Time{*resp.DBClusterSnapshot.ClusterCreateTime}
+	} else {
+		r.ko.Status.ClusterCreateTime = nil
+	}
+	if resp.DBClusterSnapshot.DBClusterIdentifier != nil {
+		r.ko.Spec.DBClusterIdentifier = resp.DBClusterSnapshot.DBClusterIdentifier
+	} else {
+		r.ko.Spec.DBClusterIdentifier = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBClusterSnapshot.DBClusterSnapshotArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBClusterSnapshot.DBClusterSnapshotArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBClusterSnapshot.DBClusterSnapshotIdentifier != nil {
+		r.ko.Spec.DBClusterSnapshotIdentifier = resp.DBClusterSnapshot.DBClusterSnapshotIdentifier
+	} else {
+		r.ko.Spec.DBClusterSnapshotIdentifier = nil
+	}
+	if resp.DBClusterSnapshot.DBSystemId != nil {
+		r.ko.Status.DBSystemID = resp.DBClusterSnapshot.DBSystemId
+	} else {
+		r.ko.Status.DBSystemID = nil
+	}
+	if resp.DBClusterSnapshot.Engine != nil {
+		r.ko.Status.Engine = resp.DBClusterSnapshot.Engine
+	} else {
+		r.ko.Status.Engine = nil
+	}
+	if resp.DBClusterSnapshot.EngineMode != nil {
+		r.ko.Status.EngineMode = resp.DBClusterSnapshot.EngineMode
+	} else {
+		r.ko.Status.EngineMode = nil
+	}
+	if resp.DBClusterSnapshot.EngineVersion != nil {
+		r.ko.Status.EngineVersion = resp.DBClusterSnapshot.EngineVersion
+	} else {
+		r.ko.Status.EngineVersion = nil
+	}
+	if resp.DBClusterSnapshot.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBClusterSnapshot.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBClusterSnapshot.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBClusterSnapshot.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBClusterSnapshot.LicenseModel != nil {
+		r.ko.Status.LicenseModel = resp.DBClusterSnapshot.LicenseModel
+	} else {
+		r.ko.Status.LicenseModel = nil
+	}
+	if resp.DBClusterSnapshot.MasterUsername != nil {
+		r.ko.Status.MasterUsername = resp.DBClusterSnapshot.MasterUsername
+	} else {
+		r.ko.Status.MasterUsername = nil
+	}
+	if resp.DBClusterSnapshot.PercentProgress != nil {
+		percentProgressCopy := int64(*resp.DBClusterSnapshot.PercentProgress)
+		r.ko.Status.PercentProgress = &percentProgressCopy
+	} else {
+		r.ko.Status.PercentProgress = nil
+	}
+	if resp.DBClusterSnapshot.Port != nil {
+		portCopy := int64(*resp.DBClusterSnapshot.Port)
+		r.ko.Status.Port = &portCopy
+	} else {
+		r.ko.Status.Port = nil
+	}
+	if resp.DBClusterSnapshot.SnapshotCreateTime != nil {
+		r.ko.Status.SnapshotCreateTime = &metav1.Time{*resp.DBClusterSnapshot.SnapshotCreateTime}
+	} else {
+		r.ko.Status.SnapshotCreateTime = nil
+	}
+	if resp.DBClusterSnapshot.SnapshotType != nil {
+		r.ko.Status.SnapshotType = resp.DBClusterSnapshot.SnapshotType
+	} else {
+		r.ko.Status.SnapshotType = nil
+	}
+	if resp.DBClusterSnapshot.SourceDBClusterSnapshotArn != nil {
+		r.ko.Spec.SourceDBClusterSnapshotARN = resp.DBClusterSnapshot.SourceDBClusterSnapshotArn
+	} else {
+		r.ko.Spec.SourceDBClusterSnapshotARN = nil
+	}
+	if resp.DBClusterSnapshot.Status != nil {
+		r.ko.Status.Status = resp.DBClusterSnapshot.Status
+	} else {
+		r.ko.Status.Status = nil
+	}
+	if resp.DBClusterSnapshot.StorageEncrypted != nil {
+		r.ko.Status.StorageEncrypted = resp.DBClusterSnapshot.StorageEncrypted
+	} else {
+		r.ko.Status.StorageEncrypted = nil
+	}
+	if resp.DBClusterSnapshot.TagList != nil {
+		f21 := []*svcapitypes.Tag{}
+		for _, f21iter := range resp.DBClusterSnapshot.TagList {
+			f21elem := &svcapitypes.Tag{}
+			if f21iter.Key != nil {
+				f21elem.Key = f21iter.Key
+			}
+			if f21iter.Value != nil {
+				f21elem.Value = f21iter.Value
+			}
+			f21 = append(f21, f21elem)
+		}
+		r.ko.Status.TagList = f21
+	} else {
+		r.ko.Status.TagList = nil
+	}
+	if resp.DBClusterSnapshot.VpcId != nil {
+		r.ko.Status.VPCID = resp.DBClusterSnapshot.VpcId
+	} else {
+		r.ko.Status.VPCID = nil
+	}
+
+}
@@ -0,0 +1,56 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// restartGenerationDeltaPath is the delta path that customPreCompare uses to
+// ask sdkUpdate to reboot the DB instance.
+const restartGenerationDeltaPath = "Spec.RestartGeneration"
+
+// initActionGenerations records the action generations of a DB instance that
+// is about to be created as already executed. A newly created DB instance
+// has nothing to reboot.
+func initActionGenerations(r *resource) {
+	r.ko.Status.LastRestartGeneration = r.ko.Spec.RestartGeneration
+}
+
+// compareRestartGeneration adds a difference to the delta when the desired
+// DB instance requests a restart generation that the latest DB instance has
+// not executed yet.
+func compareRestartGeneration(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	requested := desired.ko.Spec.RestartGeneration
+	last := latest.ko.Status.LastRestartGeneration
+	if util.GenerationRequested(requested, last) {
+		delta.Add(restartGenerationDeltaPath, requested, last)
+	}
+}
+
+// restartingMessage returns the Synced condition message of a DB instance
+// that is rebooting for the supplied restart generation.
+func restartingMessage(generation *int64) string {
+	return fmt.Sprintf(
+		"DB instance is rebooting for restart generation %d", *generation,
+	)
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+func TestSdkUpdate_RestartGeneration(t *testing.T) {
+	tests := []struct {
+		name        string
+		requested   *int64
+		last        *int64
+		status      string
+		wantOps     []string
+		wantLast    *int64
+		wantRequeue bool
+	}{
+		{
+			name:      "first restart generation reboots",
+			requested: aws.Int64(1),
+			status:    fake.StatusAvailable,
+			wantOps:   []string{"RebootDBInstance"},
+			wantLast:  aws.Int64(1),
+		},
+		{
+			name:      "incremented restart generation reboots",
+			requested: aws.Int64(3),
+			last:      aws.Int64(2),
+			status:    fake.StatusAvailable,
+			wantOps:   []string{"RebootDBInstance"},
+			wantLast:  aws.Int64(3),
+		},
+		{
+			name:      "executed restart generation does not reboot again",
+			requested: aws.Int64(2),
+			last:      aws.Int64(2),
+			status:    fake.StatusAvailable,
+			wantLast:  aws.Int64(2),
+		},
+		{
+			name:      "lowered restart generation is ignored",
+			requested: aws.Int64(1),
+			last:      aws.Int64(2),
+			status:    fake.StatusAvailable,
+			wantLast:  aws.Int64(2),
+		},
+		{
+			name:        "storage-full DB instance is not rebooted",
+			requested:   aws.Int64(1),
+			status:      "storage-full",
+			wantRequeue: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceStatus:     aws.String(tt.status),
+			}
+			rm := newTestResourceManager(api)
+			desired := &resource{ko: &svcapitypes.DBInstance{
+				Spec: svcapitypes.DBInstanceSpec{
+					DBInstanceIdentifier: aws.String("db"),
+					RestartGeneration:    tt.requested,
+					AllocatedStorage:     aws.Int64(100),
+				},
+			}}
+			latest := desired.DeepCopy().(*resource)
+			latest.ko.Spec.AllocatedStorage = aws.Int64(50)
+			latest.ko.Status.DBInstanceStatus = aws.String(tt.status)
+			latest.ko.Status.LastRestartGeneration = tt.last
+			// The pending storage modification doesn't let the
+			// storage-full DB instance through to the reboot.
+			delta := ackcompare.NewDelta()
+			delta.Add("Spec.AllocatedStorage", desired.ko.Spec.AllocatedStorage, latest.ko.Spec.AllocatedStorage)
+			compareRestartGeneration(delta, desired, latest)
+			if !delta.DifferentAt(restartGenerationDeltaPath) {
+				assert.Empty(t, tt.wantOps)
+				return
+			}
+
+			updated, err := rm.sdkUpdate(context.Background(), desired, latest, delta)
+			if tt.wantRequeue {
+				require.Error(t, err)
+				assert.Nil(t, updated.ko.Status.LastRestartGeneration)
+				assert.Empty(t, api.Operations())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOps, api.Operations())
+			assert.Equal(t, tt.wantLast, updated.ko.Status.LastRestartGeneration)
+		})
+	}
+}
+
+func TestSdkCreate_InitialRestartGenerationIsNotExecuted(t *testing.T) {
+	api := fake.New()
+	rm := newTestResourceManager(api)
+	desired := &resource{ko: &svcapitypes.DBInstance{
+		Spec: svcapitypes.DBInstanceSpec{
+			DBInstanceIdentifier: aws.String("db"),
+			DBInstanceClass:      aws.String("db.t3.micro"),
+			Engine:               aws.String("postgres"),
+			RestartGeneration:    aws.Int64(4),
+		},
+	}}
+
+	created, err := rm.sdkCreate(context.Background(), desired)
+	require.NoError(t, err)
+
+	assert.Equal(t, aws.Int64(4), created.ko.Status.LastRestartGeneration)
+	delta := ackcompare.NewDelta()
+	compareRestartGeneration(delta, desired, created)
+	assert.False(t, delta.DifferentAt(restartGenerationDeltaPath))
+}
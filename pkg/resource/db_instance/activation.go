@@ -0,0 +1,131 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// activationStateDeltaPath is the delta path that customPreCompare uses to
+// ask sdkUpdate to start or stop the DB instance.
+const activationStateDeltaPath = "Spec.ActivationState"
+
+// msgCannotModifyWhileStopped is the Synced condition message of a stopped
+// DB instance with changes that can't be applied until it is started.
+const msgCannotModifyWhileStopped = "DB instance cannot be modified while stopped, " +
+	"set activationState to '" + util.ActivationStateStarted + "' to apply the changes"
+
+var (
+	// requeueWaitWhileStopped is returned when a stopped DB instance has
+	// changes that can only be applied once it is started. There is nothing
+	// to poll for, a change to the activation state triggers a reconcile
+	// anyway, so the requeue is infrequent.
+	requeueWaitWhileStopped = ackrequeue.NeededAfter(
+		errors.New("DB instance in 'stopped' state, cannot be modified until it is started."),
+		10*time.Minute,
+	)
+	// errStopClusterMember is returned when an activation state is set on a
+	// DB instance that belongs to a DB cluster.
+	errStopClusterMember = ackerr.NewTerminalError(errors.New(
+		"activationState can't be set on a DB instance in a DB cluster, " +
+			"set activationState on the DBCluster instead",
+	))
+)
+
+// instanceStopped returns true if the supplied DB instance is stopped.
+func instanceStopped(r *resource) bool {
+	if r.ko.Status.DBInstanceStatus == nil {
+		return false
+	}
+	return *r.ko.Status.DBInstanceStatus == StatusStopped
+}
+
+// stoppedAsDesired returns true if the latest DB instance is stopped and the
+// desired DB instance doesn't ask for it to be started. Such a DB instance is
+// settled even though it isn't available.
+func stoppedAsDesired(desired *resource, latest *resource) bool {
+	return instanceStopped(latest) &&
+		!util.WantsStarted(desired.ko.Spec.ActivationState)
+}
+
+// compareActivationState adds a difference to the delta when the status of
+// the latest DB instance doesn't match the desired activation state. A DB
+// instance that is stopping or starting already heads to the desired state
+// on one side, so only the opposite direction is a difference.
+func compareActivationState(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	state := desired.ko.Spec.ActivationState
+	status := latest.ko.Status.DBInstanceStatus
+	if state == nil || status == nil {
+		return
+	}
+	stoppedOrStopping := *status == StatusStopped || *status == StatusStopping
+	if (util.WantsStarted(state) && stoppedOrStopping) ||
+		(util.WantsStopped(state) && !stoppedOrStopping) {
+		delta.Add(activationStateDeltaPath, state, status)
+	}
+}
+
+// startDBInstance calls the RDS StartDBInstance API for the supplied DB
+// instance.
+func (rm *resourceManager) startDBInstance(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.startDBInstance")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.StartDBInstance(
+		ctx,
+		&svcsdk.StartDBInstanceInput{
+			DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "StartDBInstance", err)
+	return err
+}
+
+// stopDBInstance calls the RDS StopDBInstance API for the supplied DB
+// instance.
+func (rm *resourceManager) stopDBInstance(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.stopDBInstance")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.StopDBInstance(
+		ctx,
+		&svcsdk.StopDBInstanceInput{
+			DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "StopDBInstance", err)
+	return err
+}
@@ -0,0 +1,177 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestSdkUpdate_ActivationState(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         *string
+		status        string
+		clusterMember bool
+		modifyClass   bool
+		wantOps       []string
+		wantRequeue   bool
+		wantTerminal  bool
+	}{
+		{
+			name:    "available DB instance is stopped",
+			state:   aws.String(util.ActivationStateStopped),
+			status:  fake.StatusAvailable,
+			wantOps: []string{"StopDBInstance"},
+		},
+		{
+			name:        "modifications are applied before stopping",
+			state:       aws.String(util.ActivationStateStopped),
+			status:      fake.StatusAvailable,
+			modifyClass: true,
+			wantOps:     []string{"ModifyDBInstance"},
+		},
+		{
+			name:    "stopped DB instance is started",
+			state:   aws.String(util.ActivationStateStarted),
+			status:  fake.StatusStopped,
+			wantOps: []string{"StartDBInstance"},
+		},
+		{
+			name:        "stopped DB instance with modifications waits to be started",
+			status:      fake.StatusStopped,
+			modifyClass: true,
+			wantRequeue: true,
+		},
+		{
+			name:        "DB instance restarted by RDS waits to be available before stopping",
+			state:       aws.String(util.ActivationStateStopped),
+			status:      fake.StatusStarting,
+			wantRequeue: true,
+		},
+		{
+			name:          "DB instance in a DB cluster can't be stopped",
+			state:         aws.String(util.ActivationStateStopped),
+			status:        fake.StatusAvailable,
+			clusterMember: true,
+			wantTerminal:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceStatus:     aws.String(tt.status),
+			}
+			rm := newTestResourceManager(api)
+			desired := &resource{ko: &svcapitypes.DBInstance{
+				Spec: svcapitypes.DBInstanceSpec{
+					DBInstanceIdentifier: aws.String("db"),
+					DBInstanceClass:      aws.String("db.t3.micro"),
+					ActivationState:      tt.state,
+				},
+			}}
+			if tt.clusterMember {
+				desired.ko.Spec.DBClusterIdentifier = aws.String("cluster")
+			}
+			latest := desired.DeepCopy().(*resource)
+			latest.ko.Status.DBInstanceStatus = aws.String(tt.status)
+			delta := ackcompare.NewDelta()
+			if tt.modifyClass {
+				desired.ko.Spec.DBInstanceClass = aws.String("db.t3.large")
+				delta.Add("Spec.DBInstanceClass", desired.ko.Spec.DBInstanceClass, latest.ko.Spec.DBInstanceClass)
+			}
+			compareActivationState(delta, desired, latest)
+
+			_, err := rm.sdkUpdate(context.Background(), desired, latest, delta)
+			switch {
+			case tt.wantTerminal:
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			case tt.wantRequeue:
+				require.Error(t, err)
+			default:
+				require.NoError(t, err)
+			}
+			if len(tt.wantOps) == 0 {
+				assert.Empty(t, api.Operations())
+			} else {
+				assert.Equal(t, tt.wantOps, api.Operations())
+			}
+		})
+	}
+}
+
+func TestSdkFind_StoppedDBInstanceIsSettledUnlessStartWanted(t *testing.T) {
+	tests := []struct {
+		name       string
+		state      *string
+		wantSynced bool
+	}{
+		{
+			name:       "activation state not managed",
+			wantSynced: true,
+		},
+		{
+			name:       "stop wanted",
+			state:      aws.String(util.ActivationStateStopped),
+			wantSynced: true,
+		},
+		{
+			name:  "start wanted",
+			state: aws.String(util.ActivationStateStarted),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceArn:        aws.String("arn:aws:rds:us-west-2:111111111111:db:db"),
+				DBInstanceStatus:     aws.String(fake.StatusStopped),
+			}
+			rm := newTestResourceManager(api)
+
+			latest, err := rm.sdkFind(context.Background(), &resource{ko: &svcapitypes.DBInstance{
+				Spec: svcapitypes.DBInstanceSpec{
+					DBInstanceIdentifier: aws.String("db"),
+					ActivationState:      tt.state,
+				},
+			}})
+			require.NoError(t, err)
+
+			synced := ackcondition.Synced(latest)
+			if tt.wantSynced {
+				assert.Nil(t, synced)
+			} else {
+				require.NotNil(t, synced)
+				assert.Equal(t, corev1.ConditionFalse, synced.Status)
+			}
+		})
+	}
+}
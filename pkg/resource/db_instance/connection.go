@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"errors"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+)
+
+// syncConnectionDetails publishes the connection details of the supplied DB
+// instance once it is available. It runs on every read, so it is skipped while
+// the DB instance is being deleted, and a failure to publish doesn't fail the
+// read: the DB instance is marked as not synced instead, which requeues it.
+func (rm *resourceManager) syncConnectionDetails(
+	ctx context.Context,
+	ko *svcapitypes.DBInstance,
+) {
+	if ko.DeletionTimestamp != nil || !instanceAvailable(&resource{ko}) {
+		return
+	}
+	if err := rm.publishConnectionDetails(ctx, ko); err != nil {
+		rlog := ackrtlog.FromContext(ctx)
+		rlog.Info("failed to publish connection details", "error", err.Error())
+		msg := "connection details not published: " + err.Error()
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
+}
+
+// publishConnectionDetails writes the connection details of the supplied DB
+// instance to the Secret or ConfigMap of its ConnectionDetailsName, if set.
+// The master user password is read from the MasterUserPassword secret each
+// time, so that the published details follow password changes.
+func (rm *resourceManager) publishConnectionDetails(
+	ctx context.Context,
+	ko *svcapitypes.DBInstance,
+) error {
+	if aws.ToString(ko.Spec.ConnectionDetailsName) == "" {
+		return nil
+	}
+	writer := rm.connectionWriter
+	if writer == nil {
+		return errors.New("connection details can't be published: no writer is configured")
+	}
+	details := connection.Details{
+		Engine:   aws.ToString(ko.Spec.Engine),
+		Database: aws.ToString(ko.Spec.DBName),
+		Username: aws.ToString(ko.Spec.MasterUsername),
+	}
+	if ko.Status.Endpoint != nil {
+		details.Host = aws.ToString(ko.Status.Endpoint.Address)
+		details.Port = aws.ToInt64(ko.Status.Endpoint.Port)
+	}
+	if ko.Spec.MasterUserPassword != nil {
+		password, err := rm.rr.SecretValueFromReference(ctx, ko.Spec.MasterUserPassword)
+		if err != nil {
+			return ackrequeue.Needed(err)
+		}
+		details.Password = password
+	}
+	return writer.Publish(ctx, ko, connection.Spec{
+		Name:        ko.Spec.ConnectionDetailsName,
+		Kind:        ko.Spec.ConnectionDetailsKind,
+		Keys:        ko.Spec.ConnectionDetailsKeys,
+		DBProxyName: ko.Spec.ConnectionDetailsDBProxyName,
+	}, details)
+}
@@ -99,6 +99,9 @@ func newResourceDelta(
 			delta.Add("Spec.DBClusterIdentifier", a.ko.Spec.DBClusterIdentifier, b.ko.Spec.DBClusterIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.DBClusterIdentifierRef, b.ko.Spec.DBClusterIdentifierRef) {
+		delta.Add("Spec.DBClusterIdentifierRef", a.ko.Spec.DBClusterIdentifierRef, b.ko.Spec.DBClusterIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DBClusterSnapshotIdentifier, b.ko.Spec.DBClusterSnapshotIdentifier) {
 		delta.Add("Spec.DBClusterSnapshotIdentifier", a.ko.Spec.DBClusterSnapshotIdentifier, b.ko.Spec.DBClusterSnapshotIdentifier)
 	} else if a.ko.Spec.DBClusterSnapshotIdentifier != nil && b.ko.Spec.DBClusterSnapshotIdentifier != nil {
@@ -106,6 +109,9 @@ func newResourceDelta(
 			delta.Add("Spec.DBClusterSnapshotIdentifier", a.ko.Spec.DBClusterSnapshotIdentifier, b.ko.Spec.DBClusterSnapshotIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.DBClusterSnapshotIdentifierRef, b.ko.Spec.DBClusterSnapshotIdentifierRef) {
+		delta.Add("Spec.DBClusterSnapshotIdentifierRef", a.ko.Spec.DBClusterSnapshotIdentifierRef, b.ko.Spec.DBClusterSnapshotIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DBInstanceClass, b.ko.Spec.DBInstanceClass) {
 		delta.Add("Spec.DBInstanceClass", a.ko.Spec.DBInstanceClass, b.ko.Spec.DBInstanceClass)
 	} else if a.ko.Spec.DBInstanceClass != nil && b.ko.Spec.DBInstanceClass != nil {
@@ -137,6 +143,9 @@ func newResourceDelta(
 			delta.Add("Spec.DBSnapshotIdentifier", a.ko.Spec.DBSnapshotIdentifier, b.ko.Spec.DBSnapshotIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.DBSnapshotIdentifierRef, b.ko.Spec.DBSnapshotIdentifierRef) {
+		delta.Add("Spec.DBSnapshotIdentifierRef", a.ko.Spec.DBSnapshotIdentifierRef, b.ko.Spec.DBSnapshotIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DBSubnetGroupName, b.ko.Spec.DBSubnetGroupName) {
 		delta.Add("Spec.DBSubnetGroupName", a.ko.Spec.DBSubnetGroupName, b.ko.Spec.DBSubnetGroupName)
 	} else if a.ko.Spec.DBSubnetGroupName != nil && b.ko.Spec.DBSubnetGroupName != nil {
@@ -289,6 +298,9 @@ func newResourceDelta(
 			delta.Add("Spec.OptionGroupName", a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef) {
+		delta.Add("Spec.OptionGroupRef", a.ko.Spec.OptionGroupRef, b.ko.Spec.OptionGroupRef)
+	}
 	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.PerformanceInsightsKMSKeyRef, b.ko.Spec.PerformanceInsightsKMSKeyRef) {
 		delta.Add("Spec.PerformanceInsightsKMSKeyRef", a.ko.Spec.PerformanceInsightsKMSKeyRef, b.ko.Spec.PerformanceInsightsKMSKeyRef)
 	}
@@ -348,6 +360,27 @@ func newResourceDelta(
 			delta.Add("Spec.ReplicaMode", a.ko.Spec.ReplicaMode, b.ko.Spec.ReplicaMode)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.RestoreTime, b.ko.Spec.RestoreTime) {
+		delta.Add("Spec.RestoreTime", a.ko.Spec.RestoreTime, b.ko.Spec.RestoreTime)
+	} else if a.ko.Spec.RestoreTime != nil && b.ko.Spec.RestoreTime != nil {
+		if !a.ko.Spec.RestoreTime.Equal(b.ko.Spec.RestoreTime) {
+			delta.Add("Spec.RestoreTime", a.ko.Spec.RestoreTime, b.ko.Spec.RestoreTime)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBIResourceID, b.ko.Spec.SourceDBIResourceID) {
+		delta.Add("Spec.SourceDBIResourceID", a.ko.Spec.SourceDBIResourceID, b.ko.Spec.SourceDBIResourceID)
+	} else if a.ko.Spec.SourceDBIResourceID != nil && b.ko.Spec.SourceDBIResourceID != nil {
+		if *a.ko.Spec.SourceDBIResourceID != *b.ko.Spec.SourceDBIResourceID {
+			delta.Add("Spec.SourceDBIResourceID", a.ko.Spec.SourceDBIResourceID, b.ko.Spec.SourceDBIResourceID)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBInstanceAutomatedBackupsARN, b.ko.Spec.SourceDBInstanceAutomatedBackupsARN) {
+		delta.Add("Spec.SourceDBInstanceAutomatedBackupsARN", a.ko.Spec.SourceDBInstanceAutomatedBackupsARN, b.ko.Spec.SourceDBInstanceAutomatedBackupsARN)
+	} else if a.ko.Spec.SourceDBInstanceAutomatedBackupsARN != nil && b.ko.Spec.SourceDBInstanceAutomatedBackupsARN != nil {
+		if *a.ko.Spec.SourceDBInstanceAutomatedBackupsARN != *b.ko.Spec.SourceDBInstanceAutomatedBackupsARN {
+			delta.Add("Spec.SourceDBInstanceAutomatedBackupsARN", a.ko.Spec.SourceDBInstanceAutomatedBackupsARN, b.ko.Spec.SourceDBInstanceAutomatedBackupsARN)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBInstanceIdentifier, b.ko.Spec.SourceDBInstanceIdentifier) {
 		delta.Add("Spec.SourceDBInstanceIdentifier", a.ko.Spec.SourceDBInstanceIdentifier, b.ko.Spec.SourceDBInstanceIdentifier)
 	} else if a.ko.Spec.SourceDBInstanceIdentifier != nil && b.ko.Spec.SourceDBInstanceIdentifier != nil {
@@ -355,6 +388,9 @@ func newResourceDelta(
 			delta.Add("Spec.SourceDBInstanceIdentifier", a.ko.Spec.SourceDBInstanceIdentifier, b.ko.Spec.SourceDBInstanceIdentifier)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.SourceDBInstanceIdentifierRef, b.ko.Spec.SourceDBInstanceIdentifierRef) {
+		delta.Add("Spec.SourceDBInstanceIdentifierRef", a.ko.Spec.SourceDBInstanceIdentifierRef, b.ko.Spec.SourceDBInstanceIdentifierRef)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion) {
 		delta.Add("Spec.SourceRegion", a.ko.Spec.SourceRegion, b.ko.Spec.SourceRegion)
 	} else if a.ko.Spec.SourceRegion != nil && b.ko.Spec.SourceRegion != nil {
@@ -404,6 +440,13 @@ func newResourceDelta(
 			delta.Add("Spec.UseDefaultProcessorFeatures", a.ko.Spec.UseDefaultProcessorFeatures, b.ko.Spec.UseDefaultProcessorFeatures)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.UseLatestRestorableTime, b.ko.Spec.UseLatestRestorableTime) {
+		delta.Add("Spec.UseLatestRestorableTime", a.ko.Spec.UseLatestRestorableTime, b.ko.Spec.UseLatestRestorableTime)
+	} else if a.ko.Spec.UseLatestRestorableTime != nil && b.ko.Spec.UseLatestRestorableTime != nil {
+		if *a.ko.Spec.UseLatestRestorableTime != *b.ko.Spec.UseLatestRestorableTime {
+			delta.Add("Spec.UseLatestRestorableTime", a.ko.Spec.UseLatestRestorableTime, b.ko.Spec.UseLatestRestorableTime)
+		}
+	}
 	if len(a.ko.Spec.VPCSecurityGroupIDs) != len(b.ko.Spec.VPCSecurityGroupIDs) {
 		delta.Add("Spec.VPCSecurityGroupIDs", a.ko.Spec.VPCSecurityGroupIDs, b.ko.Spec.VPCSecurityGroupIDs)
 	} else if len(a.ko.Spec.VPCSecurityGroupIDs) > 0 {
@@ -25,6 +25,7 @@ import (
 	"github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -145,8 +146,11 @@ func customPreCompare(delta *ackcompare.Delta, a *resource, b *resource) {
 	// treat them as different, such as spec has 14, status has 14.1
 	// controller should treat them as same
 	reconcileEngineVersion(a, b)
-	compareTags(delta, a, b)
 	compareSecretReferenceChanges(delta, a, b)
+	comparePendingReboot(delta, a, b)
+	compareRestartGeneration(delta, a, b)
+	compareActivationState(delta, a, b)
+	compareRoleAssociations(delta, a, b)
 
 	// if dbinstances are created from a dbcluster, certain fields can only be changed on dbclusters,
 	// not in dbinstances.
@@ -263,7 +267,10 @@ func customPreCompare(delta *ackcompare.Delta, a *resource, b *resource) {
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
 // explaining the DB instance cannot be modified until it reaches an available
 // status.
-func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+func (rm *resourceManager) requeueWaitUntilCanModify(
+	ctx context.Context,
+	r *resource,
+) *ackrequeue.RequeueNeededAfter {
 	if r.ko.Status.DBInstanceStatus == nil {
 		return nil
 	}
@@ -272,9 +279,8 @@ func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
 		"DB Instance in '%s' state, cannot be modified until '%s'.",
 		status, StatusAvailable,
 	)
-	return ackrequeue.NeededAfter(
-		errors.New(msg),
-		ackrequeue.DefaultRequeueAfterDuration,
+	return rm.requeuePolicy.NeededAfter(
+		ctx, GroupKind.Kind, r.ko, status, errors.New(msg),
 	)
 }
 
@@ -356,6 +362,96 @@ func (rm *resourceManager) restoreDbInstanceFromDbSnapshot(
 	return &resource{r.ko}, nil
 }
 
+// function to create restoreDbInstanceToPointInTime payload and call restoreDbInstanceToPointInTime API
+func (rm *resourceManager) restoreDbInstanceToPointInTime(
+	ctx context.Context,
+	r *resource,
+) (created *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.restoreDbInstanceToPointInTime")
+	defer func(err error) { exit(err) }(err)
+
+	input, err := rm.newRestoreDBInstanceToPointInTimeInput(r)
+	if err != nil {
+		return nil, err
+	}
+	resp, respErr := rm.sdkapi.RestoreDBInstanceToPointInTime(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "RestoreDBInstanceToPointInTime", respErr)
+	if respErr != nil {
+		return nil, respErr
+	}
+
+	rm.setResourceFromRestoreDBInstanceToPointInTimeOutput(r, resp)
+	rm.setStatusDefaults(r.ko)
+
+	// We expect the DB instance to be in 'creating' status since we just
+	// issued the call to create it, but I suppose it doesn't hurt to check
+	// here.
+	if instanceCreating(&resource{r.ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{r.ko}, corev1.ConditionFalse, nil, nil)
+	}
+	return &resource{r.ko}, nil
+}
+
+// restoringToPointInTime returns true if the supplied DB instance is to be
+// created by restoring the automated backups of another DB instance to a
+// point in time.
+func restoringToPointInTime(r *resource) bool {
+	return r.ko.Spec.RestoreTime != nil || aws.ToBool(r.ko.Spec.UseLatestRestorableTime)
+}
+
+// validateRestoreSource returns a terminal error if the supplied DB instance
+// sets conflicting sources to be created from. A DB instance is created
+// either from a DB snapshot, by restoring a DB instance to a point in time or
+// as a read replica of a DB instance.
+func validateRestoreSource(r *resource) error {
+	spec := r.ko.Spec
+	if spec.RestoreTime != nil && aws.ToBool(spec.UseLatestRestorableTime) {
+		return ackerr.NewTerminalError(errors.New(
+			"only one of RestoreTime and UseLatestRestorableTime can be set",
+		))
+	}
+	pointInTimeSources := 0
+	for _, source := range []*string{
+		spec.SourceDBInstanceIdentifier,
+		spec.SourceDBIResourceID,
+		spec.SourceDBInstanceAutomatedBackupsARN,
+	} {
+		if source != nil {
+			pointInTimeSources++
+		}
+	}
+	if !restoringToPointInTime(r) {
+		if spec.SourceDBIResourceID != nil || spec.SourceDBInstanceAutomatedBackupsARN != nil {
+			return ackerr.NewTerminalError(errors.New(
+				"RestoreTime or UseLatestRestorableTime must be set to restore from " +
+					"SourceDBIResourceID or SourceDBInstanceAutomatedBackupsARN",
+			))
+		}
+		if spec.DBSnapshotIdentifier != nil && spec.SourceDBInstanceIdentifier != nil {
+			return ackerr.NewTerminalError(errors.New(
+				"only one of DBSnapshotIdentifier and SourceDBInstanceIdentifier can be set",
+			))
+		}
+		return nil
+	}
+	if spec.DBSnapshotIdentifier != nil {
+		return ackerr.NewTerminalError(errors.New(
+			"DBSnapshotIdentifier can't be set when restoring to a point in time " +
+				"with RestoreTime or UseLatestRestorableTime",
+		))
+	}
+	if pointInTimeSources != 1 {
+		return ackerr.NewTerminalError(errors.New(
+			"exactly one of SourceDBInstanceIdentifier, SourceDBIResourceID and " +
+				"SourceDBInstanceAutomatedBackupsARN must be set when restoring to a point in time",
+		))
+	}
+	return nil
+}
+
 // newCreateDBInstanceReadReplicaInput returns a CreateDBInstanceReadReplicaInput object
 // with each the field set by the corresponding configuration's fields.
 // We copy the function here because currently we don't have logic to rename param
@@ -581,23 +677,6 @@ func requireEngineVersionUpdate(desiredEngineVersion *string, latestEngineVersio
 }
 
 // syncTags keeps the resource's tags in sync
-//
-// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
-// following ways:
-//
-//  1. The names of the tagging API operations are different. Other APIs use the
-//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
-//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
-//     `RemoveTagsFromResource`.
-//
-//  2. Even though the name of the `ListTagsForResource` API call is the same,
-//     the structure of the input and the output are different from other APIs.
-//     For the input, instead of a `ResourceArn` field, RDS names the field
-//     `ResourceName`, but actually expects an ARN, not the instance
-//     name.  This is the same for the `AddTagsToResource` and
-//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
-//     called `TagList` instead of `Tags` but is otherwise the same struct with
-//     a `Key` and `Value` member field.
 func (rm *resourceManager) syncTags(
 	ctx context.Context,
 	desired *resource,
@@ -608,101 +687,23 @@ func (rm *resourceManager) syncTags(
 	defer func() { exit(err) }()
 
 	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
-
-	toAdd, toDelete := util.ComputeTagsDelta(
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
 		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
 	)
-
-	if len(toDelete) > 0 {
-		rlog.Debug("removing tags from instance", "tags", toDelete)
-		_, err = rm.sdkapi.RemoveTagsFromResource(
-			ctx,
-			&svcsdk.RemoveTagsFromResourceInput{
-				ResourceName: arn,
-				TagKeys:      toDelete,
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
-		if err != nil {
-			return err
-		}
-	}
-
-	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
-	// with a new value overwrites any existing tag with the same key. So, we
-	// don't need to do anything to "update" a Tag. Simply including it in the
-	// AddTagsToResource call is enough.
-	if len(toAdd) > 0 {
-		rlog.Debug("adding tags to instance", "tags", toAdd)
-		_, err = rm.sdkapi.AddTagsToResource(
-			ctx,
-			&svcsdk.AddTagsToResourceInput{
-				ResourceName: arn,
-				Tags:         sdkTagsFromResourceTags(toAdd),
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// getTags retrieves the resource's associated tags
-func (rm *resourceManager) getTags(
-	ctx context.Context,
-	resourceARN string,
-) ([]*svcapitypes.Tag, error) {
-	resp, err := rm.sdkapi.ListTagsForResource(
-		ctx,
-		&svcsdk.ListTagsForResourceInput{
-			ResourceName: &resourceARN,
-		},
-	)
-	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
-	if err != nil {
-		return nil, err
-	}
-	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
-	for _, tag := range resp.TagList {
-		tags = append(tags, &svcapitypes.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
-	}
-	return tags, nil
 }
 
 // compareTags adds a difference to the delta if the supplied resources have
-// different tag collections
+// different tag collections, as reconciled by the supplied TagReconciler
 func compareTags(
+	tags *util.TagReconciler,
 	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
-	if len(a.ko.Spec.Tags) != len(b.ko.Spec.Tags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-	} else if len(a.ko.Spec.Tags) > 0 {
-		if !util.EqualTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
-			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-		}
-	}
-}
-
-// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
-// array.
-func sdkTagsFromResourceTags(
-	rTags []*svcapitypes.Tag,
-) []svcsdktypes.Tag {
-	tags := make([]svcsdktypes.Tag, len(rTags))
-	for i := range rTags {
-		tags[i] = svcsdktypes.Tag{
-			Key:   rTags[i].Key,
-			Value: rTags[i].Value,
-		}
-	}
-	return tags
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
 }
 
 // TODO(a-hilaly): generate this code.
@@ -0,0 +1,470 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+// newTestResourceManager returns the resourceManager that the factory of the
+// package produces with the supplied fake RDS API.
+func newTestResourceManager(api *fake.RDS) *resourceManager {
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager)
+}
+
+func TestSdkCreate_CreateRestoreOrReadReplica(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          svcapitypes.DBInstanceSpec
+		wantOperation string
+	}{
+		{
+			name: "plain create calls CreateDBInstance",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceClass:      aws.String("db.t3.micro"),
+				Engine:               aws.String("postgres"),
+			},
+			wantOperation: "CreateDBInstance",
+		},
+		{
+			name: "DBSnapshotIdentifier restores from snapshot",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceClass:      aws.String("db.t3.micro"),
+				Engine:               aws.String("postgres"),
+				DBSnapshotIdentifier: aws.String("snap"),
+			},
+			wantOperation: "RestoreDBInstanceFromDBSnapshot",
+		},
+		{
+			name: "SourceDBInstanceIdentifier creates a read replica",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier:       aws.String("db"),
+				DBInstanceClass:            aws.String("db.t3.micro"),
+				SourceDBInstanceIdentifier: aws.String("source"),
+			},
+			wantOperation: "CreateDBInstanceReadReplica",
+		},
+		{
+			name: "RestoreTime restores the source to a point in time",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier:       aws.String("db"),
+				DBInstanceClass:            aws.String("db.t3.micro"),
+				SourceDBInstanceIdentifier: aws.String("source"),
+				RestoreTime:                &metav1.Time{Time: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)},
+			},
+			wantOperation: "RestoreDBInstanceToPointInTime",
+		},
+		{
+			name: "UseLatestRestorableTime restores by resource ID",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier:    aws.String("db"),
+				DBInstanceClass:         aws.String("db.t3.micro"),
+				SourceDBIResourceID:     aws.String("db-SOURCE"),
+				UseLatestRestorableTime: aws.Bool(true),
+			},
+			wantOperation: "RestoreDBInstanceToPointInTime",
+		},
+		{
+			name: "UseLatestRestorableTime restores replicated automated backups",
+			spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier:                aws.String("db"),
+				DBInstanceClass:                     aws.String("db.t3.micro"),
+				Engine:                              aws.String("postgres"),
+				SourceDBInstanceAutomatedBackupsARN: aws.String("arn:aws:rds:us-east-1:111111111111:auto-backup:ab-source"),
+				UseLatestRestorableTime:             aws.Bool(true),
+			},
+			wantOperation: "RestoreDBInstanceToPointInTime",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["source"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("source"),
+				DBInstanceStatus:     aws.String(fake.StatusAvailable),
+				DbiResourceId:        aws.String("db-SOURCE"),
+				Engine:               aws.String("postgres"),
+			}
+			rm := newTestResourceManager(api)
+
+			created, err := rm.sdkCreate(context.Background(), &resource{
+				ko: &svcapitypes.DBInstance{Spec: tt.spec},
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{tt.wantOperation}, api.Operations())
+			assert.Equal(t, StatusCreating, *created.ko.Status.DBInstanceStatus)
+			assert.NotNil(t, created.ko.Status.ACKResourceMetadata.ARN)
+			assert.Contains(t, api.DBInstances, "db")
+		})
+	}
+}
+
+func TestSdkFind_ReadsTagsFromDescribe(t *testing.T) {
+	api := fake.New()
+	rm := newTestResourceManager(api)
+	desired := &resource{ko: &svcapitypes.DBInstance{Spec: svcapitypes.DBInstanceSpec{
+		DBInstanceIdentifier: aws.String("db"),
+		DBInstanceClass:      aws.String("db.t3.micro"),
+		Engine:               aws.String("postgres"),
+		Tags: []*svcapitypes.Tag{
+			{Key: aws.String("team"), Value: aws.String("data")},
+		},
+	}}}
+	_, err := rm.sdkCreate(context.Background(), desired)
+	require.NoError(t, err)
+
+	latest, err := rm.sdkFind(context.Background(), desired)
+	require.NoError(t, err)
+	require.Len(t, latest.ko.Spec.Tags, 1)
+	assert.Equal(t, "team", *latest.ko.Spec.Tags[0].Key)
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+	assert.Equal(t, []string{"CreateDBInstance", "DescribeDBInstances"}, api.Operations())
+}
+
+func TestSdkCreate_RestoreToPointInTimeInput(t *testing.T) {
+	api := fake.New()
+	api.DBInstances["source"] = &svcsdktypes.DBInstance{
+		DBInstanceIdentifier: aws.String("source"),
+		Engine:               aws.String("postgres"),
+	}
+	rm := newTestResourceManager(api)
+	restoreTime := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := rm.sdkCreate(context.Background(), &resource{
+		ko: &svcapitypes.DBInstance{Spec: svcapitypes.DBInstanceSpec{
+			DBInstanceIdentifier:       aws.String("db"),
+			DBInstanceClass:            aws.String("db.t3.micro"),
+			SourceDBInstanceIdentifier: aws.String("source"),
+			RestoreTime:                &metav1.Time{Time: restoreTime},
+		}},
+	})
+	require.NoError(t, err)
+	input := api.CallsTo("RestoreDBInstanceToPointInTime")[0].(*svcsdk.RestoreDBInstanceToPointInTimeInput)
+	assert.Equal(t, "db", *input.TargetDBInstanceIdentifier)
+	assert.Equal(t, "source", *input.SourceDBInstanceIdentifier)
+	assert.Equal(t, restoreTime, *input.RestoreTime)
+	assert.Nil(t, input.UseLatestRestorableTime)
+}
+
+func TestValidateRestoreSource(t *testing.T) {
+	restoreTime := &metav1.Time{Time: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name    string
+		spec    svcapitypes.DBInstanceSpec
+		wantErr bool
+	}{
+		{
+			name: "no source",
+		},
+		{
+			name: "snapshot",
+			spec: svcapitypes.DBInstanceSpec{DBSnapshotIdentifier: aws.String("snap")},
+		},
+		{
+			name: "read replica",
+			spec: svcapitypes.DBInstanceSpec{SourceDBInstanceIdentifier: aws.String("source")},
+		},
+		{
+			name: "point in time by identifier",
+			spec: svcapitypes.DBInstanceSpec{
+				SourceDBInstanceIdentifier: aws.String("source"),
+				RestoreTime:                restoreTime,
+			},
+		},
+		{
+			name: "snapshot and read replica",
+			spec: svcapitypes.DBInstanceSpec{
+				DBSnapshotIdentifier:       aws.String("snap"),
+				SourceDBInstanceIdentifier: aws.String("source"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "snapshot and point in time",
+			spec: svcapitypes.DBInstanceSpec{
+				DBSnapshotIdentifier:    aws.String("snap"),
+				SourceDBIResourceID:     aws.String("db-SOURCE"),
+				UseLatestRestorableTime: aws.Bool(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "restore time and latest restorable time",
+			spec: svcapitypes.DBInstanceSpec{
+				SourceDBInstanceIdentifier: aws.String("source"),
+				RestoreTime:                restoreTime,
+				UseLatestRestorableTime:    aws.Bool(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "two point in time sources",
+			spec: svcapitypes.DBInstanceSpec{
+				SourceDBInstanceIdentifier: aws.String("source"),
+				SourceDBIResourceID:        aws.String("db-SOURCE"),
+				RestoreTime:                restoreTime,
+			},
+			wantErr: true,
+		},
+		{
+			name: "point in time without source",
+			spec: svcapitypes.DBInstanceSpec{
+				UseLatestRestorableTime: aws.Bool(true),
+			},
+			wantErr: true,
+		},
+		{
+			name: "resource ID without restore time",
+			spec: svcapitypes.DBInstanceSpec{
+				SourceDBIResourceID: aws.String("db-SOURCE"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRestoreSource(&resource{ko: &svcapitypes.DBInstance{Spec: tt.spec}})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var terminalErr *ackerr.TerminalError
+			assert.ErrorAs(t, err, &terminalErr)
+		})
+	}
+}
+
+func TestSdkDelete_DeletionAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        *svcsdk.DeleteDBInstanceInput
+		wantErr     bool
+	}{
+		{
+			name: "final snapshot is skipped by default",
+			want: &svcsdk.DeleteDBInstanceInput{
+				DBInstanceIdentifier: aws.String("db"),
+				SkipFinalSnapshot:    aws.Bool(true),
+			},
+		},
+		{
+			name: "final snapshot and automated backups from annotations",
+			annotations: map[string]string{
+				svcapitypes.SkipFinalSnapshotAnnotation:         "false",
+				svcapitypes.FinalDBSnapshotIdentifierAnnotation: "final",
+				svcapitypes.DeleteAutomatedBackupsAnnotation:    "true",
+			},
+			want: &svcsdk.DeleteDBInstanceInput{
+				DBInstanceIdentifier:      aws.String("db"),
+				SkipFinalSnapshot:         aws.Bool(false),
+				FinalDBSnapshotIdentifier: aws.String("final"),
+				DeleteAutomatedBackups:    aws.Bool(true),
+			},
+		},
+		{
+			name: "invalid annotation does not call DeleteDBInstance",
+			annotations: map[string]string{
+				svcapitypes.SkipFinalSnapshotAnnotation: "maybe",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceStatus:     aws.String(fake.StatusAvailable),
+			}
+			rm := newTestResourceManager(api)
+
+			_, err := rm.sdkDelete(context.Background(), &resource{
+				ko: &svcapitypes.DBInstance{
+					ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+					Spec: svcapitypes.DBInstanceSpec{
+						DBInstanceIdentifier: aws.String("db"),
+					},
+					Status: svcapitypes.DBInstanceStatus{
+						DBInstanceStatus: aws.String(StatusAvailable),
+					},
+				},
+			})
+			calls := api.CallsTo("DeleteDBInstance")
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Empty(t, calls)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, calls, 1)
+			assert.Equal(t, tt.want, calls[0])
+			assert.Equal(t, StatusDeleting, *api.DBInstances["db"].DBInstanceStatus)
+		})
+	}
+}
+
+func TestSdkDelete_AlreadyDeleting(t *testing.T) {
+	api := fake.New()
+	rm := newTestResourceManager(api)
+
+	_, err := rm.sdkDelete(context.Background(), &resource{
+		ko: &svcapitypes.DBInstance{
+			Spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier: aws.String("db"),
+			},
+			Status: svcapitypes.DBInstanceStatus{
+				DBInstanceStatus: aws.String(StatusDeleting),
+			},
+		},
+	})
+	assert.Equal(t, requeueWaitWhileDeleting, err)
+	assert.Empty(t, api.Operations())
+}
+
+// fakeReader is a client.Reader that returns the objects it holds by name.
+type fakeReader struct {
+	client.Reader
+	objects map[string]client.Object
+}
+
+func (r *fakeReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	src, ok := r.objects[key.Name]
+	if !ok {
+		return ackerr.NotFound
+	}
+	switch o := obj.(type) {
+	case *svcapitypes.DBInstance:
+		*o = *src.(*svcapitypes.DBInstance)
+	case *svcapitypes.DBCluster:
+		*o = *src.(*svcapitypes.DBCluster)
+	case *svcapitypes.DBSnapshot:
+		*o = *src.(*svcapitypes.DBSnapshot)
+	case *svcapitypes.DBClusterSnapshot:
+		*o = *src.(*svcapitypes.DBClusterSnapshot)
+	}
+	return nil
+}
+
+func syncedConditions() []*ackv1alpha1.Condition {
+	return []*ackv1alpha1.Condition{{
+		Type:   ackv1alpha1.ConditionTypeResourceSynced,
+		Status: corev1.ConditionTrue,
+	}}
+}
+
+func refTo(name string) *ackv1alpha1.AWSResourceReferenceWrapper {
+	return &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+	}
+}
+
+func TestResolveReferences_SourcesAndCluster(t *testing.T) {
+	rm := newTestResourceManager(fake.New())
+	ctx := context.Background()
+	reader := &fakeReader{objects: map[string]client.Object{
+		"cluster": &svcapitypes.DBCluster{
+			Spec:   svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("cluster-a")},
+			Status: svcapitypes.DBClusterStatus{Conditions: syncedConditions()},
+		},
+		"source": &svcapitypes.DBInstance{
+			Spec:   svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("db-a")},
+			Status: svcapitypes.DBInstanceStatus{Conditions: syncedConditions()},
+		},
+		"snapshot": &svcapitypes.DBSnapshot{
+			Spec:   svcapitypes.DBSnapshotSpec{DBSnapshotIdentifier: aws.String("snap-a")},
+			Status: svcapitypes.DBSnapshotStatus{Conditions: syncedConditions()},
+		},
+		"cluster-snapshot": &svcapitypes.DBClusterSnapshot{
+			Spec:   svcapitypes.DBClusterSnapshotSpec{DBClusterSnapshotIdentifier: aws.String("csnap-a")},
+			Status: svcapitypes.DBClusterSnapshotStatus{Conditions: syncedConditions()},
+		},
+		"pending-snapshot": &svcapitypes.DBSnapshot{
+			Spec: svcapitypes.DBSnapshotSpec{DBSnapshotIdentifier: aws.String("snap-b")},
+		},
+	}}
+	newInstance := func() *resource {
+		return &resource{ko: &svcapitypes.DBInstance{
+			Spec: svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("db-b")},
+		}}
+	}
+
+	r := newInstance()
+	r.ko.Spec.DBClusterIdentifierRef = refTo("cluster")
+	r.ko.Spec.SourceDBInstanceIdentifierRef = refTo("source")
+	r.ko.Spec.DBSnapshotIdentifierRef = refTo("snapshot")
+	r.ko.Spec.DBClusterSnapshotIdentifierRef = refTo("cluster-snapshot")
+	resolved, hasRefs, err := rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.True(t, hasRefs)
+	spec := rm.concreteResource(resolved).ko.Spec
+	assert.Equal(t, "cluster-a", aws.ToString(spec.DBClusterIdentifier))
+	assert.Equal(t, "db-a", aws.ToString(spec.SourceDBInstanceIdentifier))
+	assert.Equal(t, "snap-a", aws.ToString(spec.DBSnapshotIdentifier))
+	assert.Equal(t, "csnap-a", aws.ToString(spec.DBClusterSnapshotIdentifier))
+
+	spec = rm.concreteResource(rm.ClearResolvedReferences(resolved)).ko.Spec
+	assert.Nil(t, spec.DBClusterIdentifier)
+	assert.Nil(t, spec.SourceDBInstanceIdentifier)
+	assert.Nil(t, spec.DBSnapshotIdentifier)
+	assert.Nil(t, spec.DBClusterSnapshotIdentifier)
+
+	// A snapshot that isn't synced yet isn't resolved, so the restore waits
+	// for it.
+	r = newInstance()
+	r.ko.Spec.DBSnapshotIdentifierRef = refTo("pending-snapshot")
+	_, hasRefs, err = rm.ResolveReferences(ctx, reader, r)
+	assert.True(t, hasRefs)
+	assert.Error(t, err)
+	assert.Nil(t, r.ko.Spec.DBSnapshotIdentifier)
+
+	// A cluster that doesn't exist yet isn't resolved either.
+	r = newInstance()
+	r.ko.Spec.DBClusterIdentifierRef = refTo("missing")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+	assert.Nil(t, r.ko.Spec.DBClusterIdentifier)
+
+	// References and identifiers can't both be set.
+	r = newInstance()
+	r.ko.Spec.DBClusterIdentifier = aws.String("cluster-a")
+	r.ko.Spec.DBClusterIdentifierRef = refTo("cluster")
+	assert.Error(t, validateReferenceFields(r.ko))
+}
@@ -33,11 +33,16 @@ import (
 	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
 	ackutil "github.com/aws-controllers-k8s/runtime/pkg/util"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi"
+	svcrequeue "github.com/aws-controllers-k8s/rds-controller/pkg/requeue"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
 
 var (
@@ -77,9 +82,18 @@ type resourceManager struct {
 	awsRegion ackv1alpha1.AWSRegion
 	// The AWS Partition that this resource manager targets
 	awsPartition ackv1alpha1.AWSPartition
-	// sdk is a pointer to the AWS service API client exposed by the
-	// aws-sdk-go-v2/services/{alias} package.
-	sdkapi *svcsdk.Client
+	// sdkapi is the RDS API used to manage the resources. In production this
+	// is the client exposed by the aws-sdk-go-v2/services/{alias} package.
+	sdkapi rdsapi.API
+	// tagReconciler reconciles the tags of the resources
+	tagReconciler *util.TagReconciler
+	// requeuePolicy chooses how long to wait before checking again on the
+	// resources that cannot be modified because of their status
+	requeuePolicy *svcrequeue.Policy
+	// connectionWriter publishes the connection details of the resources
+	connectionWriter *connection.Writer
+	// masterUserSecretSyncer copies the master user secrets of the resources
+	masterUserSecretSyncer *secrets.Syncer
 }
 
 // concreteResource returns a pointer to a resource from the supplied
@@ -453,17 +467,23 @@ func newResourceManager(
 	rr acktypes.Reconciler,
 	id ackv1alpha1.AWSAccountID,
 	region ackv1alpha1.AWSRegion,
+	sdkapi rdsapi.API,
+	deps svcresource.Dependencies,
 ) (*resourceManager, error) {
 	return &resourceManager{
-		cfg:          cfg,
-		clientcfg:    clientcfg,
-		log:          log,
-		metrics:      metrics,
-		rr:           rr,
-		awsAccountID: id,
-		awsRegion:    region,
-		awsPartition: ackv1alpha1.AWSPartition(cfg.Partition),
-		sdkapi:       svcsdk.NewFromConfig(clientcfg),
+		cfg:                    cfg,
+		clientcfg:              clientcfg,
+		log:                    log,
+		metrics:                metrics,
+		rr:                     rr,
+		awsAccountID:           id,
+		awsRegion:              region,
+		awsPartition:           ackv1alpha1.AWSPartition(cfg.Partition),
+		sdkapi:                 sdkapi,
+		tagReconciler:          deps.TagReconciler,
+		requeuePolicy:          deps.RequeuePolicy,
+		connectionWriter:       deps.ConnectionWriter,
+		masterUserSecretSyncer: deps.MasterUserSecretSyncers.For(clientcfg),
 	}, nil
 }
 
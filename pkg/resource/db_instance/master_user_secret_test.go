@@ -0,0 +1,111 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	ctrlrtfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+	secretsfake "github.com/aws-controllers-k8s/rds-controller/pkg/secrets/fake"
+)
+
+func TestSyncMasterUserSecret(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	c := ctrlrtfake.NewClientBuilder().WithScheme(scheme).Build()
+	backend := secretsfake.New()
+	recorder := events.NewFakeRecorder(10)
+
+	arn := "arn:aws:secretsmanager:us-west-2:111111111111:secret:rds!db-1234-AbCdEf"
+	rm := newTestResourceManager(fake.New())
+	rm.masterUserSecretSyncer = secrets.NewSyncer(backend, connection.NewWriter(c, c, scheme), recorder)
+	ko := &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "db", UID: "db-uid"},
+		Spec: svcapitypes.DBInstanceSpec{
+			ManageMasterUserPassword: aws.Bool(true),
+			MasterUserSecretSyncName: aws.String("db-master"),
+		},
+		Status: svcapitypes.DBInstanceStatus{
+			DBInstanceStatus: aws.String("available"),
+			MasterUserSecret: &svcapitypes.MasterUserSecret{
+				SecretARN:    aws.String(arn),
+				SecretStatus: aws.String("active"),
+			},
+		},
+	}
+	key := types.NamespacedName{Namespace: "apps", Name: "db-master"}
+
+	first := backend.Put(arn, `{"username":"admin","password":"first"}`)
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	assert.Equal(t, first, aws.ToString(ko.Status.MasterUserSecretVersionID))
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "first", string(secret.Data["password"]))
+	assert.Nil(t, ackcondition.Synced(&resource{ko}))
+
+	// While RDS rotates the secret, the DB instance is checked again soon.
+	ko.Status.MasterUserSecret.SecretStatus = aws.String("rotating")
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	synced := ackcondition.Synced(&resource{ko})
+	require.NotNil(t, synced)
+	assert.Equal(t, corev1.ConditionFalse, synced.Status)
+	assert.Empty(t, recorder.Events)
+
+	// Once rotated, the new version is copied and an Event is emitted.
+	second := backend.Put(arn, `{"username":"admin","password":"second"}`)
+	ko.Status.MasterUserSecret.SecretStatus = aws.String("active")
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	assert.Equal(t, second, aws.ToString(ko.Status.MasterUserSecretVersionID))
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "second", string(secret.Data["password"]))
+	assert.Len(t, recorder.Events, 1)
+
+	// A deleted Secret is recreated.
+	require.NoError(t, c.Delete(ctx, secret))
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "second", string(secret.Data["password"]))
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, 4, backend.Calls)
+
+	// Nothing is read while the DB instance is being deleted or isn't
+	// available, or when it doesn't opt in.
+	ko.DeletionTimestamp = &metav1.Time{}
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	ko.DeletionTimestamp = nil
+	ko.Status.DBInstanceStatus = aws.String("modifying")
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	ko.Status.DBInstanceStatus = aws.String("available")
+	ko.Spec.MasterUserSecretSyncName = nil
+	require.NoError(t, rm.syncMasterUserSecret(ctx, ko))
+	assert.Equal(t, 4, backend.Calls)
+}
@@ -0,0 +1,99 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// pendingRebootDeltaPath is the delta path that customPreCompare uses to ask
+// sdkUpdate to reboot a DB instance whose parameter group changes are
+// pending a reboot.
+const pendingRebootDeltaPath = "Status.DBParameterGroups"
+
+// pendingRebootParameterGroups returns the names of the supplied DB
+// instance's parameter groups with changes that only take effect after the
+// instance is rebooted.
+func pendingRebootParameterGroups(r *resource) []string {
+	names := []string{}
+	for _, pg := range r.ko.Status.DBParameterGroups {
+		if pg == nil || pg.DBParameterGroupName == nil || pg.ParameterApplyStatus == nil {
+			continue
+		}
+		if *pg.ParameterApplyStatus == util.ApplyMethodPendingReboot {
+			names = append(names, *pg.DBParameterGroupName)
+		}
+	}
+	return names
+}
+
+// setPendingRebootCondition sets the PendingReboot advisory condition on the
+// supplied DB instance when any of its parameter groups have changes pending
+// a reboot, and removes it otherwise.
+func setPendingRebootCondition(r *resource) {
+	pending := pendingRebootParameterGroups(r)
+	if len(pending) == 0 {
+		util.SetPendingRebootCondition(r, nil)
+		return
+	}
+	msg := fmt.Sprintf(
+		"changes to DB parameter group(s) %s take effect after the DB instance is rebooted",
+		strings.Join(pending, ", "),
+	)
+	util.SetPendingRebootCondition(r, &msg)
+}
+
+// comparePendingReboot adds a difference to the delta when the desired DB
+// instance is opted into being rebooted and the latest DB instance has
+// parameter group changes pending a reboot.
+func comparePendingReboot(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	if !util.RebootOnPendingParameters(desired.ko.GetAnnotations()) {
+		return
+	}
+	if pending := pendingRebootParameterGroups(latest); len(pending) > 0 {
+		delta.Add(pendingRebootDeltaPath, nil, pending)
+	}
+}
+
+// rebootDBInstance calls the RDS RebootDBInstance API for the supplied DB
+// instance.
+func (rm *resourceManager) rebootDBInstance(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.rebootDBInstance")
+	defer func() { exit(err) }()
+
+	_, err = rm.sdkapi.RebootDBInstance(
+		ctx,
+		&svcsdk.RebootDBInstanceInput{
+			DBInstanceIdentifier: r.ko.Spec.DBInstanceIdentifier,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "RebootDBInstance", err)
+	return err
+}
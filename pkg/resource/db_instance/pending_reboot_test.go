@@ -0,0 +1,161 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// newPendingRebootResource returns an available DB instance using the
+// "pg" parameter group with the supplied parameter apply status.
+func newPendingRebootResource(
+	applyStatus string,
+	annotations map[string]string,
+) *resource {
+	return &resource{
+		ko: &svcapitypes.DBInstance{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec: svcapitypes.DBInstanceSpec{
+				DBInstanceIdentifier: aws.String("db"),
+			},
+			Status: svcapitypes.DBInstanceStatus{
+				DBInstanceStatus: aws.String(StatusAvailable),
+				DBParameterGroups: []*svcapitypes.DBParameterGroupStatus_SDK{{
+					DBParameterGroupName: aws.String("pg"),
+					ParameterApplyStatus: aws.String(applyStatus),
+				}},
+			},
+		},
+	}
+}
+
+func TestSetPendingRebootCondition(t *testing.T) {
+	r := newPendingRebootResource(util.ApplyMethodPendingReboot, nil)
+
+	setPendingRebootCondition(r)
+	cond := ackcondition.AdvisoryWithReason(r, util.ConditionReasonPendingReboot)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Contains(t, *cond.Message, "pg")
+
+	// Setting the condition again must not add a second one.
+	setPendingRebootCondition(r)
+	assert.Len(t, ackcondition.AllOfType(r, ackv1alpha1.ConditionTypeAdvisory), 1)
+
+	r.ko.Status.DBParameterGroups[0].ParameterApplyStatus = aws.String("in-sync")
+	setPendingRebootCondition(r)
+	assert.Nil(t, ackcondition.AdvisoryWithReason(r, util.ConditionReasonPendingReboot))
+}
+
+func TestComparePendingReboot(t *testing.T) {
+	optIn := map[string]string{svcapitypes.RebootOnPendingParametersAnnotation: "true"}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		applyStatus string
+		wantDiff    bool
+	}{
+		{
+			name:        "pending reboot without opt-in",
+			applyStatus: util.ApplyMethodPendingReboot,
+		},
+		{
+			name:        "opted in but in sync",
+			annotations: optIn,
+			applyStatus: "in-sync",
+		},
+		{
+			name:        "opted in and pending reboot",
+			annotations: optIn,
+			applyStatus: util.ApplyMethodPendingReboot,
+			wantDiff:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			comparePendingReboot(
+				delta,
+				newPendingRebootResource("in-sync", tt.annotations),
+				newPendingRebootResource(tt.applyStatus, nil),
+			)
+			assert.Equal(t, tt.wantDiff, delta.DifferentAt(pendingRebootDeltaPath))
+		})
+	}
+}
+
+func TestSdkUpdate_RebootsOnlyWhenNothingElseChanged(t *testing.T) {
+	optIn := map[string]string{svcapitypes.RebootOnPendingParametersAnnotation: "true"}
+	tests := []struct {
+		name       string
+		extraDiff  string
+		wantReboot bool
+	}{
+		{
+			name:       "pending reboot is the only difference",
+			wantReboot: true,
+		},
+		{
+			name:      "other modifications are made first",
+			extraDiff: "Spec.DBInstanceClass",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceStatus:     aws.String(fake.StatusAvailable),
+				DBParameterGroups: []svcsdktypes.DBParameterGroupStatus{{
+					DBParameterGroupName: aws.String("pg"),
+					ParameterApplyStatus: aws.String(fake.ParameterApplyStatusPendingReboot),
+				}},
+			}
+			rm := newTestResourceManager(api)
+			desired := newPendingRebootResource("in-sync", optIn)
+			desired.ko.Spec.DBInstanceClass = aws.String("db.t3.large")
+			latest := newPendingRebootResource(util.ApplyMethodPendingReboot, nil)
+			delta := ackcompare.NewDelta()
+			comparePendingReboot(delta, desired, latest)
+			if tt.extraDiff != "" {
+				delta.Add(tt.extraDiff, nil, nil)
+			}
+
+			_, err := rm.sdkUpdate(context.Background(), desired, latest, delta)
+			require.NoError(t, err)
+
+			if tt.wantReboot {
+				assert.Equal(t, []string{"RebootDBInstance"}, api.Operations())
+				assert.Equal(t, fake.StatusRebooting, *api.DBInstances["db"].DBInstanceStatus)
+			} else {
+				assert.Equal(t, []string{"ModifyDBInstance"}, api.Operations())
+			}
+		})
+	}
+}
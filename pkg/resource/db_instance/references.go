@@ -56,10 +56,22 @@ import (
 func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
 	ko := rm.concreteResource(res).ko.DeepCopy()
 
+	if ko.Spec.DBClusterIdentifierRef != nil {
+		ko.Spec.DBClusterIdentifier = nil
+	}
+
+	if ko.Spec.DBClusterSnapshotIdentifierRef != nil {
+		ko.Spec.DBClusterSnapshotIdentifier = nil
+	}
+
 	if ko.Spec.DBParameterGroupRef != nil {
 		ko.Spec.DBParameterGroupName = nil
 	}
 
+	if ko.Spec.DBSnapshotIdentifierRef != nil {
+		ko.Spec.DBSnapshotIdentifier = nil
+	}
+
 	if ko.Spec.DBSubnetGroupRef != nil {
 		ko.Spec.DBSubnetGroupName = nil
 	}
@@ -76,10 +88,24 @@ func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) ack
 		ko.Spec.MonitoringRoleARN = nil
 	}
 
+	if ko.Spec.OptionGroupRef != nil {
+		ko.Spec.OptionGroupName = nil
+	}
+
 	if ko.Spec.PerformanceInsightsKMSKeyRef != nil {
 		ko.Spec.PerformanceInsightsKMSKeyID = nil
 	}
 
+	for f0idx, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil {
+			ko.Spec.RoleAssociations[f0idx].RoleARN = nil
+		}
+	}
+
+	if ko.Spec.SourceDBInstanceIdentifierRef != nil {
+		ko.Spec.SourceDBInstanceIdentifier = nil
+	}
+
 	if len(ko.Spec.VPCSecurityGroupRefs) > 0 {
 		ko.Spec.VPCSecurityGroupIDs = nil
 	}
@@ -103,12 +129,30 @@ func (rm *resourceManager) ResolveReferences(
 
 	resourceHasReferences := false
 	err := validateReferenceFields(ko)
+	if fieldHasReferences, err := rm.resolveReferenceForDBClusterIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForDBClusterSnapshotIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForDBParameterGroupName(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForDBSnapshotIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForDBSubnetGroupName(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -133,12 +177,30 @@ func (rm *resourceManager) ResolveReferences(
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForOptionGroupName(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForPerformanceInsightsKMSKeyID(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
 		resourceHasReferences = resourceHasReferences || fieldHasReferences
 	}
 
+	if fieldHasReferences, err := rm.resolveReferenceForRoleAssociations_RoleARN(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
+	if fieldHasReferences, err := rm.resolveReferenceForSourceDBInstanceIdentifier(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+
 	if fieldHasReferences, err := rm.resolveReferenceForVPCSecurityGroupIDs(ctx, apiReader, ko); err != nil {
 		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
 	} else {
@@ -152,10 +214,22 @@ func (rm *resourceManager) ResolveReferences(
 // identifier field.
 func validateReferenceFields(ko *svcapitypes.DBInstance) error {
 
+	if ko.Spec.DBClusterIdentifierRef != nil && ko.Spec.DBClusterIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBClusterIdentifier", "DBClusterIdentifierRef")
+	}
+
+	if ko.Spec.DBClusterSnapshotIdentifierRef != nil && ko.Spec.DBClusterSnapshotIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBClusterSnapshotIdentifier", "DBClusterSnapshotIdentifierRef")
+	}
+
 	if ko.Spec.DBParameterGroupRef != nil && ko.Spec.DBParameterGroupName != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBParameterGroupName", "DBParameterGroupRef")
 	}
 
+	if ko.Spec.DBSnapshotIdentifierRef != nil && ko.Spec.DBSnapshotIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBSnapshotIdentifier", "DBSnapshotIdentifierRef")
+	}
+
 	if ko.Spec.DBSubnetGroupRef != nil && ko.Spec.DBSubnetGroupName != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("DBSubnetGroupName", "DBSubnetGroupRef")
 	}
@@ -172,16 +246,212 @@ func validateReferenceFields(ko *svcapitypes.DBInstance) error {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("MonitoringRoleARN", "MonitoringRoleRef")
 	}
 
+	if ko.Spec.OptionGroupRef != nil && ko.Spec.OptionGroupName != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("OptionGroupName", "OptionGroupRef")
+	}
+
 	if ko.Spec.PerformanceInsightsKMSKeyRef != nil && ko.Spec.PerformanceInsightsKMSKeyID != nil {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("PerformanceInsightsKMSKeyID", "PerformanceInsightsKMSKeyRef")
 	}
 
+	for _, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil && f0iter.RoleARN != nil {
+			return ackerr.ResourceReferenceAndIDNotSupportedFor("RoleAssociations.RoleARN", "RoleAssociations.RoleRef")
+		}
+	}
+
+	if ko.Spec.SourceDBInstanceIdentifierRef != nil && ko.Spec.SourceDBInstanceIdentifier != nil {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("SourceDBInstanceIdentifier", "SourceDBInstanceIdentifierRef")
+	}
+
 	if len(ko.Spec.VPCSecurityGroupRefs) > 0 && len(ko.Spec.VPCSecurityGroupIDs) > 0 {
 		return ackerr.ResourceReferenceAndIDNotSupportedFor("VPCSecurityGroupIDs", "VPCSecurityGroupRefs")
 	}
 	return nil
 }
 
+// resolveReferenceForDBClusterIdentifier reads the resource referenced
+// from DBClusterIdentifierRef field and sets the DBClusterIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForDBClusterIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	if ko.Spec.DBClusterIdentifierRef != nil && ko.Spec.DBClusterIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.DBClusterIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: DBClusterIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBCluster{}
+		if err := getReferencedResourceState_DBCluster(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.DBClusterIdentifier = (*string)(obj.Spec.DBClusterIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBCluster looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBCluster(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBCluster,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBCluster",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBCluster",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBCluster",
+			namespace, name)
+	}
+	if obj.Spec.DBClusterIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBCluster",
+			namespace, name,
+			"Spec.DBClusterIdentifier")
+	}
+	return nil
+}
+
+// resolveReferenceForDBClusterSnapshotIdentifier reads the resource referenced
+// from DBClusterSnapshotIdentifierRef field and sets the DBClusterSnapshotIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForDBClusterSnapshotIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	if ko.Spec.DBClusterSnapshotIdentifierRef != nil && ko.Spec.DBClusterSnapshotIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.DBClusterSnapshotIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: DBClusterSnapshotIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBClusterSnapshot{}
+		if err := getReferencedResourceState_DBClusterSnapshot(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.DBClusterSnapshotIdentifier = (*string)(obj.Spec.DBClusterSnapshotIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBClusterSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBClusterSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBClusterSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBClusterSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBClusterSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBClusterSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.DBClusterSnapshotIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBClusterSnapshot",
+			namespace, name,
+			"Spec.DBClusterSnapshotIdentifier")
+	}
+	return nil
+}
+
 // resolveReferenceForDBParameterGroupName reads the resource referenced
 // from DBParameterGroupRef field and sets the DBParameterGroupName
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -273,6 +543,97 @@ func getReferencedResourceState_DBParameterGroup(
 	return nil
 }
 
+// resolveReferenceForDBSnapshotIdentifier reads the resource referenced
+// from DBSnapshotIdentifierRef field and sets the DBSnapshotIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForDBSnapshotIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	if ko.Spec.DBSnapshotIdentifierRef != nil && ko.Spec.DBSnapshotIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.DBSnapshotIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: DBSnapshotIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBSnapshot{}
+		if err := getReferencedResourceState_DBSnapshot(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.DBSnapshotIdentifier = (*string)(obj.Spec.DBSnapshotIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.DBSnapshotIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBSnapshot",
+			namespace, name,
+			"Spec.DBSnapshotIdentifier")
+	}
+	return nil
+}
+
 // resolveReferenceForDBSubnetGroupName reads the resource referenced
 // from DBSubnetGroupRef field and sets the DBSubnetGroupName
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -583,6 +944,97 @@ func getReferencedResourceState_Role(
 	return nil
 }
 
+// resolveReferenceForOptionGroupName reads the resource referenced
+// from OptionGroupRef field and sets the OptionGroupName
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForOptionGroupName(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	if ko.Spec.OptionGroupRef != nil && ko.Spec.OptionGroupRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.OptionGroupRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: OptionGroupRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.OptionGroup{}
+		if err := getReferencedResourceState_OptionGroup(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.OptionGroupName = (*string)(obj.Spec.Name)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_OptionGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_OptionGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.OptionGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"OptionGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"OptionGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"OptionGroup",
+			namespace, name)
+	}
+	if obj.Spec.Name == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"OptionGroup",
+			namespace, name,
+			"Spec.Name")
+	}
+	return nil
+}
+
 // resolveReferenceForPerformanceInsightsKMSKeyID reads the resource referenced
 // from PerformanceInsightsKMSKeyRef field and sets the PerformanceInsightsKMSKeyID
 // from referenced resource. Returns a boolean indicating whether a reference
@@ -620,6 +1072,136 @@ func (rm *resourceManager) resolveReferenceForPerformanceInsightsKMSKeyID(
 	return hasReferences, nil
 }
 
+// resolveReferenceForRoleAssociations_RoleARN reads the resource referenced
+// from RoleAssociations.RoleRef field and sets the RoleAssociations.RoleARN
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForRoleAssociations_RoleARN(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	for f0idx, f0iter := range ko.Spec.RoleAssociations {
+		if f0iter.RoleRef != nil && f0iter.RoleRef.From != nil {
+			hasReferences = true
+			arr := f0iter.RoleRef.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: RoleAssociations.RoleRef")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			obj := &iamapitypes.Role{}
+			if err := getReferencedResourceState_Role(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+				return hasReferences, err
+			}
+			ko.Spec.RoleAssociations[f0idx].RoleARN = (*string)(obj.Status.ACKResourceMetadata.ARN)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// resolveReferenceForSourceDBInstanceIdentifier reads the resource referenced
+// from SourceDBInstanceIdentifierRef field and sets the SourceDBInstanceIdentifier
+// from referenced resource. Returns a boolean indicating whether a reference
+// contains references, or an error
+func (rm *resourceManager) resolveReferenceForSourceDBInstanceIdentifier(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.DBInstance,
+) (hasReferences bool, err error) {
+	if ko.Spec.SourceDBInstanceIdentifierRef != nil && ko.Spec.SourceDBInstanceIdentifierRef.From != nil {
+		hasReferences = true
+		arr := ko.Spec.SourceDBInstanceIdentifierRef.From
+		if arr.Name == nil || *arr.Name == "" {
+			return hasReferences, fmt.Errorf("provided resource reference is nil or empty: SourceDBInstanceIdentifierRef")
+		}
+		namespace, err := ackrt.ResolveCrossNamespaceReference(
+			ctx,
+			rm.cfg.EnableCrossNamespace,
+			&ko.Status.Conditions,
+			ackrt.CrossNamespaceRefKindResource,
+			ko.ObjectMeta.GetNamespace(),
+			arr.Namespace,
+			*arr.Name,
+		)
+		if err != nil {
+			return hasReferences, err
+		}
+		obj := &svcapitypes.DBInstance{}
+		if err := getReferencedResourceState_DBInstance(ctx, apiReader, obj, *arr.Name, namespace); err != nil {
+			return hasReferences, err
+		}
+		ko.Spec.SourceDBInstanceIdentifier = (*string)(obj.Spec.DBInstanceIdentifier)
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedResourceState_DBInstance looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBInstance(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBInstance,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBInstance",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBInstance",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBInstance",
+			namespace, name)
+	}
+	if obj.Spec.DBInstanceIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBInstance",
+			namespace, name,
+			"Spec.DBInstanceIdentifier")
+	}
+	return nil
+}
+
 // resolveReferenceForVPCSecurityGroupIDs reads the resource referenced
 // from VPCSecurityGroupRefs field and sets the VPCSecurityGroupIDs
 // from referenced resource. Returns a boolean indicating whether a reference
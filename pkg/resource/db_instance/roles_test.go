@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_instance
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+const (
+	testS3ImportRoleARN = "arn:aws:iam::123456789012:role/s3-import"
+	testLambdaRoleARN   = "arn:aws:iam::123456789012:role/lambda"
+)
+
+func roleResource(
+	desired []*svcapitypes.AddRoleToDBInstanceInput,
+	associated []*svcapitypes.DBInstanceRole,
+) *resource {
+	return &resource{ko: &svcapitypes.DBInstance{
+		Spec: svcapitypes.DBInstanceSpec{
+			DBInstanceIdentifier: aws.String("db"),
+			DBInstanceClass:      aws.String("db.t3.micro"),
+			RoleAssociations:     desired,
+		},
+		Status: svcapitypes.DBInstanceStatus{
+			DBInstanceStatus: aws.String(fake.StatusAvailable),
+			AssociatedRoles:  associated,
+		},
+	}}
+}
+
+func TestCompareRoleAssociations(t *testing.T) {
+	tests := []struct {
+		name       string
+		desired    []*svcapitypes.AddRoleToDBInstanceInput
+		associated []*svcapitypes.DBInstanceRole
+		wantDiff   bool
+	}{
+		{
+			name: "not managed",
+			associated: []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), Status: aws.String("ACTIVE")},
+			},
+		},
+		{
+			name:    "empty list removes all roles",
+			desired: []*svcapitypes.AddRoleToDBInstanceInput{},
+			associated: []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), Status: aws.String("ACTIVE")},
+			},
+			wantDiff: true,
+		},
+		{
+			name: "same roles in another order",
+			desired: []*svcapitypes.AddRoleToDBInstanceInput{
+				{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda")},
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import")},
+			},
+			associated: []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import"), Status: aws.String("ACTIVE")},
+				{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("PENDING")},
+			},
+		},
+		{
+			name: "feature name differs",
+			desired: []*svcapitypes.AddRoleToDBInstanceInput{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Export")},
+			},
+			associated: []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import"), Status: aws.String("ACTIVE")},
+			},
+			wantDiff: true,
+		},
+		{
+			name: "role without feature name",
+			desired: []*svcapitypes.AddRoleToDBInstanceInput{
+				{RoleARN: aws.String(testS3ImportRoleARN)},
+			},
+			associated: []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String(""), Status: aws.String("ACTIVE")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			compareRoleAssociations(delta, roleResource(tt.desired, nil), roleResource(nil, tt.associated))
+			assert.Equal(t, tt.wantDiff, delta.DifferentAt(roleAssociationsDeltaPath))
+		})
+	}
+}
+
+func TestSdkUpdate_RoleAssociations(t *testing.T) {
+	tests := []struct {
+		name        string
+		modifyClass bool
+		wantOps     []string
+	}{
+		{
+			name:    "only role associations differ",
+			wantOps: []string{"RemoveRoleFromDBInstance", "AddRoleToDBInstance"},
+		},
+		{
+			name:        "DB instance is modified after the roles are synced",
+			modifyClass: true,
+			wantOps:     []string{"RemoveRoleFromDBInstance", "AddRoleToDBInstance", "ModifyDBInstance"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.New()
+			api.DBInstances["db"] = &svcsdktypes.DBInstance{
+				DBInstanceIdentifier: aws.String("db"),
+				DBInstanceStatus:     aws.String(fake.StatusAvailable),
+				AssociatedRoles: []svcsdktypes.DBInstanceRole{
+					{RoleArn: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("ACTIVE")},
+				},
+			}
+			rm := newTestResourceManager(api)
+			desired := roleResource([]*svcapitypes.AddRoleToDBInstanceInput{
+				{RoleARN: aws.String(testS3ImportRoleARN), FeatureName: aws.String("s3Import")},
+			}, nil)
+			latest := roleResource(nil, []*svcapitypes.DBInstanceRole{
+				{RoleARN: aws.String(testLambdaRoleARN), FeatureName: aws.String("Lambda"), Status: aws.String("ACTIVE")},
+			})
+			delta := ackcompare.NewDelta()
+			if tt.modifyClass {
+				desired.ko.Spec.DBInstanceClass = aws.String("db.t3.large")
+				delta.Add("Spec.DBInstanceClass", desired.ko.Spec.DBInstanceClass, latest.ko.Spec.DBInstanceClass)
+			}
+			compareRoleAssociations(delta, desired, latest)
+
+			_, err := rm.sdkUpdate(context.Background(), desired, latest, delta)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOps, api.Operations())
+
+			remove := api.CallsTo("RemoveRoleFromDBInstance")[0].(*svcsdk.RemoveRoleFromDBInstanceInput)
+			assert.Equal(t, testLambdaRoleARN, *remove.RoleArn)
+			assert.Equal(t, "Lambda", *remove.FeatureName)
+			add := api.CallsTo("AddRoleToDBInstance")[0].(*svcsdk.AddRoleToDBInstanceInput)
+			assert.Equal(t, "db", *add.DBInstanceIdentifier)
+			assert.Equal(t, testS3ImportRoleARN, *add.RoleArn)
+			assert.Equal(t, "s3Import", *add.FeatureName)
+
+			roles := api.DBInstances["db"].AssociatedRoles
+			require.Len(t, roles, 1)
+			assert.Equal(t, testS3ImportRoleARN, *roles[0].RoleArn)
+		})
+	}
+}
@@ -744,6 +744,22 @@ func (rm *resourceManager) sdkFind(
 		} else {
 			ko.Spec.StorageType = nil
 		}
+		if elem.TagList != nil {
+			f77 := []*svcapitypes.Tag{}
+			for _, f77iter := range elem.TagList {
+				f77elem := &svcapitypes.Tag{}
+				if f77iter.Key != nil {
+					f77elem.Key = f77iter.Key
+				}
+				if f77iter.Value != nil {
+					f77elem.Value = f77iter.Value
+				}
+				f77 = append(f77, f77elem)
+			}
+			ko.Spec.Tags = f77
+		} else {
+			ko.Spec.Tags = nil
+		}
 		if elem.TdeCredentialArn != nil {
 			ko.Spec.TDECredentialARN = elem.TdeCredentialArn
 		} else {
@@ -862,19 +878,16 @@ func (rm *resourceManager) sdkFind(
 			ko.Spec.StorageType = pmv.StorageType
 		}
 	}
-	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
-		if err != nil {
-			return nil, err
-		}
-		ko.Spec.Tags = tags
-	}
-	if !instanceAvailable(&resource{ko}) {
+	if !instanceAvailable(&resource{ko}) && !stoppedAsDesired(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	}
+	setPendingRebootCondition(&resource{ko})
+	if err := rm.syncMasterUserSecret(ctx, ko); err != nil {
+		return nil, err
+	}
+	rm.syncConnectionDetails(ctx, ko)
 	if len(r.ko.Spec.VPCSecurityGroupIDs) > 0 {
 		// If the desired resource has security groups specified then update the spec of the latest resource with the
 		// security groups from the status. This is done so that when an instance is created without security groups
@@ -939,11 +952,20 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	initActionGenerations(desired)
+	if err := validateRestoreSource(desired); err != nil {
+		return nil, err
+	}
 	// if request has DBSnapshotIdentifier spec, create request will call RestoreDBInstanceFromDBSnapshotWithContext
 	// instead of normal create api
 	if desired.ko.Spec.DBSnapshotIdentifier != nil {
 		return rm.restoreDbInstanceFromDbSnapshot(ctx, desired)
 	}
+	// if request has RestoreTime or UseLatestRestorableTime spec, create request will call RestoreDBInstanceToPointInTime
+	// instead of normal create api
+	if restoringToPointInTime(desired) {
+		return rm.restoreDbInstanceToPointInTime(ctx, desired)
+	}
 	// if request has SourceDBInstanceIdentifier spec, create request will call CreateDBInstanceReadReplicaWithContext
 	// instead of normal create api
 	if desired.ko.Spec.SourceDBInstanceIdentifier != nil {
@@ -1937,7 +1959,7 @@ func (rm *resourceManager) sdkUpdate(
 	if instanceCreating(latest) {
 		msg := "DB instance is currently being created"
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
-		return &resource{res}, requeueWaitUntilCanModify(latest)
+		return &resource{res}, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if instanceHasTerminalStatus(latest) {
 		msg := "DB instance is in '" + *latest.ko.Status.DBInstanceStatus + "' status"
@@ -1945,16 +1967,89 @@ func (rm *resourceManager) sdkUpdate(
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionTrue, nil, nil)
 		return &resource{res}, nil
 	}
-	if !instanceAvailable(latest) && !needStorageUpdate(latest, delta) {
+	if delta.DifferentAt(activationStateDeltaPath) && desired.ko.Spec.DBClusterIdentifier != nil {
+		return nil, errStopClusterMember
+	}
+	if instanceStopped(latest) {
+		if delta.DifferentAt(activationStateDeltaPath) {
+			if err = rm.startDBInstance(ctx, latest); err != nil {
+				return nil, err
+			}
+			msg := "DB instance is being started"
+			ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+			return &resource{res}, nil
+		}
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		if !delta.DifferentExcept("Spec.Tags") {
+			return &resource{res}, nil
+		}
+		msg := msgCannotModifyWhileStopped
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, requeueWaitWhileStopped
+	}
+	// A storage-full DB instance can have its storage modified, but a
+	// requested reboot waits for it to be available again.
+	if !instanceAvailable(latest) &&
+		(!needStorageUpdate(latest, delta) || delta.DifferentAt(restartGenerationDeltaPath)) {
 		msg := "DB instance cannot be modifed while in '" + *latest.ko.Status.DBInstanceStatus + "' status"
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
-		return &resource{res}, requeueWaitUntilCanModify(latest)
+		return &resource{res}, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	if delta.DifferentAt(roleAssociationsDeltaPath) {
+		// Roles are associated and disassociated with their own API calls,
+		// so there is nothing left to modify when only they and the tags
+		// differ.
+		if err = rm.syncRoleAssociations(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		if !delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath) {
+			return &resource{res}, nil
+		}
+	}
+	if delta.DifferentAt(activationStateDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, activationStateDeltaPath, pendingRebootDeltaPath) {
+		// A stopped DB instance can't be modified, so it is only stopped
+		// once there is nothing else to change. Parameter changes pending a
+		// reboot take effect when it is started again.
+		if err = rm.stopDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		msg := "DB instance is being stopped"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
+	if delta.DifferentAt(restartGenerationDeltaPath) {
+		// A requested reboot runs on its own, ahead of any other change, so
+		// that each restart generation results in one RebootDBInstance call.
+		if err = rm.rebootDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		res.Status.LastRestartGeneration = desired.ko.Spec.RestartGeneration
+		msg := restartingMessage(desired.ko.Spec.RestartGeneration)
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
+	if delta.DifferentAt(pendingRebootDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, pendingRebootDeltaPath) {
+		// Only reboot once there is nothing else to modify. Otherwise the
+		// ModifyDBInstance call below would fail because the instance is
+		// rebooting, and the changes it makes may need a reboot themselves.
+		if err = rm.rebootDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		msg := "DB instance is rebooting to apply pending parameter group changes"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
 
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
@@ -3975,6 +4070,872 @@ func (rm *resourceManager) setResourceFromRestoreDBInstanceFromDBSnapshotOutput(
 
 }
 
+// newRestoreDBInstanceToPointInTimeInput returns a RestoreDBInstanceToPointInTimeInput object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newRestoreDBInstanceToPointInTimeInput(
+	r *resource,
+) (*svcsdk.RestoreDBInstanceToPointInTimeInput, error) {
+	res := &svcsdk.RestoreDBInstanceToPointInTimeInput{}
+
+	if r.ko.Spec.AllocatedStorage != nil {
+		allocatedStorageCopy0 := *r.ko.Spec.AllocatedStorage
+		if allocatedStorageCopy0 > math.MaxInt32 || allocatedStorageCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field AllocatedStorage is of type int32")
+		}
+		allocatedStorageCopy := int32(allocatedStorageCopy0)
+		res.AllocatedStorage = &allocatedStorageCopy
+	}
+	if r.ko.Spec.AutoMinorVersionUpgrade != nil {
+		res.AutoMinorVersionUpgrade = r.ko.Spec.AutoMinorVersionUpgrade
+	}
+	if r.ko.Spec.AvailabilityZone != nil {
+		res.AvailabilityZone = r.ko.Spec.AvailabilityZone
+	}
+	if r.ko.Spec.BackupTarget != nil {
+		res.BackupTarget = r.ko.Spec.BackupTarget
+	}
+	if r.ko.Spec.CACertificateIdentifier != nil {
+		res.CACertificateIdentifier = r.ko.Spec.CACertificateIdentifier
+	}
+	if r.ko.Spec.CopyTagsToSnapshot != nil {
+		res.CopyTagsToSnapshot = r.ko.Spec.CopyTagsToSnapshot
+	}
+	if r.ko.Spec.CustomIAMInstanceProfile != nil {
+		res.CustomIamInstanceProfile = r.ko.Spec.CustomIAMInstanceProfile
+	}
+	if r.ko.Spec.DBInstanceClass != nil {
+		res.DBInstanceClass = r.ko.Spec.DBInstanceClass
+	}
+	if r.ko.Spec.DBName != nil {
+		res.DBName = r.ko.Spec.DBName
+	}
+	if r.ko.Spec.DBParameterGroupName != nil {
+		res.DBParameterGroupName = r.ko.Spec.DBParameterGroupName
+	}
+	if r.ko.Spec.DBSubnetGroupName != nil {
+		res.DBSubnetGroupName = r.ko.Spec.DBSubnetGroupName
+	}
+	if r.ko.Spec.DeletionProtection != nil {
+		res.DeletionProtection = r.ko.Spec.DeletionProtection
+	}
+	if r.ko.Spec.Domain != nil {
+		res.Domain = r.ko.Spec.Domain
+	}
+	if r.ko.Spec.DomainIAMRoleName != nil {
+		res.DomainIAMRoleName = r.ko.Spec.DomainIAMRoleName
+	}
+	if r.ko.Spec.EnableCloudwatchLogsExports != nil {
+		res.EnableCloudwatchLogsExports = aws.ToStringSlice(r.ko.Spec.EnableCloudwatchLogsExports)
+	}
+	if r.ko.Spec.EnableCustomerOwnedIP != nil {
+		res.EnableCustomerOwnedIp = r.ko.Spec.EnableCustomerOwnedIP
+	}
+	if r.ko.Spec.EnableIAMDatabaseAuthentication != nil {
+		res.EnableIAMDatabaseAuthentication = r.ko.Spec.EnableIAMDatabaseAuthentication
+	}
+	if r.ko.Spec.Engine != nil {
+		res.Engine = r.ko.Spec.Engine
+	}
+	if r.ko.Spec.IOPS != nil {
+		iopsCopy0 := *r.ko.Spec.IOPS
+		if iopsCopy0 > math.MaxInt32 || iopsCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field Iops is of type int32")
+		}
+		iopsCopy := int32(iopsCopy0)
+		res.Iops = &iopsCopy
+	}
+	if r.ko.Spec.LicenseModel != nil {
+		res.LicenseModel = r.ko.Spec.LicenseModel
+	}
+	if r.ko.Spec.MaxAllocatedStorage != nil {
+		maxAllocatedStorageCopy0 := *r.ko.Spec.MaxAllocatedStorage
+		if maxAllocatedStorageCopy0 > math.MaxInt32 || maxAllocatedStorageCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field MaxAllocatedStorage is of type int32")
+		}
+		maxAllocatedStorageCopy := int32(maxAllocatedStorageCopy0)
+		res.MaxAllocatedStorage = &maxAllocatedStorageCopy
+	}
+	if r.ko.Spec.MultiAZ != nil {
+		res.MultiAZ = r.ko.Spec.MultiAZ
+	}
+	if r.ko.Spec.NetworkType != nil {
+		res.NetworkType = r.ko.Spec.NetworkType
+	}
+	if r.ko.Spec.OptionGroupName != nil {
+		res.OptionGroupName = r.ko.Spec.OptionGroupName
+	}
+	if r.ko.Spec.Port != nil {
+		portCopy0 := *r.ko.Spec.Port
+		if portCopy0 > math.MaxInt32 || portCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field Port is of type int32")
+		}
+		portCopy := int32(portCopy0)
+		res.Port = &portCopy
+	}
+	if r.ko.Spec.ProcessorFeatures != nil {
+		resf31 := []svcsdktypes.ProcessorFeature{}
+		for _, resf31iter := range r.ko.Spec.ProcessorFeatures {
+			resf31elem := &svcsdktypes.ProcessorFeature{}
+			if resf31iter.Name != nil {
+				resf31elem.Name = resf31iter.Name
+			}
+			if resf31iter.Value != nil {
+				resf31elem.Value = resf31iter.Value
+			}
+			resf31 = append(resf31, *resf31elem)
+		}
+		res.ProcessorFeatures = resf31
+	}
+	if r.ko.Spec.PubliclyAccessible != nil {
+		res.PubliclyAccessible = r.ko.Spec.PubliclyAccessible
+	}
+	if r.ko.Spec.RestoreTime != nil {
+		res.RestoreTime = &r.ko.Spec.RestoreTime.Time
+	}
+	if r.ko.Spec.SourceDBInstanceAutomatedBackupsARN != nil {
+		res.SourceDBInstanceAutomatedBackupsArn = r.ko.Spec.SourceDBInstanceAutomatedBackupsARN
+	}
+	if r.ko.Spec.SourceDBInstanceIdentifier != nil {
+		res.SourceDBInstanceIdentifier = r.ko.Spec.SourceDBInstanceIdentifier
+	}
+	if r.ko.Spec.SourceDBIResourceID != nil {
+		res.SourceDbiResourceId = r.ko.Spec.SourceDBIResourceID
+	}
+	if r.ko.Spec.StorageThroughput != nil {
+		storageThroughputCopy0 := *r.ko.Spec.StorageThroughput
+		if storageThroughputCopy0 > math.MaxInt32 || storageThroughputCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field StorageThroughput is of type int32")
+		}
+		storageThroughputCopy := int32(storageThroughputCopy0)
+		res.StorageThroughput = &storageThroughputCopy
+	}
+	if r.ko.Spec.StorageType != nil {
+		res.StorageType = r.ko.Spec.StorageType
+	}
+	if r.ko.Spec.Tags != nil {
+		resf39 := []svcsdktypes.Tag{}
+		for _, resf39iter := range r.ko.Spec.Tags {
+			resf39elem := &svcsdktypes.Tag{}
+			if resf39iter.Key != nil {
+				resf39elem.Key = resf39iter.Key
+			}
+			if resf39iter.Value != nil {
+				resf39elem.Value = resf39iter.Value
+			}
+			resf39 = append(resf39, *resf39elem)
+		}
+		res.Tags = resf39
+	}
+	if r.ko.Spec.DBInstanceIdentifier != nil {
+		res.TargetDBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
+	}
+	if r.ko.Spec.TDECredentialARN != nil {
+		res.TdeCredentialArn = r.ko.Spec.TDECredentialARN
+	}
+	if r.ko.Spec.TDECredentialPassword != nil {
+		res.TdeCredentialPassword = r.ko.Spec.TDECredentialPassword
+	}
+	if r.ko.Spec.UseDefaultProcessorFeatures != nil {
+		res.UseDefaultProcessorFeatures = r.ko.Spec.UseDefaultProcessorFeatures
+	}
+	if r.ko.Spec.UseLatestRestorableTime != nil {
+		res.UseLatestRestorableTime = r.ko.Spec.UseLatestRestorableTime
+	}
+	if r.ko.Spec.VPCSecurityGroupIDs != nil {
+		res.VpcSecurityGroupIds = aws.ToStringSlice(r.ko.Spec.VPCSecurityGroupIDs)
+	}
+
+	return res, nil
+}
+
+// setResourceFromRestoreDBInstanceToPointInTimeOutput sets a resource RestoreDBInstanceToPointInTimeOutput type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromRestoreDBInstanceToPointInTimeOutput(
+	r *resource,
+	resp *svcsdk.RestoreDBInstanceToPointInTimeOutput,
+) {
+
+	if resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded != nil {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = resp.DBInstance.ActivityStreamEngineNativeAuditFieldsIncluded
+	} else {
+		r.ko.Status.ActivityStreamEngineNativeAuditFieldsIncluded = nil
+	}
+	if resp.DBInstance.ActivityStreamKinesisStreamName != nil {
+		r.ko.Status.ActivityStreamKinesisStreamName = resp.DBInstance.ActivityStreamKinesisStreamName
+	} else {
+		r.ko.Status.ActivityStreamKinesisStreamName = nil
+	}
+	if resp.DBInstance.ActivityStreamKmsKeyId != nil {
+		r.ko.Status.ActivityStreamKMSKeyID = resp.DBInstance.ActivityStreamKmsKeyId
+	} else {
+		r.ko.Status.ActivityStreamKMSKeyID = nil
+	}
+	if resp.DBInstance.ActivityStreamMode != "" {
+		r.ko.Status.ActivityStreamMode = aws.String(string(resp.DBInstance.ActivityStreamMode))
+	} else {
+		r.ko.Status.ActivityStreamMode = nil
+	}
+	if resp.DBInstance.ActivityStreamPolicyStatus != "" {
+		r.ko.Status.ActivityStreamPolicyStatus = aws.String(string(resp.DBInstance.ActivityStreamPolicyStatus))
+	} else {
+		r.ko.Status.ActivityStreamPolicyStatus = nil
+	}
+	if resp.DBInstance.ActivityStreamStatus != "" {
+		r.ko.Status.ActivityStreamStatus = aws.String(string(resp.DBInstance.ActivityStreamStatus))
+	} else {
+		r.ko.Status.ActivityStreamStatus = nil
+	}
+	if resp.DBInstance.AllocatedStorage != nil {
+		allocatedStorageCopy := int64(*resp.DBInstance.AllocatedStorage)
+		r.ko.Spec.AllocatedStorage = &allocatedStorageCopy
+	} else {
+		r.ko.Spec.AllocatedStorage = nil
+	}
+	if resp.DBInstance.AssociatedRoles != nil {
+		f7 := []*svcapitypes.DBInstanceRole{}
+		for _, f7iter := range resp.DBInstance.AssociatedRoles {
+			f7elem := &svcapitypes.DBInstanceRole{}
+			if f7iter.FeatureName != nil {
+				f7elem.FeatureName = f7iter.FeatureName
+			}
+			if f7iter.RoleArn != nil {
+				f7elem.RoleARN = f7iter.RoleArn
+			}
+			if f7iter.Status != nil {
+				f7elem.Status = f7iter.Status
+			}
+			f7 = append(f7, f7elem)
+		}
+		r.ko.Status.AssociatedRoles = f7
+	} else {
+		r.ko.Status.AssociatedRoles = nil
+	}
+	if resp.DBInstance.AutoMinorVersionUpgrade != nil {
+		r.ko.Spec.AutoMinorVersionUpgrade = resp.DBInstance.AutoMinorVersionUpgrade
+	} else {
+		r.ko.Spec.AutoMinorVersionUpgrade = nil
+	}
+	if resp.DBInstance.AutomaticRestartTime != nil {
+		r.ko.Status.AutomaticRestartTime = &metav1.Time{*resp.DBInstance.AutomaticRestartTime}
+	} else {
+		r.ko.Status.AutomaticRestartTime = nil
+	}
+	if resp.DBInstance.AutomationMode != "" {
+		r.ko.Status.AutomationMode = aws.String(string(resp.DBInstance.AutomationMode))
+	} else {
+		r.ko.Status.AutomationMode = nil
+	}
+	if resp.DBInstance.AvailabilityZone != nil {
+		r.ko.Spec.AvailabilityZone = resp.DBInstance.AvailabilityZone
+	} else {
+		r.ko.Spec.AvailabilityZone = nil
+	}
+	if resp.DBInstance.AwsBackupRecoveryPointArn != nil {
+		r.ko.Status.AWSBackupRecoveryPointARN = resp.DBInstance.AwsBackupRecoveryPointArn
+	} else {
+		r.ko.Status.AWSBackupRecoveryPointARN = nil
+	}
+	if resp.DBInstance.BackupRetentionPeriod != nil {
+		backupRetentionPeriodCopy := int64(*resp.DBInstance.BackupRetentionPeriod)
+		r.ko.Spec.BackupRetentionPeriod = &backupRetentionPeriodCopy
+	} else {
+		r.ko.Spec.BackupRetentionPeriod = nil
+	}
+	if resp.DBInstance.BackupTarget != nil {
+		r.ko.Spec.BackupTarget = resp.DBInstance.BackupTarget
+	} else {
+		r.ko.Spec.BackupTarget = nil
+	}
+	if resp.DBInstance.CACertificateIdentifier != nil {
+		r.ko.Spec.CACertificateIdentifier = resp.DBInstance.CACertificateIdentifier
+	} else {
+		r.ko.Spec.CACertificateIdentifier = nil
+	}
+	if resp.DBInstance.CertificateDetails != nil {
+		f16 := &svcapitypes.CertificateDetails{}
+		if resp.DBInstance.CertificateDetails.CAIdentifier != nil {
+			f16.CAIdentifier = resp.DBInstance.CertificateDetails.CAIdentifier
+		}
+		if resp.DBInstance.CertificateDetails.ValidTill != nil {
+			f16.ValidTill = &metav1.Time{*resp.DBInstance.CertificateDetails.ValidTill}
+		}
+		r.ko.Status.CertificateDetails = f16
+	} else {
+		r.ko.Status.CertificateDetails = nil
+	}
+	if resp.DBInstance.CharacterSetName != nil {
+		r.ko.Spec.CharacterSetName = resp.DBInstance.CharacterSetName
+	} else {
+		r.ko.Spec.CharacterSetName = nil
+	}
+	if resp.DBInstance.CopyTagsToSnapshot != nil {
+		r.ko.Spec.CopyTagsToSnapshot = resp.DBInstance.CopyTagsToSnapshot
+	} else {
+		r.ko.Spec.CopyTagsToSnapshot = nil
+	}
+	if resp.DBInstance.CustomIamInstanceProfile != nil {
+		r.ko.Spec.CustomIAMInstanceProfile = resp.DBInstance.CustomIamInstanceProfile
+	} else {
+		r.ko.Spec.CustomIAMInstanceProfile = nil
+	}
+	if resp.DBInstance.CustomerOwnedIpEnabled != nil {
+		r.ko.Status.CustomerOwnedIPEnabled = resp.DBInstance.CustomerOwnedIpEnabled
+	} else {
+		r.ko.Status.CustomerOwnedIPEnabled = nil
+	}
+	if resp.DBInstance.DBClusterIdentifier != nil {
+		r.ko.Spec.DBClusterIdentifier = resp.DBInstance.DBClusterIdentifier
+	} else {
+		r.ko.Spec.DBClusterIdentifier = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBInstance.DBInstanceArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBInstance.DBInstanceArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBInstance.DBInstanceAutomatedBackupsReplications != nil {
+		f23 := []*svcapitypes.DBInstanceAutomatedBackupsReplication{}
+		for _, f23iter := range resp.DBInstance.DBInstanceAutomatedBackupsReplications {
+			f23elem := &svcapitypes.DBInstanceAutomatedBackupsReplication{}
+			if f23iter.DBInstanceAutomatedBackupsArn != nil {
+				f23elem.DBInstanceAutomatedBackupsARN = f23iter.DBInstanceAutomatedBackupsArn
+			}
+			f23 = append(f23, f23elem)
+		}
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = f23
+	} else {
+		r.ko.Status.DBInstanceAutomatedBackupsReplications = nil
+	}
+	if resp.DBInstance.DBInstanceClass != nil {
+		r.ko.Spec.DBInstanceClass = resp.DBInstance.DBInstanceClass
+	} else {
+		r.ko.Spec.DBInstanceClass = nil
+	}
+	if resp.DBInstance.DBInstanceIdentifier != nil {
+		r.ko.Spec.DBInstanceIdentifier = resp.DBInstance.DBInstanceIdentifier
+	} else {
+		r.ko.Spec.DBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.DBInstanceStatus != nil {
+		r.ko.Status.DBInstanceStatus = resp.DBInstance.DBInstanceStatus
+	} else {
+		r.ko.Status.DBInstanceStatus = nil
+	}
+	if resp.DBInstance.DBName != nil {
+		r.ko.Spec.DBName = resp.DBInstance.DBName
+	} else {
+		r.ko.Spec.DBName = nil
+	}
+	if resp.DBInstance.DBParameterGroups != nil {
+		f28 := []*svcapitypes.DBParameterGroupStatus_SDK{}
+		for _, f28iter := range resp.DBInstance.DBParameterGroups {
+			f28elem := &svcapitypes.DBParameterGroupStatus_SDK{}
+			if f28iter.DBParameterGroupName != nil {
+				f28elem.DBParameterGroupName = f28iter.DBParameterGroupName
+			}
+			if f28iter.ParameterApplyStatus != nil {
+				f28elem.ParameterApplyStatus = f28iter.ParameterApplyStatus
+			}
+			f28 = append(f28, f28elem)
+		}
+		r.ko.Status.DBParameterGroups = f28
+	} else {
+		r.ko.Status.DBParameterGroups = nil
+	}
+	if resp.DBInstance.DBSubnetGroup != nil {
+		f29 := &svcapitypes.DBSubnetGroup_SDK{}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn != nil {
+			f29.DBSubnetGroupARN = resp.DBInstance.DBSubnetGroup.DBSubnetGroupArn
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription != nil {
+			f29.DBSubnetGroupDescription = resp.DBInstance.DBSubnetGroup.DBSubnetGroupDescription
+		}
+		if resp.DBInstance.DBSubnetGroup.DBSubnetGroupName != nil {
+			f29.DBSubnetGroupName = resp.DBInstance.DBSubnetGroup.DBSubnetGroupName
+		}
+		if resp.DBInstance.DBSubnetGroup.SubnetGroupStatus != nil {
+			f29.SubnetGroupStatus = resp.DBInstance.DBSubnetGroup.SubnetGroupStatus
+		}
+		if resp.DBInstance.DBSubnetGroup.Subnets != nil {
+			f29f4 := []*svcapitypes.Subnet{}
+			for _, f29f4iter := range resp.DBInstance.DBSubnetGroup.Subnets {
+				f29f4elem := &svcapitypes.Subnet{}
+				if f29f4iter.SubnetAvailabilityZone != nil {
+					f29f4elemf0 := &svcapitypes.AvailabilityZone{}
+					if f29f4iter.SubnetAvailabilityZone.Name != nil {
+						f29f4elemf0.Name = f29f4iter.SubnetAvailabilityZone.Name
+					}
+					f29f4elem.SubnetAvailabilityZone = f29f4elemf0
+				}
+				if f29f4iter.SubnetIdentifier != nil {
+					f29f4elem.SubnetIdentifier = f29f4iter.SubnetIdentifier
+				}
+				if f29f4iter.SubnetOutpost != nil {
+					f29f4elemf2 := &svcapitypes.Outpost{}
+					if f29f4iter.SubnetOutpost.Arn != nil {
+						f29f4elemf2.ARN = f29f4iter.SubnetOutpost.Arn
+					}
+					f29f4elem.SubnetOutpost = f29f4elemf2
+				}
+				if f29f4iter.SubnetStatus != nil {
+					f29f4elem.SubnetStatus = f29f4iter.SubnetStatus
+				}
+				f29f4 = append(f29f4, f29f4elem)
+			}
+			f29.Subnets = f29f4
+		}
+		if resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes != nil {
+			f29.SupportedNetworkTypes = aws.StringSlice(resp.DBInstance.DBSubnetGroup.SupportedNetworkTypes)
+		}
+		if resp.DBInstance.DBSubnetGroup.VpcId != nil {
+			f29.VPCID = resp.DBInstance.DBSubnetGroup.VpcId
+		}
+		r.ko.Status.DBSubnetGroup = f29
+	} else {
+		r.ko.Status.DBSubnetGroup = nil
+	}
+	if resp.DBInstance.DBSystemId != nil {
+		r.ko.Status.DBSystemID = resp.DBInstance.DBSystemId
+	} else {
+		r.ko.Status.DBSystemID = nil
+	}
+	if resp.DBInstance.DatabaseInsightsMode != "" {
+		r.ko.Spec.DatabaseInsightsMode = aws.String(string(resp.DBInstance.DatabaseInsightsMode))
+	} else {
+		r.ko.Spec.DatabaseInsightsMode = nil
+	}
+	if resp.DBInstance.DbInstancePort != nil {
+		dbInstancePortCopy := int64(*resp.DBInstance.DbInstancePort)
+		r.ko.Status.DBInstancePort = &dbInstancePortCopy
+	} else {
+		r.ko.Status.DBInstancePort = nil
+	}
+	if resp.DBInstance.DbiResourceId != nil {
+		r.ko.Status.DBIResourceID = resp.DBInstance.DbiResourceId
+	} else {
+		r.ko.Status.DBIResourceID = nil
+	}
+	if resp.DBInstance.DeletionProtection != nil {
+		r.ko.Spec.DeletionProtection = resp.DBInstance.DeletionProtection
+	} else {
+		r.ko.Spec.DeletionProtection = nil
+	}
+	if resp.DBInstance.DomainMemberships != nil {
+		f35 := []*svcapitypes.DomainMembership{}
+		for _, f35iter := range resp.DBInstance.DomainMemberships {
+			f35elem := &svcapitypes.DomainMembership{}
+			if f35iter.Domain != nil {
+				f35elem.Domain = f35iter.Domain
+			}
+			if f35iter.FQDN != nil {
+				f35elem.FQDN = f35iter.FQDN
+			}
+			if f35iter.IAMRoleName != nil {
+				f35elem.IAMRoleName = f35iter.IAMRoleName
+			}
+			if f35iter.Status != nil {
+				f35elem.Status = f35iter.Status
+			}
+			f35 = append(f35, f35elem)
+		}
+		r.ko.Status.DomainMemberships = f35
+	} else {
+		r.ko.Status.DomainMemberships = nil
+	}
+	if resp.DBInstance.EnabledCloudwatchLogsExports != nil {
+		r.ko.Status.EnabledCloudwatchLogsExports = aws.StringSlice(resp.DBInstance.EnabledCloudwatchLogsExports)
+	} else {
+		r.ko.Status.EnabledCloudwatchLogsExports = nil
+	}
+	if resp.DBInstance.Endpoint != nil {
+		f37 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.Endpoint.Address != nil {
+			f37.Address = resp.DBInstance.Endpoint.Address
+		}
+		if resp.DBInstance.Endpoint.HostedZoneId != nil {
+			f37.HostedZoneID = resp.DBInstance.Endpoint.HostedZoneId
+		}
+		if resp.DBInstance.Endpoint.Port != nil {
+			portCopy := int64(*resp.DBInstance.Endpoint.Port)
+			f37.Port = &portCopy
+		}
+		r.ko.Status.Endpoint = f37
+	} else {
+		r.ko.Status.Endpoint = nil
+	}
+	if resp.DBInstance.Engine != nil {
+		r.ko.Spec.Engine = resp.DBInstance.Engine
+	} else {
+		r.ko.Spec.Engine = nil
+	}
+	if resp.DBInstance.EngineVersion != nil {
+		r.ko.Spec.EngineVersion = resp.DBInstance.EngineVersion
+	} else {
+		r.ko.Spec.EngineVersion = nil
+	}
+	if resp.DBInstance.EnhancedMonitoringResourceArn != nil {
+		r.ko.Status.EnhancedMonitoringResourceARN = resp.DBInstance.EnhancedMonitoringResourceArn
+	} else {
+		r.ko.Status.EnhancedMonitoringResourceARN = nil
+	}
+	if resp.DBInstance.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBInstance.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBInstance.InstanceCreateTime != nil {
+		r.ko.Status.InstanceCreateTime = &metav1.Time{*resp.DBInstance.InstanceCreateTime}
+	} else {
+		r.ko.Status.InstanceCreateTime = nil
+	}
+	if resp.DBInstance.Iops != nil {
+		iopsCopy := int64(*resp.DBInstance.Iops)
+		r.ko.Spec.IOPS = &iopsCopy
+	} else {
+		r.ko.Spec.IOPS = nil
+	}
+	if resp.DBInstance.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBInstance.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBInstance.LatestRestorableTime != nil {
+		r.ko.Status.LatestRestorableTime = &metav1.Time{*resp.DBInstance.LatestRestorableTime}
+	} else {
+		r.ko.Status.LatestRestorableTime = nil
+	}
+	if resp.DBInstance.LicenseModel != nil {
+		r.ko.Spec.LicenseModel = resp.DBInstance.LicenseModel
+	} else {
+		r.ko.Spec.LicenseModel = nil
+	}
+	if resp.DBInstance.ListenerEndpoint != nil {
+		f47 := &svcapitypes.Endpoint{}
+		if resp.DBInstance.ListenerEndpoint.Address != nil {
+			f47.Address = resp.DBInstance.ListenerEndpoint.Address
+		}
+		if resp.DBInstance.ListenerEndpoint.HostedZoneId != nil {
+			f47.HostedZoneID = resp.DBInstance.ListenerEndpoint.HostedZoneId
+		}
+		if resp.DBInstance.ListenerEndpoint.Port != nil {
+			portCopy := int64(*resp.DBInstance.ListenerEndpoint.Port)
+			f47.Port = &portCopy
+		}
+		r.ko.Status.ListenerEndpoint = f47
+	} else {
+		r.ko.Status.ListenerEndpoint = nil
+	}
+	if resp.DBInstance.MasterUserSecret != nil {
+		f48 := &svcapitypes.MasterUserSecret{}
+		if resp.DBInstance.MasterUserSecret.KmsKeyId != nil {
+			f48.KMSKeyID = resp.DBInstance.MasterUserSecret.KmsKeyId
+		}
+		if resp.DBInstance.MasterUserSecret.SecretArn != nil {
+			f48.SecretARN = resp.DBInstance.MasterUserSecret.SecretArn
+		}
+		if resp.DBInstance.MasterUserSecret.SecretStatus != nil {
+			f48.SecretStatus = resp.DBInstance.MasterUserSecret.SecretStatus
+		}
+		r.ko.Status.MasterUserSecret = f48
+	} else {
+		r.ko.Status.MasterUserSecret = nil
+	}
+	if resp.DBInstance.MasterUsername != nil {
+		r.ko.Spec.MasterUsername = resp.DBInstance.MasterUsername
+	} else {
+		r.ko.Spec.MasterUsername = nil
+	}
+	if resp.DBInstance.MaxAllocatedStorage != nil {
+		maxAllocatedStorageCopy := int64(*resp.DBInstance.MaxAllocatedStorage)
+		r.ko.Spec.MaxAllocatedStorage = &maxAllocatedStorageCopy
+	} else {
+		r.ko.Spec.MaxAllocatedStorage = nil
+	}
+	if resp.DBInstance.MonitoringInterval != nil {
+		monitoringIntervalCopy := int64(*resp.DBInstance.MonitoringInterval)
+		r.ko.Spec.MonitoringInterval = &monitoringIntervalCopy
+	} else {
+		r.ko.Spec.MonitoringInterval = nil
+	}
+	if resp.DBInstance.MonitoringRoleArn != nil {
+		r.ko.Spec.MonitoringRoleARN = resp.DBInstance.MonitoringRoleArn
+	} else {
+		r.ko.Spec.MonitoringRoleARN = nil
+	}
+	if resp.DBInstance.MultiAZ != nil {
+		r.ko.Spec.MultiAZ = resp.DBInstance.MultiAZ
+	} else {
+		r.ko.Spec.MultiAZ = nil
+	}
+	if resp.DBInstance.NcharCharacterSetName != nil {
+		r.ko.Spec.NcharCharacterSetName = resp.DBInstance.NcharCharacterSetName
+	} else {
+		r.ko.Spec.NcharCharacterSetName = nil
+	}
+	if resp.DBInstance.NetworkType != nil {
+		r.ko.Spec.NetworkType = resp.DBInstance.NetworkType
+	} else {
+		r.ko.Spec.NetworkType = nil
+	}
+	if resp.DBInstance.OptionGroupMemberships != nil {
+		f56 := []*svcapitypes.OptionGroupMembership{}
+		for _, f56iter := range resp.DBInstance.OptionGroupMemberships {
+			f56elem := &svcapitypes.OptionGroupMembership{}
+			if f56iter.OptionGroupName != nil {
+				f56elem.OptionGroupName = f56iter.OptionGroupName
+			}
+			if f56iter.Status != nil {
+				f56elem.Status = f56iter.Status
+			}
+			f56 = append(f56, f56elem)
+		}
+		r.ko.Status.OptionGroupMemberships = f56
+	} else {
+		r.ko.Status.OptionGroupMemberships = nil
+	}
+	if resp.DBInstance.PendingModifiedValues != nil {
+		f57 := &svcapitypes.PendingModifiedValues{}
+		if resp.DBInstance.PendingModifiedValues.AllocatedStorage != nil {
+			allocatedStorageCopy := int64(*resp.DBInstance.PendingModifiedValues.AllocatedStorage)
+			f57.AllocatedStorage = &allocatedStorageCopy
+		}
+		if resp.DBInstance.PendingModifiedValues.AutomationMode != "" {
+			f57.AutomationMode = aws.String(string(resp.DBInstance.PendingModifiedValues.AutomationMode))
+		}
+		if resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod != nil {
+			backupRetentionPeriodCopy := int64(*resp.DBInstance.PendingModifiedValues.BackupRetentionPeriod)
+			f57.BackupRetentionPeriod = &backupRetentionPeriodCopy
+		}
+		if resp.DBInstance.PendingModifiedValues.CACertificateIdentifier != nil {
+			f57.CACertificateIdentifier = resp.DBInstance.PendingModifiedValues.CACertificateIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceClass != nil {
+			f57.DBInstanceClass = resp.DBInstance.PendingModifiedValues.DBInstanceClass
+		}
+		if resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier != nil {
+			f57.DBInstanceIdentifier = resp.DBInstance.PendingModifiedValues.DBInstanceIdentifier
+		}
+		if resp.DBInstance.PendingModifiedValues.DBSubnetGroupName != nil {
+			f57.DBSubnetGroupName = resp.DBInstance.PendingModifiedValues.DBSubnetGroupName
+		}
+		if resp.DBInstance.PendingModifiedValues.EngineVersion != nil {
+			f57.EngineVersion = resp.DBInstance.PendingModifiedValues.EngineVersion
+		}
+		if resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled != nil {
+			f57.IAMDatabaseAuthenticationEnabled = resp.DBInstance.PendingModifiedValues.IAMDatabaseAuthenticationEnabled
+		}
+		if resp.DBInstance.PendingModifiedValues.Iops != nil {
+			iopsCopy := int64(*resp.DBInstance.PendingModifiedValues.Iops)
+			f57.IOPS = &iopsCopy
+		}
+		if resp.DBInstance.PendingModifiedValues.LicenseModel != nil {
+			f57.LicenseModel = resp.DBInstance.PendingModifiedValues.LicenseModel
+		}
+		if resp.DBInstance.PendingModifiedValues.MasterUserPassword != nil {
+			f57.MasterUserPassword = resp.DBInstance.PendingModifiedValues.MasterUserPassword
+		}
+		if resp.DBInstance.PendingModifiedValues.MultiAZ != nil {
+			f57.MultiAZ = resp.DBInstance.PendingModifiedValues.MultiAZ
+		}
+		if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports != nil {
+			f57f13 := &svcapitypes.PendingCloudwatchLogsExports{}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable != nil {
+				f57f13.LogTypesToDisable = aws.StringSlice(resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToDisable)
+			}
+			if resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable != nil {
+				f57f13.LogTypesToEnable = aws.StringSlice(resp.DBInstance.PendingModifiedValues.PendingCloudwatchLogsExports.LogTypesToEnable)
+			}
+			f57.PendingCloudwatchLogsExports = f57f13
+		}
+		if resp.DBInstance.PendingModifiedValues.Port != nil {
+			portCopy := int64(*resp.DBInstance.PendingModifiedValues.Port)
+			f57.Port = &portCopy
+		}
+		if resp.DBInstance.PendingModifiedValues.ProcessorFeatures != nil {
+			f57f15 := []*svcapitypes.ProcessorFeature{}
+			for _, f57f15iter := range resp.DBInstance.PendingModifiedValues.ProcessorFeatures {
+				f57f15elem := &svcapitypes.ProcessorFeature{}
+				if f57f15iter.Name != nil {
+					f57f15elem.Name = f57f15iter.Name
+				}
+				if f57f15iter.Value != nil {
+					f57f15elem.Value = f57f15iter.Value
+				}
+				f57f15 = append(f57f15, f57f15elem)
+			}
+			f57.ProcessorFeatures = f57f15
+		}
+		if resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime != nil {
+			f57.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.PendingModifiedValues.ResumeFullAutomationModeTime}
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageThroughput != nil {
+			storageThroughputCopy := int64(*resp.DBInstance.PendingModifiedValues.StorageThroughput)
+			f57.StorageThroughput = &storageThroughputCopy
+		}
+		if resp.DBInstance.PendingModifiedValues.StorageType != nil {
+			f57.StorageType = resp.DBInstance.PendingModifiedValues.StorageType
+		}
+		r.ko.Status.PendingModifiedValues = f57
+	} else {
+		r.ko.Status.PendingModifiedValues = nil
+	}
+	if resp.DBInstance.PerformanceInsightsEnabled != nil {
+		r.ko.Spec.PerformanceInsightsEnabled = resp.DBInstance.PerformanceInsightsEnabled
+	} else {
+		r.ko.Spec.PerformanceInsightsEnabled = nil
+	}
+	if resp.DBInstance.PerformanceInsightsKMSKeyId != nil {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = resp.DBInstance.PerformanceInsightsKMSKeyId
+	} else {
+		r.ko.Spec.PerformanceInsightsKMSKeyID = nil
+	}
+	if resp.DBInstance.PerformanceInsightsRetentionPeriod != nil {
+		performanceInsightsRetentionPeriodCopy := int64(*resp.DBInstance.PerformanceInsightsRetentionPeriod)
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = &performanceInsightsRetentionPeriodCopy
+	} else {
+		r.ko.Spec.PerformanceInsightsRetentionPeriod = nil
+	}
+	if resp.DBInstance.PreferredBackupWindow != nil {
+		r.ko.Spec.PreferredBackupWindow = resp.DBInstance.PreferredBackupWindow
+	} else {
+		r.ko.Spec.PreferredBackupWindow = nil
+	}
+	if resp.DBInstance.PreferredMaintenanceWindow != nil {
+		r.ko.Spec.PreferredMaintenanceWindow = resp.DBInstance.PreferredMaintenanceWindow
+	} else {
+		r.ko.Spec.PreferredMaintenanceWindow = nil
+	}
+	if resp.DBInstance.ProcessorFeatures != nil {
+		f63 := []*svcapitypes.ProcessorFeature{}
+		for _, f63iter := range resp.DBInstance.ProcessorFeatures {
+			f63elem := &svcapitypes.ProcessorFeature{}
+			if f63iter.Name != nil {
+				f63elem.Name = f63iter.Name
+			}
+			if f63iter.Value != nil {
+				f63elem.Value = f63iter.Value
+			}
+			f63 = append(f63, f63elem)
+		}
+		r.ko.Spec.ProcessorFeatures = f63
+	} else {
+		r.ko.Spec.ProcessorFeatures = nil
+	}
+	if resp.DBInstance.PromotionTier != nil {
+		promotionTierCopy := int64(*resp.DBInstance.PromotionTier)
+		r.ko.Spec.PromotionTier = &promotionTierCopy
+	} else {
+		r.ko.Spec.PromotionTier = nil
+	}
+	if resp.DBInstance.PubliclyAccessible != nil {
+		r.ko.Spec.PubliclyAccessible = resp.DBInstance.PubliclyAccessible
+	} else {
+		r.ko.Spec.PubliclyAccessible = nil
+	}
+	if resp.DBInstance.ReadReplicaDBClusterIdentifiers != nil {
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = aws.StringSlice(resp.DBInstance.ReadReplicaDBClusterIdentifiers)
+	} else {
+		r.ko.Status.ReadReplicaDBClusterIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaDBInstanceIdentifiers != nil {
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = aws.StringSlice(resp.DBInstance.ReadReplicaDBInstanceIdentifiers)
+	} else {
+		r.ko.Status.ReadReplicaDBInstanceIdentifiers = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBClusterIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = resp.DBInstance.ReadReplicaSourceDBClusterIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBClusterIdentifier = nil
+	}
+	if resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier != nil {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = resp.DBInstance.ReadReplicaSourceDBInstanceIdentifier
+	} else {
+		r.ko.Status.ReadReplicaSourceDBInstanceIdentifier = nil
+	}
+	if resp.DBInstance.ReplicaMode != "" {
+		r.ko.Spec.ReplicaMode = aws.String(string(resp.DBInstance.ReplicaMode))
+	} else {
+		r.ko.Spec.ReplicaMode = nil
+	}
+	if resp.DBInstance.ResumeFullAutomationModeTime != nil {
+		r.ko.Status.ResumeFullAutomationModeTime = &metav1.Time{*resp.DBInstance.ResumeFullAutomationModeTime}
+	} else {
+		r.ko.Status.ResumeFullAutomationModeTime = nil
+	}
+	if resp.DBInstance.SecondaryAvailabilityZone != nil {
+		r.ko.Status.SecondaryAvailabilityZone = resp.DBInstance.SecondaryAvailabilityZone
+	} else {
+		r.ko.Status.SecondaryAvailabilityZone = nil
+	}
+	if resp.DBInstance.StatusInfos != nil {
+		f73 := []*svcapitypes.DBInstanceStatusInfo{}
+		for _, f73iter := range resp.DBInstance.StatusInfos {
+			f73elem := &svcapitypes.DBInstanceStatusInfo{}
+			if f73iter.Message != nil {
+				f73elem.Message = f73iter.Message
+			}
+			if f73iter.Normal != nil {
+				f73elem.Normal = f73iter.Normal
+			}
+			if f73iter.Status != nil {
+				f73elem.Status = f73iter.Status
+			}
+			if f73iter.StatusType != nil {
+				f73elem.StatusType = f73iter.StatusType
+			}
+			f73 = append(f73, f73elem)
+		}
+		r.ko.Status.StatusInfos = f73
+	} else {
+		r.ko.Status.StatusInfos = nil
+	}
+	if resp.DBInstance.StorageEncrypted != nil {
+		r.ko.Spec.StorageEncrypted = resp.DBInstance.StorageEncrypted
+	} else {
+		r.ko.Spec.StorageEncrypted = nil
+	}
+	if resp.DBInstance.StorageThroughput != nil {
+		storageThroughputCopy := int64(*resp.DBInstance.StorageThroughput)
+		r.ko.Spec.StorageThroughput = &storageThroughputCopy
+	} else {
+		r.ko.Spec.StorageThroughput = nil
+	}
+	if resp.DBInstance.StorageType != nil {
+		r.ko.Spec.StorageType = resp.DBInstance.StorageType
+	} else {
+		r.ko.Spec.StorageType = nil
+	}
+	if resp.DBInstance.TdeCredentialArn != nil {
+		r.ko.Spec.TDECredentialARN = resp.DBInstance.TdeCredentialArn
+	} else {
+		r.ko.Spec.TDECredentialARN = nil
+	}
+	if resp.DBInstance.Timezone != nil {
+		r.ko.Spec.Timezone = resp.DBInstance.Timezone
+	} else {
+		r.ko.Spec.Timezone = nil
+	}
+	if resp.DBInstance.VpcSecurityGroups != nil {
+		f79 := []*svcapitypes.VPCSecurityGroupMembership{}
+		for _, f79iter := range resp.DBInstance.VpcSecurityGroups {
+			f79elem := &svcapitypes.VPCSecurityGroupMembership{}
+			if f79iter.Status != nil {
+				f79elem.Status = f79iter.Status
+			}
+			if f79iter.VpcSecurityGroupId != nil {
+				f79elem.VPCSecurityGroupID = f79iter.VpcSecurityGroupId
+			}
+			f79 = append(f79, f79elem)
+		}
+		r.ko.Status.VPCSecurityGroups = f79
+	} else {
+		r.ko.Status.VPCSecurityGroups = nil
+	}
+
+}
+
 // setResourceFromCreateDBInstanceReadReplicaOutput sets a resource CreateDBInstanceReadReplicaOutput type
 // given the SDK type.
 func (rm *resourceManager) setResourceFromCreateDBInstanceReadReplicaOutput(
@@ -0,0 +1,181 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_parameter_group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const testFamily = "postgres14"
+
+// newTestBackend returns a fake RDS backend with a "test-pg" parameter group
+// in the testFamily family.
+func newTestBackend() *fake.RDS {
+	api := fake.New()
+	api.EngineDefaults[testFamily] = []svcsdktypes.Parameter{
+		fake.EngineDefaultParameter("work_mem", aws.String("4096"), "dynamic", true),
+		fake.EngineDefaultParameter("shared_buffers", aws.String("16384"), "static", true),
+		fake.EngineDefaultParameter("rds.extensions", nil, "static", false),
+	}
+	api.DBParameterGroups["test-pg"] = &fake.ParameterGroup{
+		Family:     testFamily,
+		Parameters: map[string]svcsdktypes.Parameter{},
+	}
+	for _, p := range api.EngineDefaults[testFamily] {
+		api.DBParameterGroups["test-pg"].Parameters[*p.ParameterName] = p
+	}
+	return api
+}
+
+func newTestResource(overrides util.Parameters) *resource {
+	return &resource{
+		ko: &svcapitypes.DBParameterGroup{
+			Spec: svcapitypes.DBParameterGroupSpec{
+				Name:               aws.String("test-pg"),
+				Family:             aws.String(testFamily),
+				ParameterOverrides: overrides,
+			},
+		},
+	}
+}
+
+func TestSyncParameters(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     util.Parameters
+		latest      util.Parameters
+		wantModify  []svcsdktypes.Parameter
+		wantReset   []svcsdktypes.Parameter
+		wantTermErr error
+	}{
+		{
+			name:    "dynamic parameter is applied immediately",
+			desired: util.Parameters{"work_mem": aws.String("8192")},
+			wantModify: []svcsdktypes.Parameter{{
+				ParameterName:  aws.String("work_mem"),
+				ParameterValue: aws.String("8192"),
+				ApplyMethod:    svcsdktypes.ApplyMethodImmediate,
+			}},
+		},
+		{
+			name:    "static parameter is applied pending reboot",
+			desired: util.Parameters{"shared_buffers": aws.String("32768")},
+			wantModify: []svcsdktypes.Parameter{{
+				ParameterName:  aws.String("shared_buffers"),
+				ParameterValue: aws.String("32768"),
+				ApplyMethod:    svcsdktypes.ApplyMethodPendingReboot,
+			}},
+		},
+		{
+			name:    "removed override is reset",
+			desired: util.Parameters{},
+			latest:  util.Parameters{"work_mem": aws.String("8192")},
+			wantReset: []svcsdktypes.Parameter{{
+				ParameterName: aws.String("work_mem"),
+				ApplyMethod:   svcsdktypes.ApplyMethodImmediate,
+			}},
+		},
+		{
+			name:        "unmodifiable parameter is a terminal error",
+			desired:     util.Parameters{"rds.extensions": aws.String("pg_stat")},
+			wantTermErr: util.ErrUnmodifiableParameter,
+		},
+		{
+			name:        "unknown parameter is a terminal error",
+			desired:     util.Parameters{"no_such_param": aws.String("1")},
+			wantTermErr: util.ErrUnknownParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newTestBackend()
+			rm := &resourceManager{
+				metrics: ackmetrics.NewMetrics("rds"),
+				sdkapi:  api,
+			}
+
+			err := rm.syncParameters(
+				context.Background(),
+				newTestResource(tt.desired),
+				newTestResource(tt.latest),
+			)
+			if tt.wantTermErr != nil {
+				var termErr *ackerr.TerminalError
+				assert.True(t, errors.As(err, &termErr))
+				assert.ErrorIs(t, err, tt.wantTermErr)
+				assert.Empty(t, api.CallsTo("ModifyDBParameterGroup"))
+				return
+			}
+			require.NoError(t, err)
+
+			modifyCalls := api.CallsTo("ModifyDBParameterGroup")
+			if tt.wantModify == nil {
+				assert.Empty(t, modifyCalls)
+			} else {
+				require.Len(t, modifyCalls, 1)
+				assert.Equal(t, tt.wantModify, modifyCalls[0].(*svcsdk.ModifyDBParameterGroupInput).Parameters)
+			}
+			resetCalls := api.CallsTo("ResetDBParameterGroup")
+			if tt.wantReset == nil {
+				assert.Empty(t, resetCalls)
+			} else {
+				require.Len(t, resetCalls, 1)
+				assert.Equal(t, tt.wantReset, resetCalls[0].(*svcsdk.ResetDBParameterGroupInput).Parameters)
+			}
+		})
+	}
+}
+
+func TestGetParameters_OnlyOverriddenOrNonDefault(t *testing.T) {
+	api := newTestBackend()
+	// Only the first parameter fits on a page, forcing getParameters to
+	// follow the Marker.
+	api.PageSize = 1
+	pg := api.DBParameterGroups["test-pg"]
+	p := pg.Parameters["shared_buffers"]
+	p.ParameterValue = aws.String("32768")
+	pg.Parameters["shared_buffers"] = p
+	rm := &resourceManager{
+		metrics: ackmetrics.NewMetrics("rds"),
+		sdkapi:  api,
+	}
+
+	params, statuses, err := rm.getParameters(
+		context.Background(),
+		aws.String("test-pg"),
+		aws.String(testFamily),
+		util.Parameters{"work_mem": aws.String("4096")},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*string{
+		"shared_buffers": aws.String("32768"),
+		"work_mem":       aws.String("4096"),
+	}, params)
+	assert.Len(t, statuses, 2)
+	assert.Len(t, api.CallsTo("DescribeDBParameters"), 3)
+}
@@ -140,7 +140,7 @@ func (rm *resourceManager) sdkFind(
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
-		ko.Status.ParameterOverrideStatuses = paramStatuses
+		setParameterOverrideStatuses(ko, paramStatuses)
 	}
 
 	return &resource{ko}, nil
@@ -41,6 +41,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	customPreCompare(delta, a, b)
 
 	if len(a.ko.Spec.Auth) != len(b.ko.Spec.Auth) {
 		delta.Add("Spec.Auth", a.ko.Spec.Auth, b.ko.Spec.Auth)
@@ -49,6 +50,12 @@ func newResourceDelta(
 			delta.Add("Spec.Auth", a.ko.Spec.Auth, b.ko.Spec.Auth)
 		}
 	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.DBClusterIdentifierRefs, b.ko.Spec.DBClusterIdentifierRefs) {
+		delta.Add("Spec.DBClusterIdentifierRefs", a.ko.Spec.DBClusterIdentifierRefs, b.ko.Spec.DBClusterIdentifierRefs)
+	}
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.DBInstanceIdentifierRefs, b.ko.Spec.DBInstanceIdentifierRefs) {
+		delta.Add("Spec.DBInstanceIdentifierRefs", a.ko.Spec.DBInstanceIdentifierRefs, b.ko.Spec.DBInstanceIdentifierRefs)
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DebugLogging, b.ko.Spec.DebugLogging) {
 		delta.Add("Spec.DebugLogging", a.ko.Spec.DebugLogging, b.ko.Spec.DebugLogging)
 	} else if a.ko.Spec.DebugLogging != nil && b.ko.Spec.DebugLogging != nil {
@@ -25,6 +25,7 @@ import (
 	k8sctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
 )
 
 const (
@@ -42,6 +43,8 @@ var (
 // resourceDescriptor implements the
 // `aws-service-operator-k8s/pkg/types.AWSResourceDescriptor` interface
 type resourceDescriptor struct {
+	// deps are the Dependencies of the factory that produced the descriptor
+	deps svcresource.Dependencies
 }
 
 // GroupVersionKind returns a Kubernetes schema.GroupVersionKind struct that
@@ -205,6 +205,16 @@ func (rm *resourceManager) sdkFind(
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else {
+		if err := rm.setTargetGroupFields(ctx, ko); err != nil {
+			return nil, err
+		}
+		// RDS checks the connection to new targets asynchronously. Keep
+		// requeueing until it's done so that the target health is current.
+		if id := registeringTarget(ko.Status.Targets); id != nil {
+			msg := "DB proxy target '" + *id + "' is being registered"
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+		}
 	}
 	return &resource{ko}, nil
 }
@@ -479,7 +489,7 @@ func (rm *resourceManager) sdkUpdate(
 	if proxyCreating(latest) {
 		msg := "DB proxy is currently being created"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if proxyHasTerminalStatus(latest) {
 		msg := "DB proxy is in '" + *latest.ko.Status.Status + "' status"
@@ -490,7 +500,17 @@ func (rm *resourceManager) sdkUpdate(
 	if !proxyAvailable(latest) {
 		msg := "DB proxy cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if targetGroupChanged(delta) {
+		if err = rm.syncTargetGroup(ctx, desired, latest, delta); err != nil {
+			return nil, err
+		}
+		if onlyTargetGroupChanged(delta) {
+			// Requeue to read the registered targets and their health.
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, nil, nil)
+			return desired, nil
+		}
 	}
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
 	if err != nil {
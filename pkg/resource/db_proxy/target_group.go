@@ -0,0 +1,372 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// defaultTargetGroupName is the name of the target group that RDS creates
+// along with each DB proxy. It is the only target group a proxy can have.
+const defaultTargetGroupName = "default"
+
+const (
+	connectionPoolConfigDeltaPath  = "Spec.ConnectionPoolConfig"
+	dbClusterIdentifiersDeltaPath  = "Spec.DBClusterIdentifiers"
+	dbInstanceIdentifiersDeltaPath = "Spec.DBInstanceIdentifiers"
+)
+
+// targetGroupChanged returns true if the delta contains a difference that
+// is applied to the default target group rather than with ModifyDBProxy.
+func targetGroupChanged(delta *ackcompare.Delta) bool {
+	return delta.DifferentAt(connectionPoolConfigDeltaPath) ||
+		delta.DifferentAt(dbClusterIdentifiersDeltaPath) ||
+		delta.DifferentAt(dbInstanceIdentifiersDeltaPath)
+}
+
+// onlyTargetGroupChanged returns true if every difference in the delta is
+// applied to the default target group.
+func onlyTargetGroupChanged(delta *ackcompare.Delta) bool {
+	return !delta.DifferentExcept(
+		connectionPoolConfigDeltaPath,
+		dbClusterIdentifiersDeltaPath,
+		dbInstanceIdentifiersDeltaPath,
+	)
+}
+
+// compareConnectionPoolConfig adds a difference to the delta when a field
+// of the desired connection pool configuration differs from the latest one.
+// Fields that aren't set in the desired configuration are left to their
+// RDS defaults and not compared.
+func compareConnectionPoolConfig(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	a := desired.ko.Spec.ConnectionPoolConfig
+	if a == nil {
+		return
+	}
+	b := latest.ko.Spec.ConnectionPoolConfig
+	if b == nil {
+		b = &svcapitypes.ConnectionPoolConfiguration{}
+	}
+	if (a.ConnectionBorrowTimeout != nil && !equalInt64(a.ConnectionBorrowTimeout, b.ConnectionBorrowTimeout)) ||
+		(a.InitQuery != nil && aws.ToString(a.InitQuery) != aws.ToString(b.InitQuery)) ||
+		(a.MaxConnectionsPercent != nil && !equalInt64(a.MaxConnectionsPercent, b.MaxConnectionsPercent)) ||
+		(a.MaxIdleConnectionsPercent != nil && !equalInt64(a.MaxIdleConnectionsPercent, b.MaxIdleConnectionsPercent)) ||
+		(a.SessionPinningFilters != nil && !util.EqualStringSets(a.SessionPinningFilters, b.SessionPinningFilters)) {
+		delta.Add(connectionPoolConfigDeltaPath, a, b)
+	}
+}
+
+func equalInt64(a, b *int64) bool {
+	return a != nil && b != nil && *a == *b
+}
+
+// compareTargets adds a difference to the delta when the desired DB
+// instances or DB clusters aren't the ones registered with the default
+// target group of the latest DB proxy. A nil list means the targets of that
+// kind aren't managed by the controller.
+func compareTargets(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	instances, clusters := registeredTargets(latest.ko.Status.Targets)
+	if desired.ko.Spec.DBInstanceIdentifiers != nil &&
+		!util.EqualStringSets(desired.ko.Spec.DBInstanceIdentifiers, instances) {
+		delta.Add(dbInstanceIdentifiersDeltaPath, desired.ko.Spec.DBInstanceIdentifiers, instances)
+	}
+	if desired.ko.Spec.DBClusterIdentifiers != nil &&
+		!util.EqualStringSets(desired.ko.Spec.DBClusterIdentifiers, clusters) {
+		delta.Add(dbClusterIdentifiersDeltaPath, desired.ko.Spec.DBClusterIdentifiers, clusters)
+	}
+}
+
+// registeredTargets returns the identifiers of the DB instances and of the
+// DB clusters registered with a target group. The DB instances that RDS
+// tracks as members of a registered DB cluster are not included.
+func registeredTargets(
+	targets []*svcapitypes.DBProxyTarget,
+) (instances []*string, clusters []*string) {
+	for _, t := range targets {
+		if t == nil || t.Type == nil || t.RdsResourceID == nil {
+			continue
+		}
+		switch svcsdktypes.TargetType(*t.Type) {
+		case svcsdktypes.TargetTypeRdsInstance:
+			if t.TrackedClusterID == nil {
+				instances = append(instances, t.RdsResourceID)
+			}
+		case svcsdktypes.TargetTypeTrackedCluster:
+			clusters = append(clusters, t.RdsResourceID)
+		}
+	}
+	return instances, clusters
+}
+
+// registeringTarget returns the identifier of the first target that RDS is
+// still registering with the target group, or nil if there is none.
+func registeringTarget(targets []*svcapitypes.DBProxyTarget) *string {
+	for _, t := range targets {
+		if t != nil && t.TargetHealth != nil &&
+			aws.ToString(t.TargetHealth.State) == string(svcsdktypes.TargetStateRegistering) {
+			return t.RdsResourceID
+		}
+	}
+	return nil
+}
+
+// setTargetGroupFields reads the default target group of the DB proxy and
+// its targets and sets the connection pool configuration in the Spec and
+// the targets in the Status of the supplied DB proxy.
+func (rm *resourceManager) setTargetGroupFields(
+	ctx context.Context,
+	ko *svcapitypes.DBProxy,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.setTargetGroupFields")
+	defer func() { exit(err) }()
+
+	groupsResp, err := rm.sdkapi.DescribeDBProxyTargetGroups(
+		ctx,
+		&svcsdk.DescribeDBProxyTargetGroupsInput{
+			DBProxyName:     ko.Spec.Name,
+			TargetGroupName: aws.String(defaultTargetGroupName),
+		},
+	)
+	rm.metrics.RecordAPICall("GET", "DescribeDBProxyTargetGroups", err)
+	if err != nil {
+		return err
+	}
+	ko.Spec.ConnectionPoolConfig = nil
+	for _, group := range groupsResp.TargetGroups {
+		if group.ConnectionPoolConfig != nil {
+			ko.Spec.ConnectionPoolConfig = connectionPoolConfigFromSDK(group.ConnectionPoolConfig)
+		}
+	}
+
+	targets := []*svcapitypes.DBProxyTarget{}
+	var marker *string
+	for {
+		resp, err := rm.sdkapi.DescribeDBProxyTargets(
+			ctx,
+			&svcsdk.DescribeDBProxyTargetsInput{
+				DBProxyName:     ko.Spec.Name,
+				TargetGroupName: aws.String(defaultTargetGroupName),
+				Marker:          marker,
+			},
+		)
+		rm.metrics.RecordAPICall("GET", "DescribeDBProxyTargets", err)
+		if err != nil {
+			return err
+		}
+		for _, t := range resp.Targets {
+			targets = append(targets, proxyTargetFromSDK(t))
+		}
+		marker = resp.Marker
+		if marker == nil {
+			break
+		}
+	}
+	ko.Status.Targets = targets
+	return nil
+}
+
+// syncTargetGroup applies the desired connection pool configuration and
+// registers and deregisters the targets of the default target group of the
+// DB proxy. Targets are deregistered before new ones are registered, so
+// that a DB proxy can be moved from one database to another.
+func (rm *resourceManager) syncTargetGroup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTargetGroup")
+	defer func() { exit(err) }()
+
+	name := desired.ko.Spec.Name
+	targetGroupName := aws.String(defaultTargetGroupName)
+
+	if delta.DifferentAt(connectionPoolConfigDeltaPath) {
+		cfg, err := connectionPoolConfigToSDK(desired.ko.Spec.ConnectionPoolConfig)
+		if err != nil {
+			return err
+		}
+		_, err = rm.sdkapi.ModifyDBProxyTargetGroup(
+			ctx,
+			&svcsdk.ModifyDBProxyTargetGroupInput{
+				DBProxyName:          name,
+				TargetGroupName:      targetGroupName,
+				ConnectionPoolConfig: cfg,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "ModifyDBProxyTargetGroup", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	instances, clusters := registeredTargets(latest.ko.Status.Targets)
+	var instancesToAdd, instancesToRemove, clustersToAdd, clustersToRemove []string
+	if delta.DifferentAt(dbInstanceIdentifiersDeltaPath) {
+		instancesToAdd, instancesToRemove = util.ComputeStringSetDelta(
+			desired.ko.Spec.DBInstanceIdentifiers, instances,
+		)
+	}
+	if delta.DifferentAt(dbClusterIdentifiersDeltaPath) {
+		clustersToAdd, clustersToRemove = util.ComputeStringSetDelta(
+			desired.ko.Spec.DBClusterIdentifiers, clusters,
+		)
+	}
+
+	if len(instancesToRemove) > 0 || len(clustersToRemove) > 0 {
+		rlog.Debug(
+			"deregistering DB proxy targets",
+			"db_instances", instancesToRemove,
+			"db_clusters", clustersToRemove,
+		)
+		_, err = rm.sdkapi.DeregisterDBProxyTargets(
+			ctx,
+			&svcsdk.DeregisterDBProxyTargetsInput{
+				DBProxyName:           name,
+				TargetGroupName:       targetGroupName,
+				DBInstanceIdentifiers: instancesToRemove,
+				DBClusterIdentifiers:  clustersToRemove,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "DeregisterDBProxyTargets", err)
+		if err != nil {
+			return err
+		}
+	}
+	if len(instancesToAdd) > 0 || len(clustersToAdd) > 0 {
+		rlog.Debug(
+			"registering DB proxy targets",
+			"db_instances", instancesToAdd,
+			"db_clusters", clustersToAdd,
+		)
+		_, err = rm.sdkapi.RegisterDBProxyTargets(
+			ctx,
+			&svcsdk.RegisterDBProxyTargetsInput{
+				DBProxyName:           name,
+				TargetGroupName:       targetGroupName,
+				DBInstanceIdentifiers: instancesToAdd,
+				DBClusterIdentifiers:  clustersToAdd,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RegisterDBProxyTargets", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func connectionPoolConfigFromSDK(
+	cfg *svcsdktypes.ConnectionPoolConfigurationInfo,
+) *svcapitypes.ConnectionPoolConfiguration {
+	res := &svcapitypes.ConnectionPoolConfiguration{
+		InitQuery: cfg.InitQuery,
+	}
+	if cfg.ConnectionBorrowTimeout != nil {
+		res.ConnectionBorrowTimeout = aws.Int64(int64(*cfg.ConnectionBorrowTimeout))
+	}
+	if cfg.MaxConnectionsPercent != nil {
+		res.MaxConnectionsPercent = aws.Int64(int64(*cfg.MaxConnectionsPercent))
+	}
+	if cfg.MaxIdleConnectionsPercent != nil {
+		res.MaxIdleConnectionsPercent = aws.Int64(int64(*cfg.MaxIdleConnectionsPercent))
+	}
+	if cfg.SessionPinningFilters != nil {
+		res.SessionPinningFilters = aws.StringSlice(cfg.SessionPinningFilters)
+	}
+	return res
+}
+
+func connectionPoolConfigToSDK(
+	cfg *svcapitypes.ConnectionPoolConfiguration,
+) (*svcsdktypes.ConnectionPoolConfiguration, error) {
+	res := &svcsdktypes.ConnectionPoolConfiguration{
+		InitQuery: cfg.InitQuery,
+	}
+	var err error
+	if res.ConnectionBorrowTimeout, err = toInt32("ConnectionBorrowTimeout", cfg.ConnectionBorrowTimeout); err != nil {
+		return nil, err
+	}
+	if res.MaxConnectionsPercent, err = toInt32("MaxConnectionsPercent", cfg.MaxConnectionsPercent); err != nil {
+		return nil, err
+	}
+	if res.MaxIdleConnectionsPercent, err = toInt32("MaxIdleConnectionsPercent", cfg.MaxIdleConnectionsPercent); err != nil {
+		return nil, err
+	}
+	if cfg.SessionPinningFilters != nil {
+		res.SessionPinningFilters = aws.ToStringSlice(cfg.SessionPinningFilters)
+	}
+	return res, nil
+}
+
+func toInt32(field string, v *int64) (*int32, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if *v > math.MaxInt32 || *v < math.MinInt32 {
+		return nil, fmt.Errorf("error: field %s is of type int32", field)
+	}
+	return aws.Int32(int32(*v)), nil
+}
+
+func proxyTargetFromSDK(t svcsdktypes.DBProxyTarget) *svcapitypes.DBProxyTarget {
+	res := &svcapitypes.DBProxyTarget{
+		Endpoint:         t.Endpoint,
+		RdsResourceID:    t.RdsResourceId,
+		TargetARN:        t.TargetArn,
+		TrackedClusterID: t.TrackedClusterId,
+	}
+	if t.Port != nil {
+		res.Port = aws.Int64(int64(*t.Port))
+	}
+	if t.Role != "" {
+		res.Role = aws.String(string(t.Role))
+	}
+	if t.Type != "" {
+		res.Type = aws.String(string(t.Type))
+	}
+	if t.TargetHealth != nil {
+		res.TargetHealth = &svcapitypes.TargetHealth{
+			Description: t.TargetHealth.Description,
+		}
+		if t.TargetHealth.Reason != "" {
+			res.TargetHealth.Reason = aws.String(string(t.TargetHealth.Reason))
+		}
+		if t.TargetHealth.State != "" {
+			res.TargetHealth.State = aws.String(string(t.TargetHealth.State))
+		}
+	}
+	return res
+}
@@ -0,0 +1,276 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_proxy
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+// newTestProxy returns a resource manager backed by a fake RDS API with an
+// available DB proxy named "proxy", two DB instances and a DB cluster with
+// one member.
+func newTestProxy(t *testing.T) (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	_, err := api.CreateDBProxy(context.Background(), &svcsdk.CreateDBProxyInput{
+		DBProxyName:  aws.String("proxy"),
+		EngineFamily: svcsdktypes.EngineFamilyPostgresql,
+	})
+	require.NoError(t, err)
+	api.DBProxies["proxy"].Status = svcsdktypes.DBProxyStatusAvailable
+	for _, id := range []string{"instance-a", "instance-b", "member"} {
+		api.DBInstances[id] = &svcsdktypes.DBInstance{
+			DBInstanceIdentifier: aws.String(id),
+			DBInstanceStatus:     aws.String(fake.StatusAvailable),
+		}
+	}
+	api.DBClusters["cluster"] = &svcsdktypes.DBCluster{
+		DBClusterIdentifier: aws.String("cluster"),
+		Status:              aws.String(fake.StatusAvailable),
+		DBClusterMembers: []svcsdktypes.DBClusterMember{{
+			DBInstanceIdentifier: aws.String("member"),
+			IsClusterWriter:      aws.Bool(true),
+		}},
+	}
+	api.Calls = nil
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+func proxyResource() *resource {
+	return &resource{ko: &svcapitypes.DBProxy{
+		Spec: svcapitypes.DBProxySpec{
+			Name:         aws.String("proxy"),
+			EngineFamily: aws.String(string(svcsdktypes.EngineFamilyPostgresql)),
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestCustomPreCompare_TargetGroup(t *testing.T) {
+	registered := []*svcapitypes.DBProxyTarget{
+		{RdsResourceID: aws.String("instance-a"), Type: aws.String("RDS_INSTANCE")},
+		{RdsResourceID: aws.String("cluster"), Type: aws.String("TRACKED_CLUSTER")},
+		{RdsResourceID: aws.String("member"), Type: aws.String("RDS_INSTANCE"), TrackedClusterID: aws.String("cluster")},
+	}
+	latestPool := &svcapitypes.ConnectionPoolConfiguration{
+		ConnectionBorrowTimeout:   aws.Int64(120),
+		MaxConnectionsPercent:     aws.Int64(100),
+		MaxIdleConnectionsPercent: aws.Int64(50),
+	}
+	tests := []struct {
+		name      string
+		instances []*string
+		clusters  []*string
+		pool      *svcapitypes.ConnectionPoolConfiguration
+		wantDiffs []string
+	}{
+		{
+			name: "targets and pool not managed",
+		},
+		{
+			name:      "registered targets match",
+			instances: aws.StringSlice([]string{"instance-a"}),
+			clusters:  aws.StringSlice([]string{"cluster"}),
+		},
+		{
+			name:      "instance added",
+			instances: aws.StringSlice([]string{"instance-a", "instance-b"}),
+			wantDiffs: []string{dbInstanceIdentifiersDeltaPath},
+		},
+		{
+			name:      "all clusters removed",
+			clusters:  []*string{},
+			wantDiffs: []string{dbClusterIdentifiersDeltaPath},
+		},
+		{
+			name: "pool fields left to their defaults are not compared",
+			pool: &svcapitypes.ConnectionPoolConfiguration{
+				MaxConnectionsPercent: aws.Int64(100),
+			},
+		},
+		{
+			name: "pool field changed",
+			pool: &svcapitypes.ConnectionPoolConfiguration{
+				MaxIdleConnectionsPercent: aws.Int64(10),
+			},
+			wantDiffs: []string{connectionPoolConfigDeltaPath},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := proxyResource()
+			desired.ko.Spec.DBInstanceIdentifiers = tt.instances
+			desired.ko.Spec.DBClusterIdentifiers = tt.clusters
+			desired.ko.Spec.ConnectionPoolConfig = tt.pool
+			latest := proxyResource()
+			latest.ko.Spec.ConnectionPoolConfig = latestPool
+			latest.ko.Status.Targets = registered
+
+			delta := ackcompare.NewDelta()
+			customPreCompare(delta, desired, latest)
+			assert.Equal(t, len(tt.wantDiffs), len(delta.Differences))
+			for _, path := range tt.wantDiffs {
+				assert.True(t, delta.DifferentAt(path), path)
+			}
+		})
+	}
+}
+
+func TestSdkFind_TargetGroup(t *testing.T) {
+	rm, api := newTestProxy(t)
+	_, err := api.RegisterDBProxyTargets(context.Background(), &svcsdk.RegisterDBProxyTargetsInput{
+		DBProxyName:           aws.String("proxy"),
+		DBInstanceIdentifiers: []string{"instance-a"},
+	})
+	require.NoError(t, err)
+
+	latest, err := rm.sdkFind(context.Background(), proxyResource())
+	require.NoError(t, err)
+	assert.Equal(t, int64(50), *latest.ko.Spec.ConnectionPoolConfig.MaxIdleConnectionsPercent)
+	require.Len(t, latest.ko.Status.Targets, 1)
+	target := latest.ko.Status.Targets[0]
+	assert.Equal(t, "instance-a", *target.RdsResourceID)
+	assert.Equal(t, "REGISTERING", *target.TargetHealth.State)
+	cond := syncedCondition(latest)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+
+	// Once RDS is done checking the target, the health is reported as is
+	// and no longer holds the DB proxy back.
+	api.DBProxyTargets["proxy"][0].TargetHealth = &svcsdktypes.TargetHealth{
+		State:  svcsdktypes.TargetStateUnavailable,
+		Reason: svcsdktypes.TargetHealthReasonAuthFailure,
+	}
+	latest, err = rm.sdkFind(context.Background(), proxyResource())
+	require.NoError(t, err)
+	target = latest.ko.Status.Targets[0]
+	assert.Equal(t, "UNAVAILABLE", *target.TargetHealth.State)
+	assert.Equal(t, "AUTH_FAILURE", *target.TargetHealth.Reason)
+	assert.Nil(t, syncedCondition(latest))
+}
+
+func TestSdkFind_TargetGroupNotReadWhileCreating(t *testing.T) {
+	rm, api := newTestProxy(t)
+	api.DBProxies["proxy"].Status = svcsdktypes.DBProxyStatusCreating
+
+	_, err := rm.sdkFind(context.Background(), proxyResource())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DescribeDBProxies"}, api.Operations())
+}
+
+func TestSdkUpdate_TargetGroup(t *testing.T) {
+	tests := []struct {
+		name         string
+		instances    []string
+		clusters     []string
+		pool         *svcapitypes.ConnectionPoolConfiguration
+		debugLogging bool
+		wantOps      []string
+		wantTargets  []string
+	}{
+		{
+			name:        "instance replaced",
+			instances:   []string{"instance-b"},
+			wantOps:     []string{"DeregisterDBProxyTargets", "RegisterDBProxyTargets"},
+			wantTargets: []string{"instance-b"},
+		},
+		{
+			name:        "instance replaced by a cluster",
+			instances:   []string{},
+			clusters:    []string{"cluster"},
+			wantOps:     []string{"DeregisterDBProxyTargets", "RegisterDBProxyTargets"},
+			wantTargets: []string{"cluster", "member"},
+		},
+		{
+			name: "connection pool modified",
+			pool: &svcapitypes.ConnectionPoolConfiguration{
+				MaxConnectionsPercent: aws.Int64(75),
+			},
+			wantOps:     []string{"ModifyDBProxyTargetGroup"},
+			wantTargets: []string{"instance-a"},
+		},
+		{
+			name:         "DB proxy modified along with its targets",
+			instances:    []string{"instance-a", "instance-b"},
+			debugLogging: true,
+			wantOps:      []string{"RegisterDBProxyTargets", "ModifyDBProxy"},
+			wantTargets:  []string{"instance-a", "instance-b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestProxy(t)
+			_, err := api.RegisterDBProxyTargets(context.Background(), &svcsdk.RegisterDBProxyTargetsInput{
+				DBProxyName:           aws.String("proxy"),
+				DBInstanceIdentifiers: []string{"instance-a"},
+			})
+			require.NoError(t, err)
+			latest, err := rm.sdkFind(context.Background(), proxyResource())
+			require.NoError(t, err)
+			api.Calls = nil
+
+			desired := proxyResource()
+			if tt.instances != nil {
+				desired.ko.Spec.DBInstanceIdentifiers = aws.StringSlice(tt.instances)
+			}
+			if tt.clusters != nil {
+				desired.ko.Spec.DBClusterIdentifiers = aws.StringSlice(tt.clusters)
+			}
+			desired.ko.Spec.ConnectionPoolConfig = tt.pool
+			delta := newResourceDelta(desired, latest)
+			if tt.debugLogging {
+				desired.ko.Spec.DebugLogging = aws.Bool(true)
+				delta.Add("Spec.DebugLogging", desired.ko.Spec.DebugLogging, nil)
+			}
+
+			updated, err := rm.sdkUpdate(context.Background(), desired, latest, delta)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOps, api.Operations())
+			cond := syncedCondition(updated)
+			require.NotNil(t, cond)
+			assert.Equal(t, corev1.ConditionFalse, cond.Status)
+
+			targets := []string{}
+			for _, target := range api.DBProxyTargets["proxy"] {
+				targets = append(targets, *target.RdsResourceId)
+			}
+			assert.ElementsMatch(t, tt.wantTargets, targets)
+			if tt.pool != nil {
+				assert.Equal(t, int32(75), *api.DBProxyTargetGroups["proxy"].ConnectionPoolConfig.MaxConnectionsPercent)
+			}
+		})
+	}
+}
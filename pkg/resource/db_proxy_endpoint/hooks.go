@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_proxy_endpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+var (
+	// TerminalStatuses are the status strings that are terminal states for a
+	// DB proxy endpoint.
+	TerminalStatuses = []string{
+		string(svcsdktypes.DBProxyEndpointStatusIncompatibleNetwork),
+		string(svcsdktypes.DBProxyEndpointStatusInsufficientResourceLimits),
+	}
+
+	// immutableFields are the fields that ModifyDBProxyEndpoint can't
+	// change. The endpoint has to be recreated to change them.
+	immutableFields = []string{
+		"Spec.DBProxyName",
+		"Spec.TargetRole",
+		"Spec.VPCSubnetIDs",
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("DB proxy endpoint in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the DB proxy endpoint cannot be modified until it reaches an
+// available status.
+func (rm *resourceManager) requeueWaitUntilCanModify(
+	ctx context.Context,
+	r *resource,
+) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"DB proxy endpoint in '%s' state, cannot be modified until '%s'.",
+		status, svcsdktypes.DBProxyEndpointStatusAvailable,
+	)
+	return rm.requeuePolicy.NeededAfter(
+		ctx, GroupKind.Kind, r.ko, status, errors.New(msg),
+	)
+}
+
+// endpointHasTerminalStatus returns whether the supplied DB proxy endpoint is
+// in a terminal state
+func endpointHasTerminalStatus(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	for _, s := range TerminalStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointAvailable returns true if the supplied DB proxy endpoint is in an
+// available status
+func endpointAvailable(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == string(svcsdktypes.DBProxyEndpointStatusAvailable)
+}
+
+// endpointCreating returns true if the supplied DB proxy endpoint is in the
+// process of being created
+func endpointCreating(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == string(svcsdktypes.DBProxyEndpointStatusCreating)
+}
+
+// endpointDeleting returns true if the supplied DB proxy endpoint is in the
+// process of being deleted
+func endpointDeleting(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == string(svcsdktypes.DBProxyEndpointStatusDeleting)
+}
+
+// immutableFieldChanged returns a terminal error naming the first field in
+// the delta that can't be changed once the DB proxy endpoint exists, or nil
+// if there is no such field.
+func immutableFieldChanged(delta *ackcompare.Delta) error {
+	for _, path := range immutableFields {
+		if delta.DifferentAt(path) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%s can't be changed after the DB proxy endpoint is created", path,
+			))
+		}
+	}
+	return nil
+}
+
+// syncTags keeps the resource's tags in sync
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return rm.tagReconciler.Get(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+// compareTags adds a difference to the delta if the supplied resources have
+// different tag collections, as reconciled by the supplied TagReconciler
+func compareTags(
+	tags *util.TagReconciler,
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
+}
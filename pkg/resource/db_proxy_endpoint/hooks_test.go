@@ -0,0 +1,205 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_proxy_endpoint
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+)
+
+// newTestEndpoint returns a resource manager backed by a fake RDS API with
+// an available DB proxy named "proxy".
+func newTestEndpoint(t *testing.T) (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	_, err := api.CreateDBProxy(context.Background(), &svcsdk.CreateDBProxyInput{
+		DBProxyName:         aws.String("proxy"),
+		EngineFamily:        svcsdktypes.EngineFamilyPostgresql,
+		VpcSecurityGroupIds: []string{"sg-proxy"},
+	})
+	require.NoError(t, err)
+	api.DBProxies["proxy"].Status = svcsdktypes.DBProxyStatusAvailable
+	api.Calls = nil
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+// descriptorDelta returns the difference between the supplied resources, as
+// computed by the descriptor of a factory with the default Dependencies.
+func descriptorDelta(a, b *resource) *ackcompare.Delta {
+	rd := &resourceDescriptor{deps: svcresource.DefaultDependencies()}
+	return rd.Delta(a, b)
+}
+
+func endpointResource() *resource {
+	return &resource{ko: &svcapitypes.DBProxyEndpoint{
+		Spec: svcapitypes.DBProxyEndpointSpec{
+			Name:         aws.String("reader"),
+			DBProxyName:  aws.String("proxy"),
+			TargetRole:   aws.String(string(svcsdktypes.DBProxyEndpointTargetRoleReadOnly)),
+			VPCSubnetIDs: aws.StringSlice([]string{"subnet-a", "subnet-b"}),
+			Tags: []*svcapitypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSdkCreateAndFind(t *testing.T) {
+	rm, api := newTestEndpoint(t)
+	ctx := context.Background()
+
+	created, err := rm.sdkCreate(ctx, endpointResource())
+	require.NoError(t, err)
+	assert.Equal(t, string(svcsdktypes.DBProxyEndpointStatusCreating), *created.ko.Status.Status)
+	require.NotNil(t, syncedCondition(created))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(created).Status)
+
+	latest, err := rm.sdkFind(ctx, endpointResource())
+	require.NoError(t, err)
+	require.NotNil(t, syncedCondition(latest))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(latest).Status)
+
+	api.DBProxyEndpoints["reader"].Status = svcsdktypes.DBProxyEndpointStatusAvailable
+	latest, err = rm.sdkFind(ctx, endpointResource())
+	require.NoError(t, err)
+	assert.Nil(t, syncedCondition(latest))
+	assert.NotNil(t, latest.ko.Status.Endpoint)
+	assert.False(t, *latest.ko.Status.IsDefault)
+	// The security groups of the proxy are used when none are specified.
+	assert.Equal(t, []string{"sg-proxy"}, aws.ToStringSlice(latest.ko.Spec.VPCSecurityGroupIDs))
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+
+	_, err = rm.sdkFind(ctx, &resource{ko: &svcapitypes.DBProxyEndpoint{
+		Spec: svcapitypes.DBProxyEndpointSpec{Name: aws.String("missing")},
+	}})
+	assert.Equal(t, ackerr.NotFound, err)
+}
+
+func TestSdkUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       svcsdktypes.DBProxyEndpointStatus
+		mutate       func(r *resource)
+		wantOps      []string
+		wantTerminal bool
+	}{
+		{
+			name:   "not available",
+			status: svcsdktypes.DBProxyEndpointStatusModifying,
+			mutate: func(r *resource) {
+				r.ko.Spec.VPCSecurityGroupIDs = aws.StringSlice([]string{"sg-new"})
+			},
+		},
+		{
+			name:   "security groups changed",
+			status: svcsdktypes.DBProxyEndpointStatusAvailable,
+			mutate: func(r *resource) {
+				r.ko.Spec.VPCSecurityGroupIDs = aws.StringSlice([]string{"sg-new"})
+			},
+			wantOps: []string{"ModifyDBProxyEndpoint"},
+		},
+		{
+			name:   "only tags changed",
+			status: svcsdktypes.DBProxyEndpointStatusAvailable,
+			mutate: func(r *resource) {
+				r.ko.Spec.Tags = []*svcapitypes.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				}
+			},
+			wantOps: []string{"RemoveTagsFromResource", "AddTagsToResource"},
+		},
+		{
+			name:   "subnets changed",
+			status: svcsdktypes.DBProxyEndpointStatusAvailable,
+			mutate: func(r *resource) {
+				r.ko.Spec.VPCSubnetIDs = aws.StringSlice([]string{"subnet-c"})
+			},
+			wantTerminal: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestEndpoint(t)
+			ctx := context.Background()
+			created, err := rm.sdkCreate(ctx, endpointResource())
+			require.NoError(t, err)
+			api.DBProxyEndpoints["reader"].Status = tt.status
+			latest, err := rm.sdkFind(ctx, created)
+			require.NoError(t, err)
+			api.Calls = nil
+
+			desired := &resource{ko: latest.ko.DeepCopy()}
+			tt.mutate(desired)
+			delta := descriptorDelta(desired, latest)
+			_, err = rm.sdkUpdate(ctx, desired, latest, delta)
+			if tt.wantTerminal {
+				var terminalErr *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminalErr)
+			} else if tt.status != svcsdktypes.DBProxyEndpointStatusAvailable {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if len(tt.wantOps) == 0 {
+				assert.Empty(t, api.Operations())
+			} else {
+				assert.Equal(t, tt.wantOps, api.Operations())
+			}
+		})
+	}
+}
+
+func TestValidateReferenceFields(t *testing.T) {
+	ref := &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("proxy")},
+	}
+	ko := endpointResource().ko
+	assert.NoError(t, validateReferenceFields(ko))
+
+	ko.Spec.DBProxyRef = ref
+	assert.Error(t, validateReferenceFields(ko))
+
+	ko.Spec.DBProxyName = nil
+	assert.NoError(t, validateReferenceFields(ko))
+
+	ko.Spec.VPCSubnetIDs = nil
+	assert.Error(t, validateReferenceFields(ko))
+
+	ko.Spec.VPCSubnetRefs = []*ackv1alpha1.AWSResourceReferenceWrapper{ref}
+	assert.NoError(t, validateReferenceFields(ko))
+}
@@ -41,7 +41,22 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
+	compareSharedAccounts(delta, a, b)
 
+	if ackcompare.HasNilDifference(a.ko.Spec.CopyOptionGroup, b.ko.Spec.CopyOptionGroup) {
+		delta.Add("Spec.CopyOptionGroup", a.ko.Spec.CopyOptionGroup, b.ko.Spec.CopyOptionGroup)
+	} else if a.ko.Spec.CopyOptionGroup != nil && b.ko.Spec.CopyOptionGroup != nil {
+		if *a.ko.Spec.CopyOptionGroup != *b.ko.Spec.CopyOptionGroup {
+			delta.Add("Spec.CopyOptionGroup", a.ko.Spec.CopyOptionGroup, b.ko.Spec.CopyOptionGroup)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.CopyTags, b.ko.Spec.CopyTags) {
+		delta.Add("Spec.CopyTags", a.ko.Spec.CopyTags, b.ko.Spec.CopyTags)
+	} else if a.ko.Spec.CopyTags != nil && b.ko.Spec.CopyTags != nil {
+		if *a.ko.Spec.CopyTags != *b.ko.Spec.CopyTags {
+			delta.Add("Spec.CopyTags", a.ko.Spec.CopyTags, b.ko.Spec.CopyTags)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.DBInstanceIdentifier, b.ko.Spec.DBInstanceIdentifier) {
 		delta.Add("Spec.DBInstanceIdentifier", a.ko.Spec.DBInstanceIdentifier, b.ko.Spec.DBInstanceIdentifier)
 	} else if a.ko.Spec.DBInstanceIdentifier != nil && b.ko.Spec.DBInstanceIdentifier != nil {
@@ -59,10 +74,36 @@ func newResourceDelta(
 			delta.Add("Spec.DBSnapshotIdentifier", a.ko.Spec.DBSnapshotIdentifier, b.ko.Spec.DBSnapshotIdentifier)
 		}
 	}
-	desiredACKTags, _ := convertToOrderedACKTags(a.ko.Spec.Tags)
-	latestACKTags, _ := convertToOrderedACKTags(b.ko.Spec.Tags)
-	if !ackcompare.MapStringStringEqual(desiredACKTags, latestACKTags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
+	if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef) {
+		delta.Add("Spec.KMSKeyRef", a.ko.Spec.KMSKeyRef, b.ko.Spec.KMSKeyRef)
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName) {
+		delta.Add("Spec.OptionGroupName", a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName)
+	} else if a.ko.Spec.OptionGroupName != nil && b.ko.Spec.OptionGroupName != nil {
+		if *a.ko.Spec.OptionGroupName != *b.ko.Spec.OptionGroupName {
+			delta.Add("Spec.OptionGroupName", a.ko.Spec.OptionGroupName, b.ko.Spec.OptionGroupName)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL) {
+		delta.Add("Spec.PreSignedURL", a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL)
+	} else if a.ko.Spec.PreSignedURL != nil && b.ko.Spec.PreSignedURL != nil {
+		if *a.ko.Spec.PreSignedURL != *b.ko.Spec.PreSignedURL {
+			delta.Add("Spec.PreSignedURL", a.ko.Spec.PreSignedURL, b.ko.Spec.PreSignedURL)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBSnapshotARN, b.ko.Spec.SourceDBSnapshotARN) {
+		delta.Add("Spec.SourceDBSnapshotARN", a.ko.Spec.SourceDBSnapshotARN, b.ko.Spec.SourceDBSnapshotARN)
+	} else if a.ko.Spec.SourceDBSnapshotARN != nil && b.ko.Spec.SourceDBSnapshotARN != nil {
+		if *a.ko.Spec.SourceDBSnapshotARN != *b.ko.Spec.SourceDBSnapshotARN {
+			delta.Add("Spec.SourceDBSnapshotARN", a.ko.Spec.SourceDBSnapshotARN, b.ko.Spec.SourceDBSnapshotARN)
+		}
+	}
+	if ackcompare.HasNilDifference(a.ko.Spec.SourceDBSnapshotRegion, b.ko.Spec.SourceDBSnapshotRegion) {
+		delta.Add("Spec.SourceDBSnapshotRegion", a.ko.Spec.SourceDBSnapshotRegion, b.ko.Spec.SourceDBSnapshotRegion)
+	} else if a.ko.Spec.SourceDBSnapshotRegion != nil && b.ko.Spec.SourceDBSnapshotRegion != nil {
+		if *a.ko.Spec.SourceDBSnapshotRegion != *b.ko.Spec.SourceDBSnapshotRegion {
+			delta.Add("Spec.SourceDBSnapshotRegion", a.ko.Spec.SourceDBSnapshotRegion, b.ko.Spec.SourceDBSnapshotRegion)
+		}
 	}
 
 	return delta
@@ -18,12 +18,15 @@ import (
 	"errors"
 	"fmt"
 
-	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
-	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
 )
@@ -35,11 +38,29 @@ const (
 	SnapshotStatusUpgrading = "upgrading"
 )
 
+// snapshotRestoreAttribute is the name of the DB snapshot attribute that
+// lists the accounts allowed to copy or restore a manual DB snapshot.
+const snapshotRestoreAttribute = "restore"
+
+var (
+	// immutableFields are the copy settings of a DB snapshot, which are only
+	// used when the snapshot is created.
+	immutableFields = []string{
+		"Spec.CopyOptionGroup",
+		"Spec.CopyTags",
+		"Spec.OptionGroupName",
+		"Spec.SourceDBSnapshotARN",
+	}
+)
+
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
 // explaining that the DB Snapshot is in a state that does not allow it to be
 // modified and that the controller should requeue the resource after a
 // specified duration.
-func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+func (rm *resourceManager) requeueWaitUntilCanModify(
+	ctx context.Context,
+	r *resource,
+) *ackrequeue.RequeueNeededAfter {
 	if r.ko.Status.Status == nil {
 		return nil
 	}
@@ -48,9 +69,8 @@ func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
 		"DB Instance in '%s' state, cannot be modified until '%s'.",
 		status, SnapshotStatusAvailable,
 	)
-	return ackrequeue.NeededAfter(
-		errors.New(msg),
-		ackrequeue.DefaultRequeueAfterDuration,
+	return rm.requeuePolicy.NeededAfter(
+		ctx, GroupKind.Kind, r.ko, status, errors.New(msg),
 	)
 }
 
@@ -74,127 +94,237 @@ func snapshotCreating(r *resource) bool {
 	return snapshotStatus == SnapshotStatusCreating
 }
 
-// syncTags keeps the resource's tags in sync
-//
-// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
-// following ways:
-//
-//  1. The names of the tagging API operations are different. Other APIs use the
-//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
-//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
-//     `RemoveTagsFromResource`.
-//
-//  2. Even though the name of the `ListTagsForResource` API call is the same,
-//     the structure of the input and the output are different from other APIs.
-//     For the input, instead of a `ResourceArn` field, RDS names the field
-//     `ResourceName`, but actually expects an ARN, not the instance
-//     name.  This is the same for the `AddTagsToResource` and
-//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
-//     called `TagList` instead of `Tags` but is otherwise the same struct with
-//     a `Key` and `Value` member field.
-func (rm *resourceManager) syncTags(
+// snapshotProgressMessage returns a message with the status and progress of
+// the supplied DB snapshot, or nil if RDS doesn't report the progress. It's
+// used as the message of the ResourceSynced condition while the snapshot is
+// being created or copied.
+func snapshotProgressMessage(r *resource) *string {
+	if r.ko.Status.Status == nil || r.ko.Status.PercentProgress == nil {
+		return nil
+	}
+	msg := fmt.Sprintf(
+		"DB snapshot in '%s' state, %d%% complete",
+		*r.ko.Status.Status, *r.ko.Status.PercentProgress,
+	)
+	return &msg
+}
+
+// copyingSnapshot returns true if the supplied resource is copied from
+// another DB snapshot rather than created from a DB instance.
+func copyingSnapshot(r *resource) bool {
+	return r.ko.Spec.SourceDBSnapshotARN != nil
+}
+
+// validateCopySource returns a terminal error if the supplied resource
+// doesn't have exactly one of a DB instance and a DB snapshot to copy as the
+// source of the snapshot, or if it has copy settings without a DB snapshot
+// to copy.
+func validateCopySource(r *resource) error {
+	spec := r.ko.Spec
+	if !copyingSnapshot(r) {
+		if spec.DBInstanceIdentifier == nil {
+			return ackerr.NewTerminalError(errors.New(
+				"one of DBInstanceIdentifier and SourceDBSnapshotARN must be set",
+			))
+		}
+		copyFields := []struct {
+			name string
+			set  bool
+		}{
+			{"CopyOptionGroup", spec.CopyOptionGroup != nil},
+			{"CopyTags", spec.CopyTags != nil},
+			{"KMSKeyID", spec.KMSKeyID != nil},
+			{"OptionGroupName", spec.OptionGroupName != nil},
+			{"PreSignedURL", spec.PreSignedURL != nil},
+			{"SourceDBSnapshotRegion", spec.SourceDBSnapshotRegion != nil},
+		}
+		for _, field := range copyFields {
+			if field.set {
+				return ackerr.NewTerminalError(fmt.Errorf(
+					"%s can only be set when SourceDBSnapshotARN is set", field.name,
+				))
+			}
+		}
+		return nil
+	}
+	if spec.DBInstanceIdentifier != nil {
+		return ackerr.NewTerminalError(errors.New(
+			"only one of DBInstanceIdentifier and SourceDBSnapshotARN can be set",
+		))
+	}
+	if !arn.IsARN(*spec.SourceDBSnapshotARN) {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"SourceDBSnapshotARN %q is not an ARN", *spec.SourceDBSnapshotARN,
+		))
+	}
+	return nil
+}
+
+// immutableFieldChanged returns a terminal error naming the first field in
+// the delta that can't be changed once the DB snapshot exists, or nil if
+// there is no such field.
+func immutableFieldChanged(delta *ackcompare.Delta) error {
+	for _, path := range immutableFields {
+		if delta.DifferentAt(path) {
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"%s can't be changed after the DB snapshot is created", path,
+			))
+		}
+	}
+	return nil
+}
+
+// copySourceRegion returns the Region of the source DB snapshot of a copy,
+// which the SDK uses to generate the presigned URL of a cross-Region copy.
+// It's SourceDBSnapshotRegion if set, otherwise the Region in
+// SourceDBSnapshotARN if that differs from the Region of the controller.
+func (rm *resourceManager) copySourceRegion(r *resource) *string {
+	if r.ko.Spec.SourceDBSnapshotRegion != nil {
+		return r.ko.Spec.SourceDBSnapshotRegion
+	}
+	parsed, err := arn.Parse(*r.ko.Spec.SourceDBSnapshotARN)
+	if err != nil || parsed.Region == "" || parsed.Region == string(rm.awsRegion) {
+		return nil
+	}
+	return &parsed.Region
+}
+
+// copyDBSnapshot creates the supplied DB snapshot by copying the DB snapshot
+// in SourceDBSnapshotARN with CopyDBSnapshot.
+func (rm *resourceManager) copyDBSnapshot(
 	ctx context.Context,
-	desired *resource,
-	latest *resource,
-) (err error) {
+	r *resource,
+) (created *resource, err error) {
 	rlog := ackrtlog.FromContext(ctx)
-	exit := rlog.Trace("rm.syncTags")
+	exit := rlog.Trace("rm.copyDBSnapshot")
 	defer func() { exit(err) }()
 
-	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	input, err := rm.newCopyDBSnapshotInput(r)
+	if err != nil {
+		return nil, err
+	}
+	input.SourceRegion = rm.copySourceRegion(r)
+	resp, err := rm.sdkapi.CopyDBSnapshot(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CopyDBSnapshot", err)
+	if err != nil {
+		return nil, err
+	}
 
-	toAdd, toDelete := util.ComputeTagsDelta(
-		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
-	)
+	rm.setResourceFromCopyDBSnapshotOutput(r, resp)
+	// The DB instance of the copy is the DB instance of the source snapshot,
+	// which isn't the source of this resource.
+	r.ko.Spec.DBInstanceIdentifier = nil
+	rm.setStatusDefaults(r.ko)
 
-	if len(toDelete) > 0 {
-		rlog.Debug("removing tags from instance", "tags", toDelete)
-		_, err = rm.sdkapi.RemoveTagsFromResource(
-			ctx,
-			&svcsdk.RemoveTagsFromResourceInput{
-				ResourceName: arn,
-				TagKeys:      toDelete,
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
-		if err != nil {
-			return err
-		}
+	if !snapshotAvailable(r) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(r, corev1.ConditionFalse, snapshotProgressMessage(r), nil)
 	}
+	return r, nil
+}
 
-	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
-	// with a new value overwrites any existing tag with the same key. So, we
-	// don't need to do anything to "update" a Tag. Simply including it in the
-	// AddTagsToResource call is enough.
-	if len(toAdd) > 0 {
-		rlog.Debug("adding tags to instance", "tags", toAdd)
-		_, err = rm.sdkapi.AddTagsToResource(
-			ctx,
-			&svcsdk.AddTagsToResourceInput{
-				ResourceName: arn,
-				Tags:         sdkTagsFromResourceTags(toAdd),
-			},
-		)
-		rm.metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
-		if err != nil {
-			return err
-		}
+// compareSharedAccounts adds a difference to the delta if the supplied
+// resources aren't shared with the same accounts. RDS doesn't preserve the
+// order of the accounts.
+func compareSharedAccounts(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if !util.EqualStringSets(a.ko.Spec.SharedAccounts, b.ko.Spec.SharedAccounts) {
+		delta.Add("Spec.SharedAccounts", a.ko.Spec.SharedAccounts, b.ko.Spec.SharedAccounts)
 	}
-	return nil
 }
 
-// getTags retrieves the resource's associated tags
-func (rm *resourceManager) getTags(
+// getSharedAccounts returns the accounts the DB snapshot is shared with,
+// which are the values of its "restore" attribute.
+func (rm *resourceManager) getSharedAccounts(
 	ctx context.Context,
-	resourceARN string,
-) ([]*svcapitypes.Tag, error) {
-	resp, err := rm.sdkapi.ListTagsForResource(
+	snapshotID *string,
+) ([]*string, error) {
+	resp, err := rm.sdkapi.DescribeDBSnapshotAttributes(
 		ctx,
-		&svcsdk.ListTagsForResourceInput{
-			ResourceName: &resourceARN,
+		&svcsdk.DescribeDBSnapshotAttributesInput{
+			DBSnapshotIdentifier: snapshotID,
 		},
 	)
-	rm.metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	rm.metrics.RecordAPICall("GET", "DescribeDBSnapshotAttributes", err)
 	if err != nil {
 		return nil, err
 	}
-	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
-	for _, tag := range resp.TagList {
-		tags = append(tags, &svcapitypes.Tag{
-			Key:   tag.Key,
-			Value: tag.Value,
-		})
+	if resp.DBSnapshotAttributesResult == nil {
+		return nil, nil
+	}
+	for _, attr := range resp.DBSnapshotAttributesResult.DBSnapshotAttributes {
+		if aws.ToString(attr.AttributeName) == snapshotRestoreAttribute && len(attr.AttributeValues) > 0 {
+			return aws.StringSlice(attr.AttributeValues), nil
+		}
 	}
-	return tags, nil
+	return nil, nil
+}
+
+// syncSharedAccounts shares the DB snapshot with the accounts that are in
+// the desired but not the latest shared accounts, and stops sharing it with
+// the accounts that are only in the latest shared accounts.
+func (rm *resourceManager) syncSharedAccounts(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncSharedAccounts")
+	defer func() { exit(err) }()
+
+	toAdd, toRemove := util.ComputeStringSetDelta(
+		desired.ko.Spec.SharedAccounts, latest.ko.Spec.SharedAccounts,
+	)
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+	rlog.Debug(
+		"modifying accounts the snapshot is shared with",
+		"adding", toAdd, "removing", toRemove,
+	)
+	_, err = rm.sdkapi.ModifyDBSnapshotAttribute(
+		ctx,
+		&svcsdk.ModifyDBSnapshotAttributeInput{
+			AttributeName:        aws.String(snapshotRestoreAttribute),
+			DBSnapshotIdentifier: latest.ko.Spec.DBSnapshotIdentifier,
+			ValuesToAdd:          toAdd,
+			ValuesToRemove:       toRemove,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyDBSnapshotAttribute", err)
+	return err
+}
+
+// syncTags keeps the resource's tags in sync
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
 }
 
 // compareTags adds a difference to the delta if the supplied resources have
-// different tag collections
+// different tag collections, as reconciled by the supplied TagReconciler
 func compareTags(
+	tags *util.TagReconciler,
 	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
-	if len(a.ko.Spec.Tags) != len(b.ko.Spec.Tags) {
-		delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-	} else if len(a.ko.Spec.Tags) > 0 {
-		if !util.EqualTags(a.ko.Spec.Tags, b.ko.Spec.Tags) {
-			delta.Add("Spec.Tags", a.ko.Spec.Tags, b.ko.Spec.Tags)
-		}
-	}
-}
-
-// sdkTagsFromResourceTags transforms a *svcapitypes.Tag array to a *svcsdk.Tag
-// array.
-func sdkTagsFromResourceTags(
-	rTags []*svcapitypes.Tag,
-) []svcsdktypes.Tag {
-	tags := make([]svcsdktypes.Tag, len(rTags))
-	for i := range rTags {
-		tags[i] = svcsdktypes.Tag{
-			Key:   rTags[i].Key,
-			Value: rTags[i].Value,
-		}
-	}
-	return tags
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
 }
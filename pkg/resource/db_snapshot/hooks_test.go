@@ -0,0 +1,327 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package db_snapshot
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+)
+
+const testSourceARN = "arn:aws:rds:us-east-1:111111111111:snapshot:source"
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+// descriptorDelta returns the difference between the supplied resources, as
+// computed by the descriptor of a factory with the default Dependencies.
+func descriptorDelta(a, b *resource) *ackcompare.Delta {
+	rd := &resourceDescriptor{deps: svcresource.DefaultDependencies()}
+	return rd.Delta(a, b)
+}
+
+func copyResource() *resource {
+	return &resource{ko: &svcapitypes.DBSnapshot{
+		Spec: svcapitypes.DBSnapshotSpec{
+			DBSnapshotIdentifier: aws.String("copy"),
+			SourceDBSnapshotARN:  aws.String(testSourceARN),
+			KMSKeyID:             aws.String("alias/snapshots"),
+			CopyTags:             aws.Bool(true),
+			Tags: []*svcapitypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSdkCreateCopiesSnapshot(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	created, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CopyDBSnapshot"}, api.Operations())
+
+	input := api.CallsTo("CopyDBSnapshot")[0].(*svcsdk.CopyDBSnapshotInput)
+	assert.Equal(t, testSourceARN, *input.SourceDBSnapshotIdentifier)
+	assert.Equal(t, "copy", *input.TargetDBSnapshotIdentifier)
+	assert.Equal(t, "alias/snapshots", *input.KmsKeyId)
+	assert.True(t, *input.CopyTags)
+	assert.Equal(t, "us-east-1", *input.SourceRegion)
+	assert.Equal(t, "data", *input.Tags[0].Value)
+
+	assert.Nil(t, created.ko.Spec.DBInstanceIdentifier)
+	assert.Equal(t, "pending", *created.ko.Status.Status)
+	require.NotNil(t, syncedCondition(created))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(created).Status)
+	assert.Equal(t, "DB snapshot in 'pending' state, 0% complete", *syncedCondition(created).Message)
+}
+
+func TestSdkCreateCopySourceRegion(t *testing.T) {
+	tests := []struct {
+		name      string
+		sourceARN string
+		region    *string
+		want      *string
+	}{
+		{
+			name:      "same region",
+			sourceARN: "arn:aws:rds:us-west-2:111111111111:snapshot:source",
+		},
+		{
+			name:      "other region",
+			sourceARN: testSourceARN,
+			want:      aws.String("us-east-1"),
+		},
+		{
+			name:      "explicit region",
+			sourceARN: "arn:aws:rds:us-west-2:111111111111:snapshot:source",
+			region:    aws.String("eu-west-1"),
+			want:      aws.String("eu-west-1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestManager()
+			r := copyResource()
+			r.ko.Spec.SourceDBSnapshotARN = aws.String(tt.sourceARN)
+			r.ko.Spec.SourceDBSnapshotRegion = tt.region
+
+			_, err := rm.sdkCreate(context.Background(), r)
+			require.NoError(t, err)
+			input := api.CallsTo("CopyDBSnapshot")[0].(*svcsdk.CopyDBSnapshotInput)
+			assert.Equal(t, tt.want, input.SourceRegion)
+		})
+	}
+}
+
+func TestSdkFindKeepsCopyDBInstanceIdentifier(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	api.DBSnapshots["copy"].DBInstanceIdentifier = aws.String("source-instance")
+	api.DBSnapshots["copy"].PercentProgress = aws.Int32(42)
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Nil(t, latest.ko.Spec.DBInstanceIdentifier)
+	assert.Equal(t, "DB snapshot in 'pending' state, 42% complete", *syncedCondition(latest).Message)
+
+	delta := newResourceDelta(copyResource(), latest)
+	assert.False(t, delta.DifferentAt("Spec.DBInstanceIdentifier"))
+	assert.False(t, delta.DifferentAt("Spec.SourceDBSnapshotARN"))
+
+	api.DBSnapshots["copy"].Status = aws.String(SnapshotStatusAvailable)
+	latest, err = rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Nil(t, syncedCondition(latest))
+}
+
+func TestSdkFindReadsTagsFromDescribe(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	require.Len(t, latest.ko.Spec.Tags, 1)
+	assert.Equal(t, "team", *latest.ko.Spec.Tags[0].Key)
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+	assert.Empty(t, api.CallsTo("ListTagsForResource"))
+}
+
+func TestDeltaIgnoresTags(t *testing.T) {
+	desired := copyResource()
+	latest := copyResource()
+	latest.ko.Spec.Tags = append(latest.ko.Spec.Tags,
+		&svcapitypes.Tag{Key: aws.String("aws:backup:source-resource"), Value: aws.String("db")},
+		&svcapitypes.Tag{Key: aws.String("finops/cost-center"), Value: aws.String("42")},
+	)
+	assert.True(t, descriptorDelta(desired, latest).DifferentAt("Spec.Tags"))
+
+	desired.ko.Annotations = map[string]string{
+		svcapitypes.IgnoredTagKeysAnnotation: "finops/*",
+	}
+	assert.False(t, descriptorDelta(desired, latest).DifferentAt("Spec.Tags"))
+}
+
+func TestValidateCopySource(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    svcapitypes.DBSnapshotSpec
+		wantErr bool
+	}{
+		{
+			name: "instance",
+			spec: svcapitypes.DBSnapshotSpec{
+				DBInstanceIdentifier: aws.String("instance"),
+			},
+		},
+		{
+			name: "copy",
+			spec: svcapitypes.DBSnapshotSpec{
+				SourceDBSnapshotARN: aws.String(testSourceARN),
+				OptionGroupName:     aws.String("options"),
+			},
+		},
+		{
+			name:    "no source",
+			spec:    svcapitypes.DBSnapshotSpec{},
+			wantErr: true,
+		},
+		{
+			name: "instance and copy",
+			spec: svcapitypes.DBSnapshotSpec{
+				DBInstanceIdentifier: aws.String("instance"),
+				SourceDBSnapshotARN:  aws.String(testSourceARN),
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy of snapshot identifier",
+			spec: svcapitypes.DBSnapshotSpec{
+				SourceDBSnapshotARN: aws.String("source"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy setting without copy",
+			spec: svcapitypes.DBSnapshotSpec{
+				DBInstanceIdentifier: aws.String("instance"),
+				KMSKeyID:             aws.String("alias/snapshots"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCopySource(&resource{ko: &svcapitypes.DBSnapshot{Spec: tt.spec}})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			var terminal *ackerr.TerminalError
+			assert.ErrorAs(t, err, &terminal)
+		})
+	}
+}
+
+func TestSdkCreateRejectsInvalidCopySource(t *testing.T) {
+	rm, api := newTestManager()
+	r := copyResource()
+	r.ko.Spec.DBInstanceIdentifier = aws.String("instance")
+
+	_, err := rm.sdkCreate(context.Background(), r)
+	var terminal *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminal)
+	assert.Empty(t, api.Operations())
+}
+
+func TestImmutableFieldChanged(t *testing.T) {
+	delta := ackcompare.NewDelta()
+	assert.NoError(t, immutableFieldChanged(delta))
+
+	delta.Add("Spec.Tags", nil, nil)
+	assert.NoError(t, immutableFieldChanged(delta))
+
+	delta.Add("Spec.SourceDBSnapshotARN", aws.String("a"), aws.String("b"))
+	err := immutableFieldChanged(delta)
+	var terminal *ackerr.TerminalError
+	require.ErrorAs(t, err, &terminal)
+	assert.Contains(t, err.Error(), "Spec.SourceDBSnapshotARN")
+}
+
+func TestSyncSharedAccounts(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	api.DBSnapshots["copy"].Status = aws.String(SnapshotStatusAvailable)
+	api.DBSnapshotAttributes["copy"] = map[string][]string{
+		"restore": {"333333333333", "444444444444"},
+	}
+
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"333333333333", "444444444444"}, aws.ToStringSlice(latest.ko.Spec.SharedAccounts))
+
+	desired := copyResource()
+	desired.ko.Spec.SharedAccounts = aws.StringSlice([]string{"444444444444", "333333333333"})
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt("Spec.SharedAccounts"))
+
+	desired.ko.Spec.SharedAccounts = aws.StringSlice([]string{"444444444444", "all"})
+	delta := newResourceDelta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.SharedAccounts"))
+
+	_, err = rm.sdkUpdate(ctx, desired, latest, delta)
+	require.NoError(t, err)
+	calls := api.CallsTo("ModifyDBSnapshotAttribute")
+	require.Len(t, calls, 1)
+	input := calls[0].(*svcsdk.ModifyDBSnapshotAttributeInput)
+	assert.Equal(t, "restore", *input.AttributeName)
+	assert.Equal(t, "copy", *input.DBSnapshotIdentifier)
+	assert.Equal(t, []string{"all"}, input.ValuesToAdd)
+	assert.Equal(t, []string{"333333333333"}, input.ValuesToRemove)
+	assert.Empty(t, api.CallsTo("ModifyDBSnapshot"))
+
+	latest, err = rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt("Spec.SharedAccounts"))
+}
+
+func TestSyncSharedAccountsWaitsForAvailableSnapshot(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	_, err := rm.sdkCreate(ctx, copyResource())
+	require.NoError(t, err)
+	latest, err := rm.sdkFind(ctx, copyResource())
+	require.NoError(t, err)
+
+	desired := copyResource()
+	desired.ko.Spec.SharedAccounts = aws.StringSlice([]string{"333333333333"})
+	_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Error(t, err)
+	assert.Empty(t, api.CallsTo("ModifyDBSnapshotAttribute"))
+}
@@ -151,9 +151,9 @@ func (rm *resourceManager) sdkFind(
 			ko.Status.IOPS = nil
 		}
 		if elem.KmsKeyId != nil {
-			ko.Status.KMSKeyID = elem.KmsKeyId
+			ko.Spec.KMSKeyID = elem.KmsKeyId
 		} else {
-			ko.Status.KMSKeyID = nil
+			ko.Spec.KMSKeyID = nil
 		}
 		if elem.LicenseModel != nil {
 			ko.Status.LicenseModel = elem.LicenseModel
@@ -256,9 +256,9 @@ func (rm *resourceManager) sdkFind(
 				}
 				f27 = append(f27, f27elem)
 			}
-			ko.Status.TagList = f27
+			ko.Spec.Tags = f27
 		} else {
-			ko.Status.TagList = nil
+			ko.Spec.Tags = nil
 		}
 		if elem.TdeCredentialArn != nil {
 			ko.Status.TDECredentialARN = elem.TdeCredentialArn
@@ -284,17 +284,21 @@ func (rm *resourceManager) sdkFind(
 
 	rm.setStatusDefaults(ko)
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
+		sharedAccounts, err := rm.getSharedAccounts(ctx, ko.Spec.DBSnapshotIdentifier)
 		if err != nil {
 			return nil, err
 		}
-		ko.Spec.Tags = tags
+		ko.Spec.SharedAccounts = sharedAccounts
+	}
+	if ko.Spec.SourceDBSnapshotARN != nil {
+		// RDS returns the DB instance of the source snapshot of a copy, which
+		// isn't the source of this resource.
+		ko.Spec.DBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
 	}
 	if !snapshotAvailable(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
-		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, snapshotProgressMessage(&resource{ko}), nil)
 	}
 
 	return &resource{ko}, nil
@@ -344,6 +348,14 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := validateCopySource(desired); err != nil {
+		return nil, err
+	}
+	// if request has SourceDBSnapshotARN spec, create request will call CopyDBSnapshot
+	// instead of normal create api
+	if desired.ko.Spec.SourceDBSnapshotARN != nil {
+		return rm.copyDBSnapshot(ctx, desired)
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -420,9 +432,9 @@ func (rm *resourceManager) sdkCreate(
 		ko.Status.IOPS = nil
 	}
 	if resp.DBSnapshot.KmsKeyId != nil {
-		ko.Status.KMSKeyID = resp.DBSnapshot.KmsKeyId
+		ko.Spec.KMSKeyID = resp.DBSnapshot.KmsKeyId
 	} else {
-		ko.Status.KMSKeyID = nil
+		ko.Spec.KMSKeyID = nil
 	}
 	if resp.DBSnapshot.LicenseModel != nil {
 		ko.Status.LicenseModel = resp.DBSnapshot.LicenseModel
@@ -604,6 +616,9 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if err = immutableFieldChanged(delta); err != nil {
+		return nil, err
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
@@ -612,7 +627,18 @@ func (rm *resourceManager) sdkUpdate(
 	if !snapshotAvailable(latest) {
 		msg := "DB instance cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if delta.DifferentAt("Spec.SharedAccounts") {
+		if err = rm.syncSharedAccounts(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	// ModifyDBSnapshot only upgrades the engine version or option group of a
+	// DB snapshot, so there's nothing left to do if only the tags or the
+	// shared accounts changed.
+	if !delta.DifferentExcept("Spec.Tags", "Spec.SharedAccounts") {
+		return desired, nil
 	}
 
 	input, err := rm.newUpdateRequestPayload(ctx, desired, delta)
@@ -691,9 +717,9 @@ func (rm *resourceManager) sdkUpdate(
 		ko.Status.IOPS = nil
 	}
 	if resp.DBSnapshot.KmsKeyId != nil {
-		ko.Status.KMSKeyID = resp.DBSnapshot.KmsKeyId
+		ko.Spec.KMSKeyID = resp.DBSnapshot.KmsKeyId
 	} else {
-		ko.Status.KMSKeyID = nil
+		ko.Spec.KMSKeyID = nil
 	}
 	if resp.DBSnapshot.LicenseModel != nil {
 		ko.Status.LicenseModel = resp.DBSnapshot.LicenseModel
@@ -976,3 +1002,246 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	// No terminal_errors specified for this resource in generator config
 	return false
 }
+
+// newCopyDBSnapshotInput returns a CopyDBSnapshotInput object
+// with each the field set by the corresponding configuration's fields.
+func (rm *resourceManager) newCopyDBSnapshotInput(
+	r *resource,
+) (*svcsdk.CopyDBSnapshotInput, error) {
+	res := &svcsdk.CopyDBSnapshotInput{}
+
+	if r.ko.Spec.CopyOptionGroup != nil {
+		res.CopyOptionGroup = r.ko.Spec.CopyOptionGroup
+	}
+	if r.ko.Spec.CopyTags != nil {
+		res.CopyTags = r.ko.Spec.CopyTags
+	}
+	if r.ko.Spec.KMSKeyID != nil {
+		res.KmsKeyId = r.ko.Spec.KMSKeyID
+	}
+	if r.ko.Spec.OptionGroupName != nil {
+		res.OptionGroupName = r.ko.Spec.OptionGroupName
+	}
+	if r.ko.Spec.PreSignedURL != nil {
+		res.PreSignedUrl = r.ko.Spec.PreSignedURL
+	}
+	if r.ko.Spec.SourceDBSnapshotARN != nil {
+		res.SourceDBSnapshotIdentifier = r.ko.Spec.SourceDBSnapshotARN
+	}
+	if r.ko.Spec.SourceDBSnapshotRegion != nil {
+		res.SourceRegion = r.ko.Spec.SourceDBSnapshotRegion
+	}
+	if r.ko.Spec.Tags != nil {
+		resf7 := []svcsdktypes.Tag{}
+		for _, resf7iter := range r.ko.Spec.Tags {
+			resf7elem := &svcsdktypes.Tag{}
+			if resf7iter.Key != nil {
+				resf7elem.Key = resf7iter.Key
+			}
+			if resf7iter.Value != nil {
+				resf7elem.Value = resf7iter.Value
+			}
+			resf7 = append(resf7, *resf7elem)
+		}
+		res.Tags = resf7
+	}
+	if r.ko.Spec.DBSnapshotIdentifier != nil {
+		res.TargetDBSnapshotIdentifier = r.ko.Spec.DBSnapshotIdentifier
+	}
+
+	return res, nil
+}
+
+// setResourceFromCopyDBSnapshotOutput sets a resource CopyDBSnapshotOutput type
+// given the SDK type.
+func (rm *resourceManager) setResourceFromCopyDBSnapshotOutput(
+	r *resource,
+	resp *svcsdk.CopyDBSnapshotOutput,
+) {
+
+	if resp.DBSnapshot.AllocatedStorage != nil {
+		allocatedStorageCopy := int64(*resp.DBSnapshot.AllocatedStorage)
+		r.ko.Status.AllocatedStorage = &allocatedStorageCopy
+	} else {
+		r.ko.Status.AllocatedStorage = nil
+	}
+	if resp.DBSnapshot.AvailabilityZone != nil {
+		r.ko.Status.AvailabilityZone = resp.DBSnapshot.AvailabilityZone
+	} else {
+		r.ko.Status.AvailabilityZone = nil
+	}
+	if resp.DBSnapshot.DBInstanceIdentifier != nil {
+		r.ko.Spec.DBInstanceIdentifier = resp.DBSnapshot.DBInstanceIdentifier
+	} else {
+		r.ko.Spec.DBInstanceIdentifier = nil
+	}
+	if r.ko.Status.ACKResourceMetadata == nil {
+		r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if resp.DBSnapshot.DBSnapshotArn != nil {
+		arn := ackv1alpha1.AWSResourceName(*resp.DBSnapshot.DBSnapshotArn)
+		r.ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if resp.DBSnapshot.DBSnapshotIdentifier != nil {
+		r.ko.Spec.DBSnapshotIdentifier = resp.DBSnapshot.DBSnapshotIdentifier
+	} else {
+		r.ko.Spec.DBSnapshotIdentifier = nil
+	}
+	if resp.DBSnapshot.DbiResourceId != nil {
+		r.ko.Status.DBIResourceID = resp.DBSnapshot.DbiResourceId
+	} else {
+		r.ko.Status.DBIResourceID = nil
+	}
+	if resp.DBSnapshot.Encrypted != nil {
+		r.ko.Status.Encrypted = resp.DBSnapshot.Encrypted
+	} else {
+		r.ko.Status.Encrypted = nil
+	}
+	if resp.DBSnapshot.Engine != nil {
+		r.ko.Status.Engine = resp.DBSnapshot.Engine
+	} else {
+		r.ko.Status.Engine = nil
+	}
+	if resp.DBSnapshot.IAMDatabaseAuthenticationEnabled != nil {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = resp.DBSnapshot.IAMDatabaseAuthenticationEnabled
+	} else {
+		r.ko.Status.IAMDatabaseAuthenticationEnabled = nil
+	}
+	if resp.DBSnapshot.InstanceCreateTime != nil {
+		r.ko.Status.InstanceCreateTime = &metav1.Time{*resp.DBSnapshot.InstanceCreateTime}
+	} else {
+		r.ko.Status.InstanceCreateTime = nil
+	}
+	if resp.DBSnapshot.Iops != nil {
+		iopsCopy := int64(*resp.DBSnapshot.Iops)
+		r.ko.Status.IOPS = &iopsCopy
+	} else {
+		r.ko.Status.IOPS = nil
+	}
+	if resp.DBSnapshot.KmsKeyId != nil {
+		r.ko.Spec.KMSKeyID = resp.DBSnapshot.KmsKeyId
+	} else {
+		r.ko.Spec.KMSKeyID = nil
+	}
+	if resp.DBSnapshot.LicenseModel != nil {
+		r.ko.Status.LicenseModel = resp.DBSnapshot.LicenseModel
+	} else {
+		r.ko.Status.LicenseModel = nil
+	}
+	if resp.DBSnapshot.MasterUsername != nil {
+		r.ko.Status.MasterUsername = resp.DBSnapshot.MasterUsername
+	} else {
+		r.ko.Status.MasterUsername = nil
+	}
+	if resp.DBSnapshot.OriginalSnapshotCreateTime != nil {
+		r.ko.Status.OriginalSnapshotCreateTime = &metav1.Time{*resp.DBSnapshot.OriginalSnapshotCreateTime}
+	} else {
+		r.ko.Status.OriginalSnapshotCreateTime = nil
+	}
+	if resp.DBSnapshot.PercentProgress != nil {
+		percentProgressCopy := int64(*resp.DBSnapshot.PercentProgress)
+		r.ko.Status.PercentProgress = &percentProgressCopy
+	} else {
+		r.ko.Status.PercentProgress = nil
+	}
+	if resp.DBSnapshot.Port != nil {
+		portCopy := int64(*resp.DBSnapshot.Port)
+		r.ko.Status.Port = &portCopy
+	} else {
+		r.ko.Status.Port = nil
+	}
+	if resp.DBSnapshot.ProcessorFeatures != nil {
+		f17 := []*svcapitypes.ProcessorFeature{}
+		for _, f17iter := range resp.DBSnapshot.ProcessorFeatures {
+			f17elem := &svcapitypes.ProcessorFeature{}
+			if f17iter.Name != nil {
+				f17elem.Name = f17iter.Name
+			}
+			if f17iter.Value != nil {
+				f17elem.Value = f17iter.Value
+			}
+			f17 = append(f17, f17elem)
+		}
+		r.ko.Status.ProcessorFeatures = f17
+	} else {
+		r.ko.Status.ProcessorFeatures = nil
+	}
+	if resp.DBSnapshot.SnapshotCreateTime != nil {
+		r.ko.Status.SnapshotCreateTime = &metav1.Time{*resp.DBSnapshot.SnapshotCreateTime}
+	} else {
+		r.ko.Status.SnapshotCreateTime = nil
+	}
+	if resp.DBSnapshot.SnapshotDatabaseTime != nil {
+		r.ko.Status.SnapshotDatabaseTime = &metav1.Time{*resp.DBSnapshot.SnapshotDatabaseTime}
+	} else {
+		r.ko.Status.SnapshotDatabaseTime = nil
+	}
+	if resp.DBSnapshot.SnapshotTarget != nil {
+		r.ko.Status.SnapshotTarget = resp.DBSnapshot.SnapshotTarget
+	} else {
+		r.ko.Status.SnapshotTarget = nil
+	}
+	if resp.DBSnapshot.SnapshotType != nil {
+		r.ko.Status.SnapshotType = resp.DBSnapshot.SnapshotType
+	} else {
+		r.ko.Status.SnapshotType = nil
+	}
+	if resp.DBSnapshot.SourceDBSnapshotIdentifier != nil {
+		r.ko.Status.SourceDBSnapshotIdentifier = resp.DBSnapshot.SourceDBSnapshotIdentifier
+	} else {
+		r.ko.Status.SourceDBSnapshotIdentifier = nil
+	}
+	if resp.DBSnapshot.SourceRegion != nil {
+		r.ko.Status.SourceRegion = resp.DBSnapshot.SourceRegion
+	} else {
+		r.ko.Status.SourceRegion = nil
+	}
+	if resp.DBSnapshot.Status != nil {
+		r.ko.Status.Status = resp.DBSnapshot.Status
+	} else {
+		r.ko.Status.Status = nil
+	}
+	if resp.DBSnapshot.StorageThroughput != nil {
+		storageThroughputCopy := int64(*resp.DBSnapshot.StorageThroughput)
+		r.ko.Status.StorageThroughput = &storageThroughputCopy
+	} else {
+		r.ko.Status.StorageThroughput = nil
+	}
+	if resp.DBSnapshot.StorageType != nil {
+		r.ko.Status.StorageType = resp.DBSnapshot.StorageType
+	} else {
+		r.ko.Status.StorageType = nil
+	}
+	if resp.DBSnapshot.TagList != nil {
+		f27 := []*svcapitypes.Tag{}
+		for _, f27iter := range resp.DBSnapshot.TagList {
+			f27elem := &svcapitypes.Tag{}
+			if f27iter.Key != nil {
+				f27elem.Key = f27iter.Key
+			}
+			if f27iter.Value != nil {
+				f27elem.Value = f27iter.Value
+			}
+			f27 = append(f27, f27elem)
+		}
+		r.ko.Status.TagList = f27
+	} else {
+		r.ko.Status.TagList = nil
+	}
+	if resp.DBSnapshot.TdeCredentialArn != nil {
+		r.ko.Status.TDECredentialARN = resp.DBSnapshot.TdeCredentialArn
+	} else {
+		r.ko.Status.TDECredentialARN = nil
+	}
+	if resp.DBSnapshot.Timezone != nil {
+		r.ko.Status.Timezone = resp.DBSnapshot.Timezone
+	} else {
+		r.ko.Status.Timezone = nil
+	}
+	if resp.DBSnapshot.VpcId != nil {
+		r.ko.Status.VPCID = resp.DBSnapshot.VpcId
+	} else {
+		r.ko.Status.VPCID = nil
+	}
+
+}
@@ -0,0 +1,66 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resource
+
+import (
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/requeue"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// Dependencies are the components the controller builds at startup, from its
+// flags, and that the resource manager factories hand to the resource
+// managers they produce.
+type Dependencies struct {
+	// TagReconciler reconciles the tags of the resources.
+	TagReconciler *util.TagReconciler
+	// RequeuePolicy chooses how long to wait before checking again on the
+	// resources that cannot be modified because of their status.
+	RequeuePolicy *requeue.Policy
+	// ConnectionWriter publishes the connection details of the DB instances
+	// and DB clusters. It needs the clients of the controller manager, so it
+	// isn't set by DefaultDependencies.
+	ConnectionWriter *connection.Writer
+	// MasterUserSecretSyncers returns the Syncers that copy the master user
+	// secrets of the DB instances and DB clusters. Like ConnectionWriter, it
+	// isn't set by DefaultDependencies.
+	MasterUserSecretSyncers secrets.SyncerFactory
+}
+
+// DefaultDependencies returns the Dependencies configured with the default
+// values of the controller flags.
+func DefaultDependencies() Dependencies {
+	return Dependencies{
+		TagReconciler: util.NewDefaultTagReconciler(),
+		RequeuePolicy: requeue.NewDefaultPolicy(),
+	}
+}
+
+// dependentFactory is implemented by the resource manager factories of the
+// package's registry.
+type dependentFactory interface {
+	SetDependencies(deps Dependencies)
+}
+
+// SetDependencies hands the supplied Dependencies to the resource manager
+// factories registered with the package's registry. It must be called before
+// the factories are bound to the controller manager.
+func SetDependencies(deps Dependencies) {
+	for _, f := range GetManagerFactories() {
+		if df, ok := f.(dependentFactory); ok {
+			df.SetDependencies(deps)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package resource_test
+
+import (
+	"testing"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+	_ "github.com/aws-controllers-k8s/rds-controller/pkg/resource/db_subnet_group"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func subnetGroupFactory(t *testing.T) acktypes.AWSResourceManagerFactory {
+	for _, f := range svcresource.GetManagerFactories() {
+		if f.ResourceDescriptor().GroupVersionKind().Kind == "DBSubnetGroup" {
+			return f
+		}
+	}
+	require.FailNow(t, "no DBSubnetGroup resource manager factory")
+	return nil
+}
+
+func subnetGroup(tags ...string) *svcapitypes.DBSubnetGroup {
+	obj := &svcapitypes.DBSubnetGroup{}
+	for _, key := range tags {
+		obj.Spec.Tags = append(obj.Spec.Tags, &svcapitypes.Tag{
+			Key: aws.String(key), Value: aws.String("1"),
+		})
+	}
+	return obj
+}
+
+func TestSetDependencies(t *testing.T) {
+	t.Cleanup(func() { svcresource.SetDependencies(svcresource.DefaultDependencies()) })
+	f := subnetGroupFactory(t)
+	differentTags := func() bool {
+		rd := f.ResourceDescriptor()
+		desired := rd.ResourceFromRuntimeObject(subnetGroup("env"))
+		latest := rd.ResourceFromRuntimeObject(subnetGroup("env", "team"))
+		return rd.Delta(desired, latest).DifferentAt("Spec.Tags")
+	}
+	assert.True(t, differentTags())
+
+	tags, err := util.NewTagReconciler(util.TagReconcilerOptions{IgnoredTagKeys: []string{"team"}})
+	require.NoError(t, err)
+	deps := svcresource.DefaultDependencies()
+	deps.TagReconciler = tags
+	svcresource.SetDependencies(deps)
+	assert.False(t, differentTags())
+}
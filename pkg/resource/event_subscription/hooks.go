@@ -0,0 +1,244 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package event_subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+// The RDS API doesn't define an enum for the status of an event
+// subscription. These are the values documented for EventSubscription.Status.
+const (
+	StatusActive         = "active"
+	StatusCreating       = "creating"
+	StatusDeleting       = "deleting"
+	StatusModifying      = "modifying"
+	StatusNoPermission   = "no-permission"
+	StatusTopicNotExists = "topic-not-exist"
+)
+
+var (
+	// modifiableFields are the fields that ModifyEventSubscription changes.
+	// Source identifiers are added and removed with their own operations.
+	modifiableFields = []string{
+		"Spec.Enabled",
+		"Spec.EventCategories",
+		"Spec.SNSTopicARN",
+		"Spec.SourceType",
+	}
+)
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		errors.New("event subscription in 'deleting' state, cannot be modified or deleted."),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the event subscription cannot be modified until it reaches an
+// active status.
+func requeueWaitUntilCanModify(r *resource) *ackrequeue.RequeueNeededAfter {
+	if r.ko.Status.Status == nil {
+		return nil
+	}
+	status := *r.ko.Status.Status
+	msg := fmt.Sprintf(
+		"event subscription in '%s' state, cannot be modified until '%s'.",
+		status, StatusActive,
+	)
+	return ackrequeue.NeededAfter(
+		errors.New(msg),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// subscriptionActive returns true if the supplied event subscription is in
+// the active status
+func subscriptionActive(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusActive
+}
+
+// subscriptionCreating returns true if the supplied event subscription is in
+// the process of being created
+func subscriptionCreating(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusCreating
+}
+
+// subscriptionDeleting returns true if the supplied event subscription is in
+// the process of being deleted
+func subscriptionDeleting(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusDeleting
+}
+
+// subscriptionBusy returns true if the supplied event subscription is being
+// created or modified. The 'no-permission' and 'topic-not-exist' statuses
+// aren't transitional, and the subscription may be modified to point at
+// another SNS topic to get out of them.
+func subscriptionBusy(r *resource) bool {
+	if r.ko.Status.Status == nil {
+		return false
+	}
+	status := *r.ko.Status.Status
+	return status == StatusCreating || status == StatusModifying
+}
+
+// subscriptionAttributesChanged returns true if the delta contains a field
+// that has to be changed with ModifyEventSubscription
+func subscriptionAttributesChanged(delta *ackcompare.Delta) bool {
+	for _, path := range modifiableFields {
+		if delta.DifferentAt(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeSourceIDs removes the source identifiers that are in the latest
+// subscription but not in the desired one.
+func (rm *resourceManager) removeSourceIDs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.removeSourceIDs")
+	defer func() { exit(err) }()
+
+	_, toRemove := util.ComputeStringSetDelta(
+		desired.ko.Spec.SourceIDs, latest.ko.Spec.SourceIDs,
+	)
+	for _, id := range toRemove {
+		rlog.Debug("removing source identifier from event subscription", "source_id", id)
+		_, err = rm.sdkapi.RemoveSourceIdentifierFromSubscription(
+			ctx,
+			&svcsdk.RemoveSourceIdentifierFromSubscriptionInput{
+				SubscriptionName: desired.ko.Spec.Name,
+				SourceIdentifier: aws.String(id),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveSourceIdentifierFromSubscription", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSourceIDs adds the source identifiers that are in the desired
+// subscription but not in the latest one.
+func (rm *resourceManager) addSourceIDs(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.addSourceIDs")
+	defer func() { exit(err) }()
+
+	toAdd, _ := util.ComputeStringSetDelta(
+		desired.ko.Spec.SourceIDs, latest.ko.Spec.SourceIDs,
+	)
+	for _, id := range toAdd {
+		rlog.Debug("adding source identifier to event subscription", "source_id", id)
+		_, err = rm.sdkapi.AddSourceIdentifierToSubscription(
+			ctx,
+			&svcsdk.AddSourceIdentifierToSubscriptionInput{
+				SubscriptionName: desired.ko.Spec.Name,
+				SourceIdentifier: aws.String(id),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "AddSourceIdentifierToSubscription", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compareStringSets adds a difference to the delta at the supplied path if
+// the two lists don't contain the same strings. RDS doesn't preserve the
+// order of the event categories and source identifiers of a subscription.
+func compareStringSets(
+	delta *ackcompare.Delta,
+	path string,
+	a []*string,
+	b []*string,
+) {
+	if !util.EqualStringSets(a, b) {
+		delta.Add(path, a, b)
+	}
+}
+
+// syncTags keeps the resource's tags in sync
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return rm.tagReconciler.Get(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+// compareTags adds a difference to the delta if the supplied resources have
+// different tag collections, as reconciled by the supplied TagReconciler
+func compareTags(
+	tags *util.TagReconciler,
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
+}
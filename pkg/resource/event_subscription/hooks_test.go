@@ -0,0 +1,360 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package event_subscription
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	svcresource "github.com/aws-controllers-k8s/rds-controller/pkg/resource"
+)
+
+const testTopicARN = "arn:aws:sns:us-west-2:123456789012:rds-events"
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+// descriptorDelta returns the difference between the supplied resources, as
+// computed by the descriptor of a factory with the default Dependencies.
+func descriptorDelta(a, b *resource) *ackcompare.Delta {
+	rd := &resourceDescriptor{deps: svcresource.DefaultDependencies()}
+	return rd.Delta(a, b)
+}
+
+func subscriptionResource() *resource {
+	return &resource{ko: &svcapitypes.EventSubscription{
+		Spec: svcapitypes.EventSubscriptionSpec{
+			Name:            aws.String("events"),
+			SNSTopicARN:     aws.String(testTopicARN),
+			SourceType:      aws.String(string(svcapitypes.SourceType_db_instance)),
+			SourceIDs:       aws.StringSlice([]string{"db-a", "db-b"}),
+			EventCategories: aws.StringSlice([]string{"failover", "failure"}),
+			Tags: []*svcapitypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("data")},
+			},
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSdkCreateAndFind(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	created, err := rm.sdkCreate(ctx, subscriptionResource())
+	require.NoError(t, err)
+	assert.Equal(t, StatusCreating, *created.ko.Status.Status)
+	require.NotNil(t, syncedCondition(created))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(created).Status)
+
+	api.EventSubscriptions["events"].Status = aws.String(StatusActive)
+	latest, err := rm.sdkFind(ctx, subscriptionResource())
+	require.NoError(t, err)
+	assert.Nil(t, syncedCondition(latest))
+	assert.True(t, *latest.ko.Spec.Enabled)
+	assert.Equal(t, "events", *latest.ko.Status.CustSubscriptionID)
+	assert.Equal(t, []string{"db-a", "db-b"}, aws.ToStringSlice(latest.ko.Spec.SourceIDs))
+	assert.Equal(t, "data", *latest.ko.Spec.Tags[0].Value)
+
+	_, err = rm.sdkFind(ctx, &resource{ko: &svcapitypes.EventSubscription{
+		Spec: svcapitypes.EventSubscriptionSpec{Name: aws.String("missing")},
+	}})
+	assert.Equal(t, ackerr.NotFound, err)
+}
+
+func TestNewResourceDelta_UnorderedLists(t *testing.T) {
+	a := subscriptionResource()
+	b := subscriptionResource()
+	b.ko.Spec.SourceIDs = aws.StringSlice([]string{"db-b", "db-a"})
+	b.ko.Spec.EventCategories = aws.StringSlice([]string{"failure", "failover"})
+	assert.Empty(t, newResourceDelta(a, b).Differences)
+
+	b.ko.Spec.SourceIDs = aws.StringSlice([]string{"db-a"})
+	assert.True(t, newResourceDelta(a, b).DifferentAt("Spec.SourceIDs"))
+}
+
+func TestSdkUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      string
+		mutate      func(r *resource)
+		wantErr     bool
+		wantOps     []string
+		wantSources []string
+	}{
+		{
+			name:   "modifying",
+			status: StatusModifying,
+			mutate: func(r *resource) {
+				r.ko.Spec.SourceIDs = aws.StringSlice([]string{"db-c"})
+			},
+			wantErr:     true,
+			wantSources: []string{"db-a", "db-b"},
+		},
+		{
+			name:   "source ids changed",
+			status: StatusActive,
+			mutate: func(r *resource) {
+				r.ko.Spec.SourceIDs = aws.StringSlice([]string{"db-b", "db-c"})
+			},
+			wantOps: []string{
+				"RemoveSourceIdentifierFromSubscription",
+				"AddSourceIdentifierToSubscription",
+			},
+			wantSources: []string{"db-b", "db-c"},
+		},
+		{
+			name:   "event categories changed",
+			status: StatusActive,
+			mutate: func(r *resource) {
+				r.ko.Spec.EventCategories = aws.StringSlice([]string{"deletion"})
+			},
+			wantOps:     []string{"ModifyEventSubscription"},
+			wantSources: []string{"db-a", "db-b"},
+		},
+		{
+			// The sources of the previous type are removed before the
+			// subscription is modified and the new ones are added once it
+			// is active again.
+			name:   "source type changed",
+			status: StatusActive,
+			mutate: func(r *resource) {
+				r.ko.Spec.SourceType = aws.String(string(svcapitypes.SourceType_db_cluster))
+				r.ko.Spec.SourceIDs = aws.StringSlice([]string{"cluster-a"})
+			},
+			wantOps: []string{
+				"RemoveSourceIdentifierFromSubscription",
+				"RemoveSourceIdentifierFromSubscription",
+				"ModifyEventSubscription",
+			},
+			wantSources: []string{},
+		},
+		{
+			name:   "topic changed without permission on the old topic",
+			status: StatusNoPermission,
+			mutate: func(r *resource) {
+				r.ko.Spec.SNSTopicARN = aws.String(testTopicARN + "-new")
+			},
+			wantOps:     []string{"ModifyEventSubscription"},
+			wantSources: []string{"db-a", "db-b"},
+		},
+		{
+			name:   "only tags changed",
+			status: StatusActive,
+			mutate: func(r *resource) {
+				r.ko.Spec.Tags = []*svcapitypes.Tag{
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				}
+			},
+			wantOps:     []string{"RemoveTagsFromResource", "AddTagsToResource"},
+			wantSources: []string{"db-a", "db-b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestManager()
+			ctx := context.Background()
+			_, err := rm.sdkCreate(ctx, subscriptionResource())
+			require.NoError(t, err)
+			api.EventSubscriptions["events"].Status = aws.String(tt.status)
+			latest, err := rm.sdkFind(ctx, subscriptionResource())
+			require.NoError(t, err)
+			api.Calls = nil
+
+			desired := &resource{ko: latest.ko.DeepCopy()}
+			tt.mutate(desired)
+			delta := descriptorDelta(desired, latest)
+			_, err = rm.sdkUpdate(ctx, desired, latest, delta)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if len(tt.wantOps) == 0 {
+				assert.Empty(t, api.Operations())
+			} else {
+				assert.Equal(t, tt.wantOps, api.Operations())
+			}
+			assert.ElementsMatch(t, tt.wantSources, api.EventSubscriptions["events"].SourceIdsList)
+		})
+	}
+}
+
+func TestSdkUpdate_AddsSourcesAfterModify(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	_, err := rm.sdkCreate(ctx, subscriptionResource())
+	require.NoError(t, err)
+	api.EventSubscriptions["events"].Status = aws.String(StatusActive)
+	latest, err := rm.sdkFind(ctx, subscriptionResource())
+	require.NoError(t, err)
+
+	desired := &resource{ko: latest.ko.DeepCopy()}
+	desired.ko.Spec.SourceType = aws.String(string(svcapitypes.SourceType_db_cluster))
+	desired.ko.Spec.SourceIDs = aws.StringSlice([]string{"cluster-a"})
+	updated, err := rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-a"}, aws.ToStringSlice(updated.ko.Spec.SourceIDs))
+	require.NotNil(t, syncedCondition(updated))
+	assert.Equal(t, corev1.ConditionFalse, syncedCondition(updated).Status)
+
+	api.EventSubscriptions["events"].Status = aws.String(StatusActive)
+	latest, err = rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	api.Calls = nil
+	_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"AddSourceIdentifierToSubscription"}, api.Operations())
+	assert.Equal(t, []string{"cluster-a"}, api.EventSubscriptions["events"].SourceIdsList)
+}
+
+func TestSdkDelete(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	_, err := rm.sdkCreate(ctx, subscriptionResource())
+	require.NoError(t, err)
+
+	_, err = rm.sdkDelete(ctx, subscriptionResource())
+	require.NoError(t, err)
+	assert.Equal(t, StatusDeleting, *api.EventSubscriptions["events"].Status)
+
+	latest, err := rm.sdkFind(ctx, subscriptionResource())
+	require.NoError(t, err)
+	api.Calls = nil
+	_, err = rm.sdkDelete(ctx, latest)
+	assert.Error(t, err)
+	assert.Empty(t, api.Operations())
+}
+
+// fakeReader is a client.Reader that returns the objects it holds by name.
+type fakeReader struct {
+	client.Reader
+	objects map[string]client.Object
+}
+
+func (r *fakeReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	src, ok := r.objects[key.Name]
+	if !ok {
+		return ackerr.NotFound
+	}
+	switch o := obj.(type) {
+	case *svcapitypes.DBInstance:
+		*o = *src.(*svcapitypes.DBInstance)
+	case *svcapitypes.DBCluster:
+		*o = *src.(*svcapitypes.DBCluster)
+	}
+	return nil
+}
+
+func syncedConditions() []*ackv1alpha1.Condition {
+	return []*ackv1alpha1.Condition{{
+		Type:   ackv1alpha1.ConditionTypeResourceSynced,
+		Status: corev1.ConditionTrue,
+	}}
+}
+
+func TestResolveReferences(t *testing.T) {
+	rm, _ := newTestManager()
+	ctx := context.Background()
+	reader := &fakeReader{objects: map[string]client.Object{
+		"instance": &svcapitypes.DBInstance{
+			Spec:   svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("db-a")},
+			Status: svcapitypes.DBInstanceStatus{Conditions: syncedConditions()},
+		},
+		"pending": &svcapitypes.DBInstance{
+			Spec: svcapitypes.DBInstanceSpec{DBInstanceIdentifier: aws.String("db-b")},
+		},
+		"cluster": &svcapitypes.DBCluster{
+			Spec:   svcapitypes.DBClusterSpec{DBClusterIdentifier: aws.String("cluster-a")},
+			Status: svcapitypes.DBClusterStatus{Conditions: syncedConditions()},
+		},
+	}}
+	refTo := func(names ...string) []*ackv1alpha1.AWSResourceReferenceWrapper {
+		refs := []*ackv1alpha1.AWSResourceReferenceWrapper{}
+		for _, name := range names {
+			refs = append(refs, &ackv1alpha1.AWSResourceReferenceWrapper{
+				From: &ackv1alpha1.AWSResourceReference{Name: aws.String(name)},
+			})
+		}
+		return refs
+	}
+
+	r := subscriptionResource()
+	r.ko.Spec.SourceIDs = nil
+	r.ko.Spec.SourceRefs = refTo("instance")
+	resolved, hasRefs, err := rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.True(t, hasRefs)
+	assert.Equal(t, []string{"db-a"}, aws.ToStringSlice(rm.concreteResource(resolved).ko.Spec.SourceIDs))
+	cleared := rm.ClearResolvedReferences(resolved)
+	assert.Nil(t, rm.concreteResource(cleared).ko.Spec.SourceIDs)
+
+	r = subscriptionResource()
+	r.ko.Spec.SourceIDs = nil
+	r.ko.Spec.SourceType = aws.String(string(svcapitypes.SourceType_db_cluster))
+	r.ko.Spec.SourceRefs = refTo("cluster")
+	resolved, _, err = rm.ResolveReferences(ctx, reader, r)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cluster-a"}, aws.ToStringSlice(rm.concreteResource(resolved).ko.Spec.SourceIDs))
+
+	// A source that isn't synced yet isn't resolved.
+	r = subscriptionResource()
+	r.ko.Spec.SourceIDs = nil
+	r.ko.Spec.SourceRefs = refTo("instance", "pending")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+
+	// References and identifiers can't both be set.
+	r = subscriptionResource()
+	r.ko.Spec.SourceRefs = refTo("instance")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	assert.Error(t, err)
+
+	// Only some source types can be referenced.
+	r = subscriptionResource()
+	r.ko.Spec.SourceIDs = nil
+	r.ko.Spec.SourceType = aws.String(string(svcapitypes.SourceType_db_proxy))
+	r.ko.Spec.SourceRefs = refTo("instance")
+	_, _, err = rm.ResolveReferences(ctx, reader, r)
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+}
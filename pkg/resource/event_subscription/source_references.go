@@ -0,0 +1,362 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package event_subscription
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// validateSourceRefs validates the SourceRefs field, which can't be set with
+// SourceIDs and needs a SourceType whose resources can be referenced.
+func validateSourceRefs(ko *svcapitypes.EventSubscription) error {
+	if len(ko.Spec.SourceRefs) > 0 && len(ko.Spec.SourceIDs) > 0 {
+		return ackerr.ResourceReferenceAndIDNotSupportedFor("SourceIDs", "SourceRefs")
+	}
+	if len(ko.Spec.SourceRefs) > 0 {
+		switch svcapitypes.SourceType(aws.ToString(ko.Spec.SourceType)) {
+		case svcapitypes.SourceType_db_cluster,
+			svcapitypes.SourceType_db_instance,
+			svcapitypes.SourceType_db_parameter_group,
+			svcapitypes.SourceType_db_snapshot:
+		default:
+			return ackerr.NewTerminalError(fmt.Errorf(
+				"SourceRefs requires SourceType to be one of %s, %s, %s or %s",
+				svcapitypes.SourceType_db_instance,
+				svcapitypes.SourceType_db_cluster,
+				svcapitypes.SourceType_db_parameter_group,
+				svcapitypes.SourceType_db_snapshot,
+			))
+		}
+	}
+	return nil
+}
+
+// resolveReferenceForSourceIDs reads the resources referenced from the
+// SourceRefs field and sets the SourceIDs from the referenced resources. The
+// kind of the referenced resources is given by SourceType. Returns a boolean
+// indicating whether a reference contains references, or an error
+//
+// The references config of the code generator can't express a kind that
+// depends on another field, so this is called from the
+// references_post_resolve hook instead of being generated.
+func (rm *resourceManager) resolveReferenceForSourceIDs(
+	ctx context.Context,
+	apiReader client.Reader,
+	ko *svcapitypes.EventSubscription,
+) (hasReferences bool, err error) {
+	if err := validateSourceRefs(ko); err != nil {
+		return len(ko.Spec.SourceRefs) > 0, err
+	}
+	for _, f0iter := range ko.Spec.SourceRefs {
+		if f0iter != nil && f0iter.From != nil {
+			hasReferences = true
+			arr := f0iter.From
+			if arr.Name == nil || *arr.Name == "" {
+				return hasReferences, fmt.Errorf("provided resource reference is nil or empty: SourceRefs")
+			}
+			namespace, err := ackrt.ResolveCrossNamespaceReference(
+				ctx,
+				rm.cfg.EnableCrossNamespace,
+				&ko.Status.Conditions,
+				ackrt.CrossNamespaceRefKindResource,
+				ko.ObjectMeta.GetNamespace(),
+				arr.Namespace,
+				*arr.Name,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			sourceID, err := getReferencedSourceID(
+				ctx, apiReader, svcapitypes.SourceType(aws.ToString(ko.Spec.SourceType)),
+				*arr.Name, namespace,
+			)
+			if err != nil {
+				return hasReferences, err
+			}
+			if ko.Spec.SourceIDs == nil {
+				ko.Spec.SourceIDs = make([]*string, 0, 1)
+			}
+			ko.Spec.SourceIDs = append(ko.Spec.SourceIDs, sourceID)
+		}
+	}
+
+	return hasReferences, nil
+}
+
+// getReferencedSourceID returns the identifier of the event source referenced
+// by the supplied name and namespace. The kind of the referenced resource is
+// given by the subscription's source type.
+func getReferencedSourceID(
+	ctx context.Context,
+	apiReader client.Reader,
+	sourceType svcapitypes.SourceType,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) (*string, error) {
+	switch sourceType {
+	case svcapitypes.SourceType_db_cluster:
+		obj := &svcapitypes.DBCluster{}
+		if err := getReferencedResourceState_DBCluster(ctx, apiReader, obj, name, namespace); err != nil {
+			return nil, err
+		}
+		return obj.Spec.DBClusterIdentifier, nil
+	case svcapitypes.SourceType_db_instance:
+		obj := &svcapitypes.DBInstance{}
+		if err := getReferencedResourceState_DBInstance(ctx, apiReader, obj, name, namespace); err != nil {
+			return nil, err
+		}
+		return obj.Spec.DBInstanceIdentifier, nil
+	case svcapitypes.SourceType_db_parameter_group:
+		obj := &svcapitypes.DBParameterGroup{}
+		if err := getReferencedResourceState_DBParameterGroup(ctx, apiReader, obj, name, namespace); err != nil {
+			return nil, err
+		}
+		return obj.Spec.Name, nil
+	case svcapitypes.SourceType_db_snapshot:
+		obj := &svcapitypes.DBSnapshot{}
+		if err := getReferencedResourceState_DBSnapshot(ctx, apiReader, obj, name, namespace); err != nil {
+			return nil, err
+		}
+		return obj.Spec.DBSnapshotIdentifier, nil
+	}
+	return nil, fmt.Errorf("unsupported source type for SourceRefs: %s", sourceType)
+}
+
+// getReferencedResourceState_DBCluster looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBCluster(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBCluster,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBCluster",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBCluster",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBCluster",
+			namespace, name)
+	}
+	if obj.Spec.DBClusterIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBCluster",
+			namespace, name,
+			"Spec.DBClusterIdentifier")
+	}
+	return nil
+}
+
+// getReferencedResourceState_DBInstance looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBInstance(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBInstance,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBInstance",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBInstance",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBInstance",
+			namespace, name)
+	}
+	if obj.Spec.DBInstanceIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBInstance",
+			namespace, name,
+			"Spec.DBInstanceIdentifier")
+	}
+	return nil
+}
+
+// getReferencedResourceState_DBParameterGroup looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBParameterGroup(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBParameterGroup,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBParameterGroup",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBParameterGroup",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBParameterGroup",
+			namespace, name)
+	}
+	if obj.Spec.Name == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBParameterGroup",
+			namespace, name,
+			"Spec.Name")
+	}
+	return nil
+}
+
+// getReferencedResourceState_DBSnapshot looks up whether a referenced resource
+// exists and is in a ACK.ResourceSynced=True state. If the referenced resource does exist and is
+// in a Synced state, returns nil, otherwise returns `ackerr.ResourceReferenceTerminalFor` or
+// `ResourceReferenceNotSyncedFor` depending on if the resource is in a Terminal state.
+func getReferencedResourceState_DBSnapshot(
+	ctx context.Context,
+	apiReader client.Reader,
+	obj *svcapitypes.DBSnapshot,
+	name string, // the Kubernetes name of the referenced resource
+	namespace string, // the Kubernetes namespace of the referenced resource
+) error {
+	namespacedName := types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}
+	err := apiReader.Get(ctx, namespacedName, obj)
+	if err != nil {
+		return err
+	}
+	var refResourceTerminal bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeTerminal &&
+			cond.Status == corev1.ConditionTrue {
+			return ackerr.ResourceReferenceTerminalFor(
+				"DBSnapshot",
+				namespace, name)
+		}
+	}
+	if refResourceTerminal {
+		return ackerr.ResourceReferenceTerminalFor(
+			"DBSnapshot",
+			namespace, name)
+	}
+	var refResourceSynced bool
+	for _, cond := range obj.Status.Conditions {
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced &&
+			cond.Status == corev1.ConditionTrue {
+			refResourceSynced = true
+		}
+	}
+	if !refResourceSynced {
+		return ackerr.ResourceReferenceNotSyncedFor(
+			"DBSnapshot",
+			namespace, name)
+	}
+	if obj.Spec.DBSnapshotIdentifier == nil {
+		return ackerr.ResourceReferenceMissingTargetFieldFor(
+			"DBSnapshot",
+			namespace, name,
+			"Spec.DBSnapshotIdentifier")
+	}
+	return nil
+}
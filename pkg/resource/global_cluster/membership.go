@@ -0,0 +1,285 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package global_cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// PrimaryChangeModeSwitchover makes the target the primary cluster with
+	// SwitchoverGlobalCluster, without data loss.
+	PrimaryChangeModeSwitchover = "switchover"
+	// PrimaryChangeModeFailover makes the target the primary cluster with
+	// FailoverGlobalCluster, allowing data loss.
+	PrimaryChangeModeFailover = "failover"
+)
+
+// StatusAvailable is the status of a global cluster that can be modified.
+const StatusAvailable = "available"
+
+const (
+	// primaryDBClusterDeltaPath is the delta path that the delta pre-compare
+	// hook uses to ask sdkUpdate to switch over or fail over the global
+	// cluster.
+	primaryDBClusterDeltaPath = "Spec.PrimaryDBClusterARN"
+	// removedMembersDeltaPath is the delta path that the delta pre-compare
+	// hook uses to ask sdkUpdate to detach members of the global cluster.
+	removedMembersDeltaPath = "Spec.RemovedMemberARNs"
+)
+
+var (
+	// requeueWaitWhileChangingPrimary is returned while a switchover or a
+	// failover of the global cluster is in progress.
+	requeueWaitWhileChangingPrimary = ackrequeue.NeededAfter(
+		errors.New("Global cluster is changing its primary cluster, cannot be modified."),
+		30*time.Second,
+	)
+	// requeueWaitUntilAvailable is returned when the membership of a global
+	// cluster needs to change but the global cluster isn't available.
+	requeueWaitUntilAvailable = ackrequeue.NeededAfter(
+		errors.New("Global cluster is not available, cannot change its members."),
+		30*time.Second,
+	)
+)
+
+// writerARN returns the ARN of the primary cluster of the supplied global
+// cluster, or nil if it has none.
+func writerARN(r *resource) *string {
+	for _, m := range r.ko.Status.GlobalClusterMembers {
+		if m != nil && aws.ToBool(m.IsWriter) {
+			return m.DBClusterARN
+		}
+	}
+	return nil
+}
+
+// isMember returns true if the DB cluster with the supplied ARN is a member
+// of the supplied global cluster.
+func isMember(r *resource, arn string) bool {
+	for _, m := range r.ko.Status.GlobalClusterMembers {
+		if m != nil && aws.ToString(m.DBClusterARN) == arn {
+			return true
+		}
+	}
+	return false
+}
+
+// globalClusterAvailable returns true if the supplied global cluster is
+// available.
+func globalClusterAvailable(r *resource) bool {
+	return aws.ToString(r.ko.Status.Status) == StatusAvailable
+}
+
+// failoverInProgress returns true if a switchover or a failover of the
+// supplied global cluster is in progress. RDS clears the failover state once
+// it is done.
+func failoverInProgress(r *resource) bool {
+	state := r.ko.Status.FailoverState
+	return state != nil && aws.ToString(state.Status) != ""
+}
+
+// failoverProgressMessage returns the Synced condition message of a global
+// cluster with a switchover or a failover in progress.
+func failoverProgressMessage(r *resource) string {
+	state := r.ko.Status.FailoverState
+	operation := PrimaryChangeModeSwitchover
+	if aws.ToBool(state.IsDataLossAllowed) {
+		operation = PrimaryChangeModeFailover
+	}
+	return fmt.Sprintf(
+		"Global cluster %s from %s to %s in '%s' state",
+		operation,
+		aws.ToString(state.FromDBClusterARN),
+		aws.ToString(state.ToDBClusterARN),
+		aws.ToString(state.Status),
+	)
+}
+
+// comparePrimaryDBCluster adds a difference to the delta when the desired
+// primary cluster isn't the writer of the latest global cluster.
+func comparePrimaryDBCluster(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	primary := desired.ko.Spec.PrimaryDBClusterARN
+	if primary == nil || *primary == "" {
+		return
+	}
+	writer := writerARN(latest)
+	if aws.ToString(writer) != *primary {
+		delta.Add(primaryDBClusterDeltaPath, primary, writer)
+	}
+}
+
+// compareRemovedMembers adds a difference to the delta when a DB cluster
+// that should be detached is still a member of the latest global cluster.
+func compareRemovedMembers(
+	delta *ackcompare.Delta,
+	desired *resource,
+	latest *resource,
+) {
+	for _, arn := range desired.ko.Spec.RemovedMemberARNs {
+		if arn != nil && isMember(latest, *arn) {
+			delta.Add(
+				removedMembersDeltaPath,
+				desired.ko.Spec.RemovedMemberARNs,
+				latest.ko.Status.GlobalClusterMembers,
+			)
+			return
+		}
+	}
+}
+
+// validatePrimaryChangeMode returns a terminal error if the primary change
+// mode of the supplied global cluster isn't supported.
+func validatePrimaryChangeMode(r *resource) error {
+	mode := r.ko.Spec.PrimaryChangeMode
+	if mode == nil ||
+		*mode == PrimaryChangeModeSwitchover ||
+		*mode == PrimaryChangeModeFailover {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"unsupported primaryChangeMode %q, expected %q or %q",
+		*mode, PrimaryChangeModeSwitchover, PrimaryChangeModeFailover,
+	))
+}
+
+// updateMembership changes the primary cluster of the global cluster or
+// detaches its members. A change of primary cluster runs first, so that a
+// former primary cluster can be detached once it is a secondary cluster.
+// Each call starts one operation and leaves the rest to later
+// reconciliations, once the global cluster is available again.
+func (rm *resourceManager) updateMembership(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateMembership")
+	defer func() { exit(err) }()
+
+	if !globalClusterAvailable(latest) {
+		msg := "Global cluster cannot change its members while in '" +
+			aws.ToString(latest.ko.Status.Status) + "' status"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilAvailable
+	}
+	if delta.DifferentAt(primaryDBClusterDeltaPath) {
+		if err = validatePrimaryChangeMode(desired); err != nil {
+			return nil, err
+		}
+		target := *desired.ko.Spec.PrimaryDBClusterARN
+		if !isMember(latest, target) {
+			msg := fmt.Sprintf(
+				"DB cluster %s is not a member of the global cluster, "+
+					"cannot make it the primary cluster", target,
+			)
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+			return desired, requeueWaitUntilAvailable
+		}
+		if err = rm.changePrimaryDBCluster(ctx, desired); err != nil {
+			return nil, err
+		}
+		msg := fmt.Sprintf("Global cluster is changing its primary cluster to %s", target)
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, nil
+	}
+	if err = rm.removeMembers(ctx, desired, latest); err != nil {
+		return nil, err
+	}
+	msg := "Global cluster is detaching DB clusters"
+	ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+	return desired, nil
+}
+
+// changePrimaryDBCluster calls SwitchoverGlobalCluster, or
+// FailoverGlobalCluster allowing data loss, to make the desired primary
+// cluster the writer of the global cluster.
+func (rm *resourceManager) changePrimaryDBCluster(
+	ctx context.Context,
+	desired *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.changePrimaryDBCluster")
+	defer func() { exit(err) }()
+
+	if aws.ToString(desired.ko.Spec.PrimaryChangeMode) == PrimaryChangeModeFailover {
+		_, err = rm.sdkapi.FailoverGlobalCluster(
+			ctx,
+			&svcsdk.FailoverGlobalClusterInput{
+				GlobalClusterIdentifier:   desired.ko.Spec.GlobalClusterIdentifier,
+				TargetDbClusterIdentifier: desired.ko.Spec.PrimaryDBClusterARN,
+				AllowDataLoss:             aws.Bool(true),
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "FailoverGlobalCluster", err)
+		return err
+	}
+	_, err = rm.sdkapi.SwitchoverGlobalCluster(
+		ctx,
+		&svcsdk.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   desired.ko.Spec.GlobalClusterIdentifier,
+			TargetDbClusterIdentifier: desired.ko.Spec.PrimaryDBClusterARN,
+		},
+	)
+	rm.metrics.RecordAPICall("UPDATE", "SwitchoverGlobalCluster", err)
+	return err
+}
+
+// removeMembers calls RemoveFromGlobalCluster for each DB cluster that
+// should be detached and is still a member of the global cluster.
+func (rm *resourceManager) removeMembers(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.removeMembers")
+	defer func() { exit(err) }()
+
+	for _, arn := range desired.ko.Spec.RemovedMemberARNs {
+		if arn == nil || !isMember(latest, *arn) {
+			continue
+		}
+		rlog.Debug("removing DB cluster from global cluster", "db_cluster_arn", *arn)
+		_, err = rm.sdkapi.RemoveFromGlobalCluster(
+			ctx,
+			&svcsdk.RemoveFromGlobalClusterInput{
+				GlobalClusterIdentifier: desired.ko.Spec.GlobalClusterIdentifier,
+				DbClusterIdentifier:     arn,
+			},
+		)
+		rm.metrics.RecordAPICall("UPDATE", "RemoveFromGlobalCluster", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package global_cluster
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+const (
+	testPrimaryARN   = "arn:aws:rds:us-east-1:111111111111:cluster:primary"
+	testSecondaryARN = "arn:aws:rds:us-west-2:111111111111:cluster:secondary"
+)
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	api.GlobalClusters["global"] = &svcsdktypes.GlobalCluster{
+		GlobalClusterIdentifier: aws.String("global"),
+		GlobalClusterArn:        aws.String("arn:aws:rds::111111111111:global-cluster:global"),
+		Status:                  aws.String(fake.StatusAvailable),
+		GlobalClusterMembers: []svcsdktypes.GlobalClusterMember{
+			{DBClusterArn: aws.String(testPrimaryARN), IsWriter: aws.Bool(true)},
+			{DBClusterArn: aws.String(testSecondaryARN), IsWriter: aws.Bool(false)},
+		},
+	}
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+func globalClusterResource() *resource {
+	return &resource{ko: &svcapitypes.GlobalCluster{
+		Spec: svcapitypes.GlobalClusterSpec{
+			GlobalClusterIdentifier: aws.String("global"),
+		},
+	}}
+}
+
+func syncedCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.ko.Status.Conditions {
+		if c.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestChangePrimaryDBCluster(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       *string
+		wantOp     string
+		wantStatus string
+	}{
+		{
+			name:       "switchover by default",
+			wantOp:     "SwitchoverGlobalCluster",
+			wantStatus: fake.GlobalClusterStatusSwitchingOver,
+		},
+		{
+			name:       "failover allowing data loss",
+			mode:       aws.String(PrimaryChangeModeFailover),
+			wantOp:     "FailoverGlobalCluster",
+			wantStatus: fake.GlobalClusterStatusFailingOver,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestManager()
+			ctx := context.Background()
+			desired := globalClusterResource()
+			desired.ko.Spec.PrimaryDBClusterARN = aws.String(testSecondaryARN)
+			desired.ko.Spec.PrimaryChangeMode = tt.mode
+
+			latest, err := rm.sdkFind(ctx, desired)
+			require.NoError(t, err)
+			delta := newResourceDelta(desired, latest)
+			require.True(t, delta.DifferentAt(primaryDBClusterDeltaPath))
+
+			updated, err := rm.sdkUpdate(ctx, desired, latest, delta)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"DescribeGlobalClusters", tt.wantOp}, api.Operations())
+			assert.Equal(t, corev1.ConditionFalse, syncedCondition(updated).Status)
+			if tt.mode != nil {
+				input := api.CallsTo("FailoverGlobalCluster")[0].(*svcsdk.FailoverGlobalClusterInput)
+				assert.True(t, *input.AllowDataLoss)
+				assert.Equal(t, testSecondaryARN, *input.TargetDbClusterIdentifier)
+			}
+
+			// The switchover or failover is reported until RDS clears the
+			// failover state.
+			latest, err = rm.sdkFind(ctx, desired)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, *latest.ko.Status.Status)
+			require.NotNil(t, syncedCondition(latest))
+			assert.Contains(t, *syncedCondition(latest).Message, testSecondaryARN)
+			_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+			assert.Equal(t, requeueWaitWhileChangingPrimary, err)
+
+			gc := api.GlobalClusters["global"]
+			gc.Status = aws.String(fake.StatusAvailable)
+			gc.FailoverState = nil
+			gc.GlobalClusterMembers[0].IsWriter = aws.Bool(false)
+			gc.GlobalClusterMembers[1].IsWriter = aws.Bool(true)
+			latest, err = rm.sdkFind(ctx, desired)
+			require.NoError(t, err)
+			assert.Nil(t, syncedCondition(latest))
+			assert.False(t, newResourceDelta(desired, latest).DifferentAt(primaryDBClusterDeltaPath))
+		})
+	}
+}
+
+func TestChangePrimaryDBClusterNotAMember(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired := globalClusterResource()
+	desired.ko.Spec.PrimaryDBClusterARN = aws.String("arn:aws:rds:eu-west-1:111111111111:cluster:other")
+
+	latest, err := rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Equal(t, requeueWaitUntilAvailable, err)
+	assert.Empty(t, api.CallsTo("SwitchoverGlobalCluster"))
+}
+
+func TestValidatePrimaryChangeMode(t *testing.T) {
+	r := globalClusterResource()
+	assert.NoError(t, validatePrimaryChangeMode(r))
+	r.ko.Spec.PrimaryChangeMode = aws.String(PrimaryChangeModeSwitchover)
+	assert.NoError(t, validatePrimaryChangeMode(r))
+	r.ko.Spec.PrimaryChangeMode = aws.String("promote")
+	var terminal *ackerr.TerminalError
+	assert.ErrorAs(t, validatePrimaryChangeMode(r), &terminal)
+}
+
+func TestRemoveMembers(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	desired := globalClusterResource()
+	desired.ko.Spec.RemovedMemberARNs = aws.StringSlice([]string{
+		testSecondaryARN,
+		"arn:aws:rds:eu-west-1:111111111111:cluster:already-removed",
+	})
+
+	latest, err := rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	delta := newResourceDelta(desired, latest)
+	require.True(t, delta.DifferentAt(removedMembersDeltaPath))
+
+	_, err = rm.sdkUpdate(ctx, desired, latest, delta)
+	require.NoError(t, err)
+	calls := api.CallsTo("RemoveFromGlobalCluster")
+	require.Len(t, calls, 1)
+	input := calls[0].(*svcsdk.RemoveFromGlobalClusterInput)
+	assert.Equal(t, "global", *input.GlobalClusterIdentifier)
+	assert.Equal(t, testSecondaryARN, *input.DbClusterIdentifier)
+
+	latest, err = rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt(removedMembersDeltaPath))
+}
+
+func TestMembershipWaitsForAvailableGlobalCluster(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	api.GlobalClusters["global"].Status = aws.String("modifying")
+	desired := globalClusterResource()
+	desired.ko.Spec.RemovedMemberARNs = aws.StringSlice([]string{testSecondaryARN})
+
+	latest, err := rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	assert.Equal(t, requeueWaitUntilAvailable, err)
+	assert.Empty(t, api.CallsTo("RemoveFromGlobalCluster"))
+}
@@ -121,6 +121,9 @@ func (rm *resourceManager) sdkFind(
 			if elem.FailoverState.FromDbClusterArn != nil {
 				f5.FromDBClusterARN = elem.FailoverState.FromDbClusterArn
 			}
+			if elem.FailoverState.IsDataLossAllowed != nil {
+				f5.IsDataLossAllowed = elem.FailoverState.IsDataLossAllowed
+			}
 			if elem.FailoverState.Status != "" {
 				f5.Status = aws.String(string(elem.FailoverState.Status))
 			}
@@ -203,6 +206,12 @@ func (rm *resourceManager) sdkFind(
 		return nil, ackerr.NotFound
 	}
 
+	if failoverInProgress(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		msg := failoverProgressMessage(&resource{ko})
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
 	rm.setStatusDefaults(ko)
 	return &resource{ko}, nil
 }
@@ -288,6 +297,9 @@ func (rm *resourceManager) sdkCreate(
 		if resp.GlobalCluster.FailoverState.FromDbClusterArn != nil {
 			f5.FromDBClusterARN = resp.GlobalCluster.FailoverState.FromDbClusterArn
 		}
+		if resp.GlobalCluster.FailoverState.IsDataLossAllowed != nil {
+			f5.IsDataLossAllowed = resp.GlobalCluster.FailoverState.IsDataLossAllowed
+		}
 		if resp.GlobalCluster.FailoverState.Status != "" {
 			f5.Status = aws.String(string(resp.GlobalCluster.FailoverState.Status))
 		}
@@ -430,11 +442,22 @@ func (rm *resourceManager) sdkUpdate(
 	}()
 	updatedko := desired.ko.DeepCopy()
 	updatedko.Status = latest.ko.Status
+	if failoverInProgress(latest) {
+		msg := failoverProgressMessage(latest)
+		ackcondition.SetSynced(&resource{updatedko}, corev1.ConditionFalse, &msg, nil)
+		return &resource{updatedko}, requeueWaitWhileChangingPrimary
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return &resource{updatedko}, err
 		}
 	}
+	if delta.DifferentAt(primaryDBClusterDeltaPath) || delta.DifferentAt(removedMembersDeltaPath) {
+		// Membership changes run on their own. Any other modification is
+		// picked up by a later reconciliation, once the global cluster is
+		// available again.
+		return rm.updateMembership(ctx, &resource{updatedko}, latest, delta)
+	}
 	if !delta.DifferentExcept("Spec.Tags") {
 		return &resource{updatedko}, nil
 	}
@@ -484,6 +507,9 @@ func (rm *resourceManager) sdkUpdate(
 		if resp.GlobalCluster.FailoverState.FromDbClusterArn != nil {
 			f5.FromDBClusterARN = resp.GlobalCluster.FailoverState.FromDbClusterArn
 		}
+		if resp.GlobalCluster.FailoverState.IsDataLossAllowed != nil {
+			f5.IsDataLossAllowed = resp.GlobalCluster.FailoverState.IsDataLossAllowed
+		}
 		if resp.GlobalCluster.FailoverState.Status != "" {
 			f5.Status = aws.String(string(resp.GlobalCluster.FailoverState.Status))
 		}
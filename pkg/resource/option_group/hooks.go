@@ -0,0 +1,294 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package option_group
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+var (
+	errOptionsModified         = fmt.Errorf("options modified, requeuing to refresh status")
+	requeueWaitAfterOptionSync = ackrequeue.NeededAfter(
+		errOptionsModified,
+		5*time.Second,
+	)
+)
+
+// customUpdate syncs the tags and the options of the option group. The
+// description, engine name and major engine version of an option group
+// can't be changed.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() {
+		exit(err)
+	}()
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.Options") {
+		if err = rm.syncOptions(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		return desired, requeueWaitAfterOptionSync
+	}
+	return desired, nil
+}
+
+// syncTags keeps the resource's tags in sync
+func (rm *resourceManager) syncTags(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncTags")
+	defer func() { exit(err) }()
+
+	arn := (*string)(latest.ko.Status.ACKResourceMetadata.ARN)
+	return rm.tagReconciler.ForAnnotations(desired.ko.Annotations).Sync(
+		ctx, rm.sdkapi, rm.metrics, *arn,
+		desired.ko.Spec.Tags, latest.ko.Spec.Tags,
+	)
+}
+
+// getTags retrieves the resource's associated tags
+func (rm *resourceManager) getTags(
+	ctx context.Context,
+	resourceARN string,
+) ([]*svcapitypes.Tag, error) {
+	return rm.tagReconciler.Get(ctx, rm.sdkapi, rm.metrics, resourceARN)
+}
+
+// compareTags adds a difference to the delta if the supplied resources have
+// different tag collections, as reconciled by the supplied TagReconciler
+func compareTags(
+	tags *util.TagReconciler,
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	tags.ForAnnotations(a.ko.Annotations).Compare(
+		delta, a.ko.Spec.Tags, b.ko.Spec.Tags,
+	)
+}
+
+// compareOptions adds a difference to the delta if the options of the
+// desired resource aren't all present, with the same configuration, in the
+// latest resource, or if the latest resource has options that the desired
+// resource doesn't.
+func compareOptions(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	included, removed := util.GetOptionsDifference(
+		a.ko.Spec.Options, b.ko.Spec.Options,
+	)
+	if len(included) > 0 || len(removed) > 0 {
+		delta.Add("Spec.Options", a.ko.Spec.Options, b.ko.Spec.Options)
+	}
+}
+
+// syncOptions adds, modifies and removes the options of the option group so
+// that they match the desired options.
+//
+// Changes are applied immediately. DB instances that use the option group
+// pick up options that require a reboot, like most Oracle and SQL Server
+// options, on their next reboot.
+func (rm *resourceManager) syncOptions(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncOptions")
+	defer func() { exit(err) }()
+
+	var latestOptions []*svcapitypes.OptionConfiguration
+	// In the create code paths, we pass a nil latest...
+	if latest != nil {
+		latestOptions = latest.ko.Spec.Options
+	}
+	toInclude, toRemove := util.GetOptionsDifference(
+		desired.ko.Spec.Options, latestOptions,
+	)
+	if len(toInclude) == 0 && len(toRemove) == 0 {
+		return nil
+	}
+
+	input := &svcsdk.ModifyOptionGroupInput{
+		OptionGroupName:  desired.ko.Spec.Name,
+		ApplyImmediately: aws.Bool(true),
+		OptionsToRemove:  toRemove,
+	}
+	for _, opt := range toInclude {
+		input.OptionsToInclude = append(
+			input.OptionsToInclude, sdkOptionConfiguration(opt),
+		)
+	}
+	rlog.Debug(
+		"modifying options of option group",
+		"include", len(input.OptionsToInclude), "remove", toRemove,
+	)
+	_, err = rm.sdkapi.ModifyOptionGroup(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "ModifyOptionGroup", err)
+	return err
+}
+
+// sdkOptionConfiguration transforms a *svcapitypes.OptionConfiguration to a
+// svcsdktypes.OptionConfiguration. Only the name and value of the option
+// settings are sent to RDS.
+func sdkOptionConfiguration(
+	opt *svcapitypes.OptionConfiguration,
+) svcsdktypes.OptionConfiguration {
+	cfg := svcsdktypes.OptionConfiguration{
+		OptionName:                  opt.OptionName,
+		OptionVersion:               opt.OptionVersion,
+		DBSecurityGroupMemberships:  aws.StringValueSlice(opt.DBSecurityGroupMemberships),
+		VpcSecurityGroupMemberships: aws.StringValueSlice(opt.VPCSecurityGroupMemberships),
+	}
+	if opt.Port != nil {
+		port := int32(*opt.Port)
+		cfg.Port = &port
+	}
+	for _, s := range opt.OptionSettings {
+		if s == nil {
+			continue
+		}
+		cfg.OptionSettings = append(cfg.OptionSettings, svcsdktypes.OptionSetting{
+			Name:  s.Name,
+			Value: s.Value,
+		})
+	}
+	return cfg
+}
+
+// observedOptions returns the options of an option group as option
+// configurations, in the order of the desired options followed by the
+// options that aren't desired, by name.
+//
+// Option groups return every setting of an option, most of them with their
+// default value, so only the settings that are in the desired option are
+// returned, in the desired order. Otherwise every setting RDS reports would
+// show up in the spec of the resource.
+//
+// The options of an option group whose desired options are nil aren't
+// managed, so nil is returned and they stay out of the spec.
+func observedOptions(
+	options []svcsdktypes.Option,
+	desired []*svcapitypes.OptionConfiguration,
+) []*svcapitypes.OptionConfiguration {
+	if desired == nil {
+		return nil
+	}
+	desiredByName := map[string]*svcapitypes.OptionConfiguration{}
+	order := map[string]int{}
+	for i, opt := range desired {
+		if opt != nil && opt.OptionName != nil {
+			desiredByName[*opt.OptionName] = opt
+			order[*opt.OptionName] = i
+		}
+	}
+
+	observed := make([]*svcapitypes.OptionConfiguration, 0, len(options))
+	for _, opt := range options {
+		cfg := &svcapitypes.OptionConfiguration{
+			OptionName:    opt.OptionName,
+			OptionVersion: opt.OptionVersion,
+		}
+		if opt.Port != nil {
+			cfg.Port = aws.Int64(int64(*opt.Port))
+		}
+		for _, m := range opt.DBSecurityGroupMemberships {
+			cfg.DBSecurityGroupMemberships = append(
+				cfg.DBSecurityGroupMemberships, m.DBSecurityGroupName,
+			)
+		}
+		for _, m := range opt.VpcSecurityGroupMemberships {
+			cfg.VPCSecurityGroupMemberships = append(
+				cfg.VPCSecurityGroupMemberships, m.VpcSecurityGroupId,
+			)
+		}
+		if want, ok := desiredByName[aws.StringValue(opt.OptionName)]; ok {
+			cfg.OptionSettings = observedOptionSettings(
+				opt.OptionSettings, want.OptionSettings,
+			)
+		}
+		observed = append(observed, cfg)
+	}
+
+	sort.SliceStable(observed, func(i, j int) bool {
+		ni := aws.StringValue(observed[i].OptionName)
+		nj := aws.StringValue(observed[j].OptionName)
+		oi, iDesired := order[ni]
+		oj, jDesired := order[nj]
+		switch {
+		case iDesired && jDesired:
+			return oi < oj
+		case iDesired != jDesired:
+			return iDesired
+		default:
+			return ni < nj
+		}
+	})
+	return observed
+}
+
+// observedOptionSettings returns the name and value of the settings of an
+// option that are in the desired settings, in the desired order.
+func observedOptionSettings(
+	settings []svcsdktypes.OptionSetting,
+	desired []*svcapitypes.OptionSetting,
+) []*svcapitypes.OptionSetting {
+	values := map[string]*string{}
+	for _, s := range settings {
+		if s.Name != nil {
+			values[*s.Name] = s.Value
+		}
+	}
+	var observed []*svcapitypes.OptionSetting
+	for _, s := range desired {
+		if s == nil || s.Name == nil {
+			continue
+		}
+		if value, ok := values[*s.Name]; ok {
+			observed = append(observed, &svcapitypes.OptionSetting{
+				Name:  s.Name,
+				Value: value,
+			})
+		}
+	}
+	return observed
+}
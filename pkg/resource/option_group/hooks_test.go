@@ -0,0 +1,235 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package option_group
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+)
+
+func newTestManager() (*resourceManager, *fake.RDS) {
+	api := fake.New()
+	f := newResourceManagerFactory()
+	f.newSDKAPI = api.NewSDKAPI
+	return api.ManagerFor(f).(*resourceManager), api
+}
+
+func setting(name, value string) *svcapitypes.OptionSetting {
+	return &svcapitypes.OptionSetting{Name: aws.String(name), Value: aws.String(value)}
+}
+
+func optionGroupResource(options ...*svcapitypes.OptionConfiguration) *resource {
+	return &resource{ko: &svcapitypes.OptionGroup{
+		Spec: svcapitypes.OptionGroupSpec{
+			Name:               aws.String("sqlserver"),
+			Description:        aws.String("SQL Server options"),
+			EngineName:         aws.String("sqlserver-se"),
+			MajorEngineVersion: aws.String("15.00"),
+			Options:            options,
+		},
+	}}
+}
+
+// withoutOptions sets the options of the supplied option group to an empty
+// list, which removes all its options.
+func withoutOptions(r *resource) *resource {
+	r.ko.Spec.Options = []*svcapitypes.OptionConfiguration{}
+	return r
+}
+
+func backupRestore(role string) *svcapitypes.OptionConfiguration {
+	return &svcapitypes.OptionConfiguration{
+		OptionName:     aws.String("SQLSERVER_BACKUP_RESTORE"),
+		OptionSettings: []*svcapitypes.OptionSetting{setting("IAM_ROLE_ARN", role)},
+	}
+}
+
+func TestSdkCreateAndFind(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+
+	desired := optionGroupResource(backupRestore("arn:aws:iam::111111111111:role/backup"))
+	created, err := rm.sdkCreate(ctx, desired)
+	require.NoError(t, err)
+	assert.NotNil(t, created.ko.Status.ACKResourceMetadata.ARN)
+	assert.Equal(t, []string{"CreateOptionGroup", "ModifyOptionGroup"}, api.Operations())
+
+	// RDS reports every setting of an option, not only the ones we set.
+	opt := &api.OptionGroups["sqlserver"].Options[0]
+	opt.OptionSettings = append(opt.OptionSettings, svcsdktypes.OptionSetting{
+		Name:  aws.String("MAX_CONCURRENT_TASKS"),
+		Value: aws.String("2"),
+	})
+	opt.Port = aws.Int32(1433)
+
+	latest, err := rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	require.Len(t, latest.ko.Spec.Options, 1)
+	assert.Equal(t, []*svcapitypes.OptionSetting{
+		setting("IAM_ROLE_ARN", "arn:aws:iam::111111111111:role/backup"),
+	}, latest.ko.Spec.Options[0].OptionSettings)
+	assert.Equal(t, int64(1433), *latest.ko.Spec.Options[0].Port)
+	assert.False(t, newResourceDelta(desired, latest).DifferentAt("Spec.Options"))
+
+	_, err = rm.sdkFind(ctx, &resource{ko: &svcapitypes.OptionGroup{
+		Spec: svcapitypes.OptionGroupSpec{Name: aws.String("missing")},
+	}})
+	assert.Equal(t, ackerr.NotFound, err)
+}
+
+func TestSdkUpdate(t *testing.T) {
+	tde := &svcapitypes.OptionConfiguration{OptionName: aws.String("TDE")}
+	tests := []struct {
+		name        string
+		desired     *resource
+		wantInclude []string
+		wantRemove  []string
+	}{
+		{
+			name:    "in sync",
+			desired: optionGroupResource(backupRestore("role-a"), tde),
+		},
+		{
+			name:        "setting changed",
+			desired:     optionGroupResource(backupRestore("role-b"), tde),
+			wantInclude: []string{"SQLSERVER_BACKUP_RESTORE"},
+		},
+		{
+			name:       "option removed",
+			desired:    optionGroupResource(backupRestore("role-a")),
+			wantRemove: []string{"TDE"},
+		},
+		{
+			name:    "options not managed",
+			desired: optionGroupResource(),
+		},
+		{
+			name:       "all options removed",
+			desired:    withoutOptions(optionGroupResource()),
+			wantRemove: []string{"SQLSERVER_BACKUP_RESTORE", "TDE"},
+		},
+		{
+			name: "option added",
+			desired: optionGroupResource(backupRestore("role-a"), tde, &svcapitypes.OptionConfiguration{
+				OptionName: aws.String("MSDTC"),
+				Port:       aws.Int64(5000),
+			}),
+			wantInclude: []string{"MSDTC"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rm, api := newTestManager()
+			ctx := context.Background()
+			_, err := rm.sdkCreate(ctx, optionGroupResource(backupRestore("role-a"), tde))
+			require.NoError(t, err)
+			api.Calls = nil
+
+			latest, err := rm.sdkFind(ctx, tt.desired)
+			require.NoError(t, err)
+			delta := newResourceDelta(tt.desired, latest)
+			_, err = rm.sdkUpdate(ctx, tt.desired, latest, delta)
+
+			if tt.wantInclude == nil && tt.wantRemove == nil {
+				require.NoError(t, err)
+				assert.Equal(t, []string{"DescribeOptionGroups", "ListTagsForResource"}, api.Operations())
+				return
+			}
+			var requeueErr *ackrequeue.RequeueNeededAfter
+			assert.True(t, errors.As(err, &requeueErr))
+			calls := api.CallsTo("ModifyOptionGroup")
+			require.Len(t, calls, 1)
+			input := calls[0].(*svcsdk.ModifyOptionGroupInput)
+			var include []string
+			for _, opt := range input.OptionsToInclude {
+				include = append(include, *opt.OptionName)
+			}
+			assert.Equal(t, tt.wantInclude, include)
+			assert.Equal(t, tt.wantRemove, input.OptionsToRemove)
+			assert.True(t, *input.ApplyImmediately)
+
+			latest, err = rm.sdkFind(ctx, tt.desired)
+			require.NoError(t, err)
+			assert.False(t, newResourceDelta(tt.desired, latest).DifferentAt("Spec.Options"))
+		})
+	}
+}
+
+func TestSdkUpdate_TerminalError(t *testing.T) {
+	rm, api := newTestManager()
+	ctx := context.Background()
+	tde := &svcapitypes.OptionConfiguration{OptionName: aws.String("TDE")}
+	_, err := rm.sdkCreate(ctx, optionGroupResource(tde))
+	require.NoError(t, err)
+	api.OptionGroups["sqlserver"].Options[0].Permanent = aws.Bool(true)
+
+	desired := withoutOptions(optionGroupResource())
+	latest, err := rm.sdkFind(ctx, desired)
+	require.NoError(t, err)
+	_, err = rm.sdkUpdate(ctx, desired, latest, newResourceDelta(desired, latest))
+	require.Error(t, err)
+	assert.True(t, rm.terminalAWSError(err))
+}
+
+func TestObservedOptions(t *testing.T) {
+	options := []svcsdktypes.Option{
+		{OptionName: aws.String("C")},
+		{OptionName: aws.String("B")},
+		{
+			OptionName: aws.String("A"),
+			OptionSettings: []svcsdktypes.OptionSetting{
+				{Name: aws.String("X"), Value: aws.String("1")},
+				{Name: aws.String("Y"), Value: aws.String("2")},
+			},
+			VpcSecurityGroupMemberships: []svcsdktypes.VpcSecurityGroupMembership{
+				{VpcSecurityGroupId: aws.String("sg-1")},
+			},
+		},
+		{OptionName: aws.String("D")},
+	}
+	desired := []*svcapitypes.OptionConfiguration{
+		{OptionName: aws.String("D")},
+		{
+			OptionName:     aws.String("A"),
+			OptionSettings: []*svcapitypes.OptionSetting{setting("Y", "3")},
+		},
+	}
+
+	observed := observedOptions(options, desired)
+	var names []string
+	for _, opt := range observed {
+		names = append(names, *opt.OptionName)
+	}
+	// Desired options come first, in the desired order, followed by the
+	// options that aren't desired, by name.
+	assert.Equal(t, []string{"D", "A", "B", "C"}, names)
+	assert.Equal(t, []*svcapitypes.OptionSetting{setting("Y", "2")}, observed[1].OptionSettings)
+	assert.Equal(t, []string{"sg-1"}, aws.ToStringSlice(observed[1].VPCSecurityGroupMemberships))
+	assert.Nil(t, observed[0].OptionSettings)
+
+	// Options aren't observed when they aren't managed.
+	assert.Nil(t, observedOptions(options, nil))
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package secrets copies the master user secrets RDS manages in AWS Secrets
+// Manager, for the DB instances and DB clusters with ManageMasterUserPassword
+// turned on, to Kubernetes Secrets that workloads can read.
+//
+// The secrets are read through a Backend, so that the copy can be exercised
+// against an in-memory backend such as the one in the secrets/fake package.
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+// VersionStageCurrent is the staging label of the current version of a
+// secret. Rotating a secret moves it to the new version.
+const VersionStageCurrent = "AWSCURRENT"
+
+// SecretValue is a version of a secret.
+type SecretValue struct {
+	// VersionID identifies the version.
+	VersionID string
+	// VersionStages are the staging labels attached to the version.
+	VersionStages []string
+	// SecretString is the value of the version.
+	SecretString string
+}
+
+// Backend reads secrets from a secret store.
+type Backend interface {
+	// GetCurrentSecretValue returns the version of the secret with the
+	// supplied ARN that has the VersionStageCurrent staging label.
+	GetCurrentSecretValue(ctx context.Context, arn string) (*SecretValue, error)
+}
+
+// awsBackend is the Backend that reads secrets from AWS Secrets Manager.
+type awsBackend struct {
+	client *secretsmanager.Client
+}
+
+// NewAWSBackend returns a Backend that reads secrets from AWS Secrets
+// Manager with the supplied AWS configuration.
+func NewAWSBackend(cfg aws.Config) Backend {
+	return &awsBackend{client: secretsmanager.NewFromConfig(cfg)}
+}
+
+// GetCurrentSecretValue returns the current version of the secret with the
+// supplied ARN.
+func (b *awsBackend) GetCurrentSecretValue(
+	ctx context.Context,
+	arn string,
+) (*SecretValue, error) {
+	resp, err := b.client.GetSecretValue(
+		ctx,
+		&secretsmanager.GetSecretValueInput{
+			SecretId:     &arn,
+			VersionStage: aws.String(VersionStageCurrent),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.VersionId == nil || resp.SecretString == nil {
+		return nil, fmt.Errorf("secret %s has no current version with a string value", arn)
+	}
+	return &SecretValue{
+		VersionID:     *resp.VersionId,
+		VersionStages: resp.VersionStages,
+		SecretString:  *resp.SecretString,
+	}, nil
+}
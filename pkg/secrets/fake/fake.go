@@ -0,0 +1,97 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package fake provides an in-memory secrets.Backend for tests.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+)
+
+const versionStagePrevious = "AWSPREVIOUS"
+
+// Backend is an in-memory secrets.Backend. Each secret keeps its current
+// and previous versions, like AWS Secrets Manager after a rotation.
+type Backend struct {
+	mu       sync.Mutex
+	versions map[string][]*secrets.SecretValue
+	nextID   int
+	// Calls counts the calls to GetCurrentSecretValue.
+	Calls int
+}
+
+// New returns an empty Backend.
+func New() *Backend {
+	return &Backend{versions: map[string][]*secrets.SecretValue{}}
+}
+
+// Put adds a version with the supplied value to the secret with the supplied
+// ARN, creating the secret if needed, and makes it the current version, like
+// a rotation does. Returns the ID of the version.
+func (b *Backend) Put(arn string, value string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var versions []*secrets.SecretValue
+	for _, v := range b.versions[arn] {
+		if hasStage(v, secrets.VersionStageCurrent) {
+			// The current version becomes the previous version and the
+			// older versions lose their staging labels.
+			versions = append(versions, &secrets.SecretValue{
+				VersionID:     v.VersionID,
+				VersionStages: []string{versionStagePrevious},
+				SecretString:  v.SecretString,
+			})
+		}
+	}
+	b.nextID++
+	id := fmt.Sprintf("version-%d", b.nextID)
+	b.versions[arn] = append(versions, &secrets.SecretValue{
+		VersionID:     id,
+		VersionStages: []string{secrets.VersionStageCurrent},
+		SecretString:  value,
+	})
+	return id
+}
+
+// GetCurrentSecretValue returns the current version of the secret with the
+// supplied ARN.
+func (b *Backend) GetCurrentSecretValue(
+	ctx context.Context,
+	arn string,
+) (*secrets.SecretValue, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.Calls++
+	for _, v := range b.versions[arn] {
+		if hasStage(v, secrets.VersionStageCurrent) {
+			res := *v
+			return &res, nil
+		}
+	}
+	return nil, fmt.Errorf("ResourceNotFoundException: secret %s not found", arn)
+}
+
+func hasStage(v *secrets.SecretValue, stage string) bool {
+	for _, s := range v.VersionStages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+)
+
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+
+const (
+	// ReasonMasterUserSecretRotated is the reason of the Event emitted when
+	// the current version of a master user secret changed since it was last
+	// copied.
+	ReasonMasterUserSecretRotated = "MasterUserSecretRotated"
+	// statusRotating is the status of a master user secret that is being
+	// rotated.
+	statusRotating = "rotating"
+)
+
+// Rotating returns true if RDS reports the master user secret with the
+// supplied status as being rotated.
+func Rotating(status *string) bool {
+	return aws.ToString(status) == statusRotating
+}
+
+// Syncer copies the master user secrets of DB instances and DB clusters to
+// Kubernetes Secrets of their namespaces.
+type Syncer struct {
+	backend  Backend
+	writer   *connection.Writer
+	recorder events.EventRecorder
+}
+
+// NewSyncer returns a Syncer that reads the master user secrets from the
+// supplied backend, writes the Secrets with the supplied writer and emits
+// Events with the supplied recorder.
+func NewSyncer(
+	backend Backend,
+	writer *connection.Writer,
+	recorder events.EventRecorder,
+) *Syncer {
+	return &Syncer{
+		backend:  backend,
+		writer:   writer,
+		recorder: recorder,
+	}
+}
+
+// SyncerFactory returns the Syncer of the resource managers that use the
+// supplied AWS configuration.
+type SyncerFactory func(cfg aws.Config) *Syncer
+
+// NewSyncerFactory returns a SyncerFactory whose Syncers read the master user
+// secrets from the backends newBackend returns, write the Secrets with the
+// supplied writer and emit Events with the supplied recorder.
+func NewSyncerFactory(
+	newBackend func(cfg aws.Config) Backend,
+	writer *connection.Writer,
+	recorder events.EventRecorder,
+) SyncerFactory {
+	return func(cfg aws.Config) *Syncer {
+		return NewSyncer(newBackend(cfg), writer, recorder)
+	}
+}
+
+// For returns the Syncer for the supplied AWS configuration, or nil if the
+// factory is nil.
+func (f SyncerFactory) For(cfg aws.Config) *Syncer {
+	if f == nil {
+		return nil
+	}
+	return f(cfg)
+}
+
+// Sync copies the current version of the master user secret with the
+// supplied ARN to the Secret with the supplied name, in the namespace of the
+// owner, and returns the ID of the version. The keys of the Secret are the
+// keys of the JSON object stored in the master user secret, "username" and
+// "password".
+//
+// The writer compares the Secret with the master user secret before writing
+// it, so the Secret is only written when it is missing or its data differs,
+// which restores a Secret that was deleted or edited. When the current
+// version isn't the last copied version, the secret has been rotated since:
+// an Event is emitted for the owner too.
+func (s *Syncer) Sync(
+	ctx context.Context,
+	owner client.Object,
+	arn string,
+	name string,
+	lastVersionID *string,
+) (*string, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	value, err := s.backend.GetCurrentSecretValue(ctx, arn)
+	if err != nil {
+		return nil, err
+	}
+	if !hasStage(value.VersionStages, VersionStageCurrent) {
+		return nil, fmt.Errorf(
+			"version %s of secret %s isn't the %s version", value.VersionID, arn, VersionStageCurrent,
+		)
+	}
+	data, err := secretData(value.SecretString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value of secret %s: %v", arn, err)
+	}
+	if err := s.writer.Write(ctx, owner, connection.KindSecret, name, data); err != nil {
+		return nil, err
+	}
+	if lastVersionID != nil && *lastVersionID != value.VersionID {
+		rlog.Info("master user secret rotated", "arn", arn, "version", value.VersionID)
+		s.recorder.Eventf(
+			owner, nil, corev1.EventTypeNormal, ReasonMasterUserSecretRotated, "SyncMasterUserSecret",
+			"Master user secret %s rotated to version %s, updated Secret %s",
+			arn, value.VersionID, name,
+		)
+	}
+	return &value.VersionID, nil
+}
+
+// secretData returns the data of the Secret a master user secret with the
+// supplied value is copied to: the keys and values of the JSON object of the
+// value.
+func secretData(value string) (map[string]string, error) {
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(value), &fields); err != nil {
+		return nil, fmt.Errorf("not a JSON object: %v", err)
+	}
+	data := make(map[string]string, len(fields))
+	for key, field := range fields {
+		switch field := field.(type) {
+		case string:
+			data[key] = field
+		default:
+			// The secrets RDS manages only contain strings, but keep
+			// anything else as JSON rather than dropping it.
+			b, err := json.Marshal(field)
+			if err != nil {
+				return nil, err
+			}
+			data[key] = string(b)
+		}
+	}
+	return data, nil
+}
+
+func hasStage(stages []string, stage string) bool {
+	for _, s := range stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package secrets_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/connection"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/secrets"
+	secretsfake "github.com/aws-controllers-k8s/rds-controller/pkg/secrets/fake"
+)
+
+const testSecretARN = "arn:aws:secretsmanager:us-west-2:123456789012:secret:rds!db-1234-AbCdEf"
+
+func TestSyncerSync(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	backend := secretsfake.New()
+	recorder := events.NewFakeRecorder(10)
+	syncer := secrets.NewSyncer(backend, connection.NewWriter(c, c, scheme), recorder)
+	owner := &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "db", UID: "db-uid"},
+	}
+	key := types.NamespacedName{Namespace: "apps", Name: "db-master"}
+
+	first := backend.Put(testSecretARN, `{"username":"admin","password":"first"}`)
+	versionID, err := syncer.Sync(ctx, owner, testSecretARN, "db-master", nil)
+	require.NoError(t, err)
+	assert.Equal(t, first, aws.ToString(versionID))
+	secret := &corev1.Secret{}
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, map[string][]byte{
+		"username": []byte("admin"),
+		"password": []byte("first"),
+	}, secret.Data)
+	assert.True(t, metav1.IsControlledBy(secret, owner))
+	// The first copy isn't a rotation.
+	assert.Empty(t, recorder.Events)
+
+	// Syncing the same version again restores the Secret if it was deleted
+	// or edited.
+	require.NoError(t, c.Delete(ctx, secret))
+	versionID, err = syncer.Sync(ctx, owner, testSecretARN, "db-master", versionID)
+	require.NoError(t, err)
+	assert.Equal(t, first, aws.ToString(versionID))
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "first", string(secret.Data["password"]))
+	secret.Data["password"] = []byte("edited")
+	require.NoError(t, c.Update(ctx, secret))
+	_, err = syncer.Sync(ctx, owner, testSecretARN, "db-master", versionID)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "first", string(secret.Data["password"]))
+	assert.Empty(t, recorder.Events)
+
+	// A rotation updates the Secret and emits an Event.
+	second := backend.Put(testSecretARN, `{"username":"admin","password":"second"}`)
+	versionID, err = syncer.Sync(ctx, owner, testSecretARN, "db-master", versionID)
+	require.NoError(t, err)
+	assert.Equal(t, second, aws.ToString(versionID))
+	require.NoError(t, c.Get(ctx, key, secret))
+	assert.Equal(t, "second", string(secret.Data["password"]))
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Normal "+secrets.ReasonMasterUserSecretRotated)
+}
+
+func TestSyncerSync_Errors(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	backend := secretsfake.New()
+	syncer := secrets.NewSyncer(backend, connection.NewWriter(c, c, scheme), events.NewFakeRecorder(10))
+	owner := &svcapitypes.DBInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "db"},
+	}
+
+	// The secret doesn't exist.
+	_, err := syncer.Sync(ctx, owner, testSecretARN, "db-master", nil)
+	assert.Error(t, err)
+
+	// The secret isn't a JSON object.
+	backend.Put(testSecretARN, "hunter2")
+	_, err = syncer.Sync(ctx, owner, testSecretARN, "db-master", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 2, backend.Calls)
+}
+
+func TestSyncerFactory(t *testing.T) {
+	var f secrets.SyncerFactory
+	assert.Nil(t, f.For(aws.Config{}))
+
+	var regions []string
+	f = secrets.NewSyncerFactory(func(cfg aws.Config) secrets.Backend {
+		regions = append(regions, cfg.Region)
+		return secretsfake.New()
+	}, nil, events.NewFakeRecorder(10))
+	assert.NotNil(t, f.For(aws.Config{Region: "us-west-2"}))
+	assert.Equal(t, []string{"us-west-2"}, regions)
+}
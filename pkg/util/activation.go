@@ -0,0 +1,32 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+// The values of the ActivationState field of DB instances and DB clusters.
+const (
+	ActivationStateStarted = "started"
+	ActivationStateStopped = "stopped"
+)
+
+// WantsStarted returns true if the supplied desired activation state asks
+// for the DB instance or DB cluster to be started.
+func WantsStarted(activationState *string) bool {
+	return activationState != nil && *activationState == ActivationStateStarted
+}
+
+// WantsStopped returns true if the supplied desired activation state asks
+// for the DB instance or DB cluster to be stopped.
+func WantsStopped(activationState *string) bool {
+	return activationState != nil && *activationState == ActivationStateStopped
+}
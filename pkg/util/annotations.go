@@ -2,6 +2,7 @@ package util
 
 import (
 	"strconv"
+	"strings"
 
 	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 )
@@ -64,3 +65,24 @@ func ParseDeletionAnnotations(annotations map[string]string) (*DeleteInputAnnota
 	}
 	return params, nil
 }
+
+// RebootOnPendingParameters returns whether the supplied annotations opt the
+// resource into being rebooted when changes to its parameter group are
+// pending a reboot. Anything other than a value that strconv.ParseBool
+// accepts as true leaves the resource opted out.
+func RebootOnPendingParameters(annotations map[string]string) bool {
+	reboot, err := strconv.ParseBool(annotations[svcapitypes.RebootOnPendingParametersAnnotation])
+	return err == nil && reboot
+}
+
+// IgnoredTagKeys returns the tag key patterns of the IgnoredTagKeysAnnotation
+// in the supplied annotations, without the empty ones.
+func IgnoredTagKeys(annotations map[string]string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(annotations[svcapitypes.IgnoredTagKeysAnnotation], ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
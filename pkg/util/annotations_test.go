@@ -84,3 +84,80 @@ func TestParseDeletionAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestRebootOnPendingParameters(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{
+			name: "no annotations",
+			want: false,
+		},
+		{
+			name: "enabled",
+			annotations: map[string]string{
+				svcapitypes.RebootOnPendingParametersAnnotation: "true",
+			},
+			want: true,
+		},
+		{
+			name: "disabled",
+			annotations: map[string]string{
+				svcapitypes.RebootOnPendingParametersAnnotation: "false",
+			},
+			want: false,
+		},
+		{
+			name: "invalid value is treated as disabled",
+			annotations: map[string]string{
+				svcapitypes.RebootOnPendingParametersAnnotation: "yes please",
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.RebootOnPendingParameters(tt.annotations); got != tt.want {
+				t.Errorf("RebootOnPendingParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoredTagKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+	}{
+		{
+			name: "no annotations",
+			want: nil,
+		},
+		{
+			name: "single pattern",
+			annotations: map[string]string{
+				svcapitypes.IgnoredTagKeysAnnotation: "awsbackup:*",
+			},
+			want: []string{"awsbackup:*"},
+		},
+		{
+			name: "spaces and empty patterns are dropped",
+			annotations: map[string]string{
+				svcapitypes.IgnoredTagKeysAnnotation: " awsbackup:* ,, finops/cost-center ,",
+			},
+			want: []string{"awsbackup:*", "finops/cost-center"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.IgnoredTagKeys(tt.annotations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IgnoredTagKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ConditionReasonPendingReboot is the reason of the ACK.Advisory condition
+// that DB instances and DB clusters carry while changes to their parameter
+// groups are waiting for a reboot to take effect.
+const ConditionReasonPendingReboot = "PendingReboot"
+
+// SetPendingRebootCondition sets the PendingReboot advisory condition on the
+// supplied resource with the supplied message. A nil message removes the
+// condition, since there is nothing left to advise about.
+func SetPendingRebootCondition(
+	subject acktypes.ConditionManager,
+	message *string,
+) {
+	if message != nil {
+		reason := ConditionReasonPendingReboot
+		ackcondition.SetAdvisory(subject, corev1.ConditionTrue, message, &reason)
+		return
+	}
+	if ackcondition.AdvisoryWithReason(subject, ConditionReasonPendingReboot) == nil {
+		return
+	}
+	conds := []*ackv1alpha1.Condition{}
+	for _, c := range subject.Conditions() {
+		if c.Type == ackv1alpha1.ConditionTypeAdvisory &&
+			c.Reason != nil && *c.Reason == ConditionReasonPendingReboot {
+			continue
+		}
+		conds = append(conds, c)
+	}
+	subject.ReplaceConditions(conds)
+}
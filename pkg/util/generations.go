@@ -0,0 +1,26 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+// GenerationRequested returns true if the supplied requested action
+// generation, such as a DB instance's Spec.RestartGeneration, has not been
+// executed yet. Action generations only move forward, so a requested
+// generation that is lower than or equal to the last executed one is
+// ignored.
+func GenerationRequested(requested *int64, lastExecuted *int64) bool {
+	if requested == nil {
+		return false
+	}
+	return lastExecuted == nil || *requested > *lastExecuted
+}
@@ -0,0 +1,44 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestGenerationRequested(t *testing.T) {
+	tests := []struct {
+		name         string
+		requested    *int64
+		lastExecuted *int64
+		want         bool
+	}{
+		{"nothing requested", nil, aws.Int64(1), false},
+		{"first request", aws.Int64(1), nil, true},
+		{"incremented", aws.Int64(2), aws.Int64(1), true},
+		{"already executed", aws.Int64(2), aws.Int64(2), false},
+		{"lowered", aws.Int64(1), aws.Int64(2), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.GenerationRequested(tt.requested, tt.lastExecuted); got != tt.want {
+				t.Errorf("GenerationRequested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
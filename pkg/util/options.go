@@ -0,0 +1,113 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"sort"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// GetOptionsDifference compares the desired options of an option group with
+// the latest ones and returns the option configurations to include, which
+// are the options that are missing from the latest options or that differ
+// from them, and the sorted names of the latest options to remove.
+//
+// Only the fields and the option settings that are set in a desired option
+// are compared, so that settings RDS defaults, like the port of an option,
+// don't cause a difference.
+//
+// Nil desired options mean that the options aren't managed and never differ
+// from the latest ones. Only an empty list of desired options removes all
+// the latest options.
+func GetOptionsDifference(
+	to, from []*svcapitypes.OptionConfiguration,
+) (included []*svcapitypes.OptionConfiguration, removed []string) {
+	if to == nil {
+		return nil, nil
+	}
+	fromByName := optionsByName(from)
+	toByName := optionsByName(to)
+
+	for _, toOpt := range to {
+		if toOpt == nil || toOpt.OptionName == nil {
+			continue
+		}
+		fromOpt, existsInFrom := fromByName[*toOpt.OptionName]
+		if !existsInFrom || optionDiffers(toOpt, fromOpt) {
+			included = append(included, toOpt)
+		}
+	}
+	for name := range fromByName {
+		if _, existsInTo := toByName[name]; !existsInTo {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(removed)
+	return included, removed
+}
+
+// optionDiffers returns true if any field or option setting that is set in
+// the desired option has a different value in the latest option.
+func optionDiffers(to, from *svcapitypes.OptionConfiguration) bool {
+	if to.OptionVersion != nil && !stringPtrEqual(to.OptionVersion, from.OptionVersion) {
+		return true
+	}
+	if to.Port != nil && (from.Port == nil || *to.Port != *from.Port) {
+		return true
+	}
+	if to.VPCSecurityGroupMemberships != nil &&
+		!EqualStringSets(to.VPCSecurityGroupMemberships, from.VPCSecurityGroupMemberships) {
+		return true
+	}
+	if to.DBSecurityGroupMemberships != nil &&
+		!EqualStringSets(to.DBSecurityGroupMemberships, from.DBSecurityGroupMemberships) {
+		return true
+	}
+	fromSettings := map[string]*string{}
+	for _, s := range from.OptionSettings {
+		if s != nil && s.Name != nil {
+			fromSettings[*s.Name] = s.Value
+		}
+	}
+	for _, s := range to.OptionSettings {
+		if s == nil || s.Name == nil {
+			continue
+		}
+		fromVal, ok := fromSettings[*s.Name]
+		if !ok || !stringPtrEqual(s.Value, fromVal) {
+			return true
+		}
+	}
+	return false
+}
+
+func optionsByName(
+	options []*svcapitypes.OptionConfiguration,
+) map[string]*svcapitypes.OptionConfiguration {
+	byName := make(map[string]*svcapitypes.OptionConfiguration, len(options))
+	for _, opt := range options {
+		if opt != nil && opt.OptionName != nil {
+			byName[*opt.OptionName] = opt
+		}
+	}
+	return byName
+}
+
+func stringPtrEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,132 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func option(
+	name string,
+	settings ...string,
+) *svcapitypes.OptionConfiguration {
+	opt := &svcapitypes.OptionConfiguration{OptionName: aws.String(name)}
+	for i := 0; i+1 < len(settings); i += 2 {
+		opt.OptionSettings = append(opt.OptionSettings, &svcapitypes.OptionSetting{
+			Name:  aws.String(settings[i]),
+			Value: aws.String(settings[i+1]),
+		})
+	}
+	return opt
+}
+
+func TestGetOptionsDifference(t *testing.T) {
+	withPort := func(opt *svcapitypes.OptionConfiguration, port int64) *svcapitypes.OptionConfiguration {
+		opt.Port = aws.Int64(port)
+		return opt
+	}
+	withSGs := func(opt *svcapitypes.OptionConfiguration, sgs ...string) *svcapitypes.OptionConfiguration {
+		opt.VPCSecurityGroupMemberships = aws.StringSlice(sgs)
+		return opt
+	}
+	tests := []struct {
+		name         string
+		to           []*svcapitypes.OptionConfiguration
+		from         []*svcapitypes.OptionConfiguration
+		wantIncluded []string
+		wantRemoved  []string
+	}{
+		{
+			name: "both empty",
+		},
+		{
+			name:         "new option",
+			to:           []*svcapitypes.OptionConfiguration{option("TDE")},
+			wantIncluded: []string{"TDE"},
+		},
+		{
+			name: "option not in desired is removed",
+			to:   []*svcapitypes.OptionConfiguration{option("TDE")},
+			from: []*svcapitypes.OptionConfiguration{
+				option("TDE"), option("SQLSERVER_BACKUP_RESTORE"), option("APEX"),
+			},
+			wantRemoved: []string{"APEX", "SQLSERVER_BACKUP_RESTORE"},
+		},
+		{
+			name: "options not managed",
+			from: []*svcapitypes.OptionConfiguration{option("TDE"), option("APEX")},
+		},
+		{
+			name:        "all options removed",
+			to:          []*svcapitypes.OptionConfiguration{},
+			from:        []*svcapitypes.OptionConfiguration{option("TDE"), option("APEX")},
+			wantRemoved: []string{"APEX", "TDE"},
+		},
+		{
+			name: "unset fields and settings are ignored",
+			to:   []*svcapitypes.OptionConfiguration{option("OEM", "A", "1")},
+			from: []*svcapitypes.OptionConfiguration{
+				withSGs(withPort(option("OEM", "A", "1", "B", "2"), 5500), "sg-1"),
+			},
+		},
+		{
+			name: "setting value differs",
+			to:   []*svcapitypes.OptionConfiguration{option("OEM", "A", "1")},
+			from: []*svcapitypes.OptionConfiguration{
+				option("OEM", "A", "2", "B", "2"),
+			},
+			wantIncluded: []string{"OEM"},
+		},
+		{
+			name:         "setting missing from latest option",
+			to:           []*svcapitypes.OptionConfiguration{option("OEM", "A", "1")},
+			from:         []*svcapitypes.OptionConfiguration{option("OEM")},
+			wantIncluded: []string{"OEM"},
+		},
+		{
+			name:         "port differs",
+			to:           []*svcapitypes.OptionConfiguration{withPort(option("OEM"), 5501)},
+			from:         []*svcapitypes.OptionConfiguration{withPort(option("OEM"), 5500)},
+			wantIncluded: []string{"OEM"},
+		},
+		{
+			name:         "security groups differ",
+			to:           []*svcapitypes.OptionConfiguration{withSGs(option("OEM"), "sg-1", "sg-2")},
+			from:         []*svcapitypes.OptionConfiguration{withSGs(option("OEM"), "sg-1")},
+			wantIncluded: []string{"OEM"},
+		},
+		{
+			name: "security groups in a different order",
+			to:   []*svcapitypes.OptionConfiguration{withSGs(option("OEM"), "sg-2", "sg-1")},
+			from: []*svcapitypes.OptionConfiguration{withSGs(option("OEM"), "sg-1", "sg-2")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			included, removed := util.GetOptionsDifference(tt.to, tt.from)
+			var includedNames []string
+			for _, opt := range included {
+				includedNames = append(includedNames, *opt.OptionName)
+			}
+			assert.Equal(t, tt.wantIncluded, includedNames)
+			assert.Equal(t, tt.wantRemoved, removed)
+		})
+	}
+}
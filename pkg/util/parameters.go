@@ -15,8 +15,20 @@ package util
 
 import (
 	"fmt"
+	"sort"
 
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	// ApplyMethodPendingReboot is the apply method of parameters that only
+	// take effect after the DB instances or DB clusters using the parameter
+	// group are rebooted. It is also the ParameterApplyStatus of a DB
+	// instance, and the DBClusterParameterGroupStatus of a DB cluster member,
+	// with such changes outstanding.
+	ApplyMethodPendingReboot = "pending-reboot"
 )
 
 var (
@@ -82,26 +94,45 @@ func GetParametersDifference(
 }
 
 // ChunkParameters splits a supplied map of parameters into multiple
-// slices of maps of parameters of a given size.
+// slices of maps of parameters of a given size. Parameters are assigned to
+// chunks in order of parameter name so that repeated calls with the same
+// input produce the same chunks.
 func ChunkParameters(
 	input Parameters,
 	chunkSize int,
 ) []Parameters {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	var chunks []Parameters
 	chunk := Parameters{}
-	idx := 0
-	for k, v := range input {
-		if idx < chunkSize {
-			chunk[k] = v
-			idx++
-		} else {
+	for _, k := range keys {
+		if len(chunk) == chunkSize {
 			// reset the chunker
 			chunks = append(chunks, chunk)
 			chunk = Parameters{}
-			idx = 0
 		}
+		chunk[k] = input[k]
 	}
 	chunks = append(chunks, chunk)
 
 	return chunks
 }
+
+// StaticParameters returns the names of the supplied parameter statuses
+// that use the pending-reboot apply method, or nil if there are none. The
+// apply method of a parameter never changes: whether a DB instance is waiting
+// for a reboot is reported by the ParameterApplyStatus of its parameter
+// groups instead.
+func StaticParameters(statuses []*svcapitypes.Parameter) []*string {
+	var names []*string
+	for _, st := range statuses {
+		if st.ApplyMethod != nil && *st.ApplyMethod == ApplyMethodPendingReboot {
+			names = append(names, st.ParameterName)
+		}
+	}
+	return names
+}
@@ -14,9 +14,12 @@
 package util
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
 )
 
 func TestGetParametersDifference_PointerComparison(t *testing.T) {
@@ -127,3 +130,64 @@ func TestGetParametersDifference_RemoveParameter(t *testing.T) {
 		t.Errorf("Expected 1 unchanged parameter, got %d: %v", len(unchanged), unchanged)
 	}
 }
+
+func TestChunkParameters(t *testing.T) {
+	input := Parameters{}
+	for i := 0; i < 45; i++ {
+		input[fmt.Sprintf("param_%02d", i)] = aws.String(fmt.Sprintf("%d", i))
+	}
+
+	chunks := ChunkParameters(input, 20)
+
+	if len(chunks) != 3 {
+		t.Fatalf("Expected 3 chunks, got %d", len(chunks))
+	}
+	for i, want := range []int{20, 20, 5} {
+		if len(chunks[i]) != want {
+			t.Errorf("Expected chunk %d to have %d parameters, got %d", i, want, len(chunks[i]))
+		}
+	}
+	// Parameters are chunked in name order, so param_00..param_19 land in
+	// the first chunk, param_20..param_39 in the second and so on.
+	for i := 0; i < 45; i++ {
+		name := fmt.Sprintf("param_%02d", i)
+		if _, ok := chunks[i/20][name]; !ok {
+			t.Errorf("Expected parameter %s in chunk %d", name, i/20)
+		}
+	}
+}
+
+func TestChunkParameters_ExactMultiple(t *testing.T) {
+	input := Parameters{
+		"a": aws.String("1"),
+		"b": aws.String("2"),
+		"c": aws.String("3"),
+		"d": aws.String("4"),
+	}
+
+	chunks := ChunkParameters(input, 2)
+
+	if len(chunks) != 2 {
+		t.Fatalf("Expected 2 chunks, got %d: %v", len(chunks), chunks)
+	}
+	if _, ok := chunks[1]["d"]; !ok {
+		t.Errorf("Expected last parameter in last chunk, got %v", chunks[1])
+	}
+}
+
+func TestStaticParameters(t *testing.T) {
+	statuses := []*svcapitypes.Parameter{
+		{ParameterName: aws.String("work_mem"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("shared_buffers"), ApplyMethod: aws.String(ApplyMethodPendingReboot)},
+		{ParameterName: aws.String("max_connections")},
+	}
+
+	names := StaticParameters(statuses)
+
+	if len(names) != 1 || *names[0] != "shared_buffers" {
+		t.Errorf("Expected only shared_buffers to be static, got %v", aws.StringValueSlice(names))
+	}
+	if StaticParameters(statuses[:1]) != nil {
+		t.Errorf("Expected nil when no parameters are static")
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"sort"
+)
+
+// ComputeStringSetDelta compares a desired and a latest list of strings as
+// sets and returns, in sorted order, the strings that are only in the
+// desired list and the strings that are only in the latest list. Nil and
+// empty strings are ignored.
+func ComputeStringSetDelta(
+	desired []*string,
+	latest []*string,
+) (added []string, removed []string) {
+	desiredSet := stringSet(desired)
+	latestSet := stringSet(latest)
+	for s := range desiredSet {
+		if _, ok := latestSet[s]; !ok {
+			added = append(added, s)
+		}
+	}
+	for s := range latestSet {
+		if _, ok := desiredSet[s]; !ok {
+			removed = append(removed, s)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// EqualStringSets returns true if the two lists of strings contain the same
+// strings, regardless of their order and of duplicates.
+func EqualStringSets(a []*string, b []*string) bool {
+	added, removed := ComputeStringSetDelta(a, b)
+	return len(added) == 0 && len(removed) == 0
+}
+
+func stringSet(list []*string) map[string]struct{} {
+	set := make(map[string]struct{}, len(list))
+	for _, s := range list {
+		if s != nil && *s != "" {
+			set[*s] = struct{}{}
+		}
+	}
+	return set
+}
@@ -0,0 +1,76 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestComputeStringSetDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     []*string
+		latest      []*string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name: "both empty",
+		},
+		{
+			name:      "all added",
+			desired:   aws.StringSlice([]string{"b", "a"}),
+			wantAdded: []string{"a", "b"},
+		},
+		{
+			name:        "all removed",
+			desired:     []*string{},
+			latest:      aws.StringSlice([]string{"a", "b"}),
+			wantRemoved: []string{"a", "b"},
+		},
+		{
+			name:        "added and removed",
+			desired:     aws.StringSlice([]string{"a", "c"}),
+			latest:      aws.StringSlice([]string{"b", "a"}),
+			wantAdded:   []string{"c"},
+			wantRemoved: []string{"b"},
+		},
+		{
+			name:    "order and duplicates ignored",
+			desired: aws.StringSlice([]string{"b", "a", "a"}),
+			latest:  aws.StringSlice([]string{"a", "b"}),
+		},
+		{
+			name:    "nil and empty strings ignored",
+			desired: []*string{nil, aws.String(""), aws.String("a")},
+			latest:  aws.StringSlice([]string{"a"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := util.ComputeStringSetDelta(tt.desired, tt.latest)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.wantRemoved, removed)
+			assert.Equal(t,
+				len(tt.wantAdded) == 0 && len(tt.wantRemoved) == 0,
+				util.EqualStringSets(tt.desired, tt.latest),
+			)
+		})
+	}
+}
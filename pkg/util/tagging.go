@@ -0,0 +1,320 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+// DefaultTagBatchSize is the maximum number of tags added to or removed from
+// a resource in a single call, which is the maximum number of tags of an RDS
+// resource.
+const DefaultTagBatchSize = 50
+
+// AWSTagKeyPattern matches the keys of the tags AWS reserves, which can't be
+// added, changed or removed. They are always ignored.
+const AWSTagKeyPattern = "aws:*"
+
+// TaggingAPI is the part of the RDS API that manages the tags of resources.
+//
+// NOTE(jaypipes): RDS' Tagging APIs differ from other AWS APIs in the
+// following ways:
+//
+//  1. The names of the tagging API operations are different. Other APIs use the
+//     Tagris `ListTagsForResource`, `TagResource` and `UntagResource` API
+//     calls. RDS uses `ListTagsForResource`, `AddTagsToResource` and
+//     `RemoveTagsFromResource`.
+//
+//  2. Even though the name of the `ListTagsForResource` API call is the same,
+//     the structure of the input and the output are different from other APIs.
+//     For the input, instead of a `ResourceArn` field, RDS names the field
+//     `ResourceName`, but actually expects an ARN, not the instance
+//     name.  This is the same for the `AddTagsToResource` and
+//     `RemoveTagsFromResource` input shapes. For the output shape, the field is
+//     called `TagList` instead of `Tags` but is otherwise the same struct with
+//     a `Key` and `Value` member field.
+type TaggingAPI interface {
+	ListTagsForResource(context.Context, *svcsdk.ListTagsForResourceInput, ...func(*svcsdk.Options)) (*svcsdk.ListTagsForResourceOutput, error)
+	AddTagsToResource(context.Context, *svcsdk.AddTagsToResourceInput, ...func(*svcsdk.Options)) (*svcsdk.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(context.Context, *svcsdk.RemoveTagsFromResourceInput, ...func(*svcsdk.Options)) (*svcsdk.RemoveTagsFromResourceOutput, error)
+}
+
+// APICallRecorder records the outcome of the calls to the RDS API, like the
+// metrics of the resource managers do.
+type APICallRecorder interface {
+	RecordAPICall(opType string, opID string, err error)
+}
+
+// TagReconcilerOptions configures a TagReconciler.
+type TagReconcilerOptions struct {
+	// DefaultTags are added to the desired tags of every resource that
+	// doesn't have a tag with the same key.
+	DefaultTags []*svcapitypes.Tag
+	// IgnoredTagKeys are the patterns of the keys of the tags that are never
+	// added, changed or removed, and that don't make the tags of a resource
+	// differ, like the tags AWS Backup adds. A '*' in a pattern matches any
+	// sequence of characters. AWSTagKeyPattern is always ignored.
+	IgnoredTagKeys []string
+	// BatchSize is the maximum number of tags added or removed in a single
+	// call. Defaults to DefaultTagBatchSize.
+	BatchSize int
+}
+
+// TagReconciler reconciles the tags of RDS resources with the RDS tagging
+// API. It is shared by the resource managers, which supply their API client
+// and metrics to each call.
+type TagReconciler struct {
+	defaultTags []*svcapitypes.Tag
+	ignored     []*regexp.Regexp
+	batchSize   int
+}
+
+// NewTagReconciler returns a TagReconciler configured with the supplied
+// options, or an error if they are invalid.
+func NewTagReconciler(opts TagReconcilerOptions) (*TagReconciler, error) {
+	if opts.BatchSize < 0 {
+		return nil, fmt.Errorf("invalid tag batch size %d: must not be negative", opts.BatchSize)
+	}
+	r := &TagReconciler{batchSize: opts.BatchSize}
+	if r.batchSize == 0 {
+		r.batchSize = DefaultTagBatchSize
+	}
+	for _, pattern := range append([]string{AWSTagKeyPattern}, opts.IgnoredTagKeys...) {
+		if pattern == "" {
+			return nil, fmt.Errorf("invalid ignored tag key pattern: must not be empty")
+		}
+		r.ignored = append(r.ignored, tagKeyPatternRegexp(pattern))
+	}
+	for _, tag := range opts.DefaultTags {
+		if tag == nil || tag.Key == nil || *tag.Key == "" {
+			return nil, fmt.Errorf("invalid default tag: key must not be empty")
+		}
+		if r.Ignored(*tag.Key) {
+			return nil, fmt.Errorf("invalid default tag %q: key is ignored", *tag.Key)
+		}
+		r.defaultTags = append(r.defaultTags, tag)
+	}
+	return r, nil
+}
+
+// NewDefaultTagReconciler returns a TagReconciler with the default options:
+// no default tags, only the AWS tags ignored, and batches of
+// DefaultTagBatchSize tags.
+func NewDefaultTagReconciler() *TagReconciler {
+	return &TagReconciler{
+		ignored:   []*regexp.Regexp{tagKeyPatternRegexp(AWSTagKeyPattern)},
+		batchSize: DefaultTagBatchSize,
+	}
+}
+
+// WithIgnoredTagKeys returns a copy of the TagReconciler that also ignores
+// the tags whose keys match the supplied patterns. Empty patterns are
+// skipped.
+func (r *TagReconciler) WithIgnoredTagKeys(patterns []string) *TagReconciler {
+	res := *r
+	res.ignored = append([]*regexp.Regexp{}, r.ignored...)
+	for _, pattern := range patterns {
+		if pattern != "" {
+			res.ignored = append(res.ignored, tagKeyPatternRegexp(pattern))
+		}
+	}
+	return &res
+}
+
+// ForAnnotations returns the TagReconciler of a resource with the supplied
+// annotations, which also ignores the tag key patterns of its
+// IgnoredTagKeysAnnotation.
+func (r *TagReconciler) ForAnnotations(annotations map[string]string) *TagReconciler {
+	patterns := IgnoredTagKeys(annotations)
+	if len(patterns) == 0 {
+		return r
+	}
+	return r.WithIgnoredTagKeys(patterns)
+}
+
+// tagKeyPatternRegexp returns the regular expression that matches the keys
+// matching the supplied pattern.
+func tagKeyPatternRegexp(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// Ignored returns true if the tag with the supplied key is ignored.
+func (r *TagReconciler) Ignored(key string) bool {
+	for _, re := range r.ignored {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// Desired returns the tags to set on a resource with the supplied tags: the
+// tags that aren't ignored and the default tags the resource doesn't
+// override.
+func (r *TagReconciler) Desired(tags []*svcapitypes.Tag) []*svcapitypes.Tag {
+	res := r.Managed(tags)
+	keys := map[string]bool{}
+	for _, tag := range res {
+		keys[*tag.Key] = true
+	}
+	for _, tag := range r.defaultTags {
+		if !keys[*tag.Key] {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// Managed returns the supplied tags without the ignored ones.
+func (r *TagReconciler) Managed(tags []*svcapitypes.Tag) []*svcapitypes.Tag {
+	var res []*svcapitypes.Tag
+	for _, tag := range tags {
+		if tag != nil && tag.Key != nil && !r.Ignored(*tag.Key) {
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// Equal returns true if a resource with the latest tags has the desired tags.
+func (r *TagReconciler) Equal(desired, latest []*svcapitypes.Tag) bool {
+	return EqualTags(r.Desired(desired), r.Managed(latest))
+}
+
+// Compare adds a difference at Spec.Tags to the delta if a resource with the
+// latest tags doesn't have the desired tags.
+func (r *TagReconciler) Compare(
+	delta *ackcompare.Delta,
+	desired []*svcapitypes.Tag,
+	latest []*svcapitypes.Tag,
+) {
+	if !r.Equal(desired, latest) {
+		delta.Add("Spec.Tags", desired, latest)
+	}
+}
+
+// Get returns the tags of the resource with the supplied ARN.
+func (r *TagReconciler) Get(
+	ctx context.Context,
+	api TaggingAPI,
+	metrics APICallRecorder,
+	arn string,
+) ([]*svcapitypes.Tag, error) {
+	resp, err := api.ListTagsForResource(
+		ctx,
+		&svcsdk.ListTagsForResourceInput{
+			ResourceName: &arn,
+		},
+	)
+	metrics.RecordAPICall("GET", "ListTagsForResource", err)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]*svcapitypes.Tag, 0, len(resp.TagList))
+	for _, tag := range resp.TagList {
+		tags = append(tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	return tags, nil
+}
+
+// Sync adds, changes and removes the tags of the resource with the supplied
+// ARN and latest tags so that it has the desired tags.
+func (r *TagReconciler) Sync(
+	ctx context.Context,
+	api TaggingAPI,
+	metrics APICallRecorder,
+	arn string,
+	desired []*svcapitypes.Tag,
+	latest []*svcapitypes.Tag,
+) error {
+	rlog := ackrtlog.FromContext(ctx)
+	toAdd, toDelete := ComputeTagsDelta(r.Desired(desired), r.Managed(latest))
+
+	for _, keys := range batches(toDelete, r.batchSize) {
+		rlog.Debug("removing tags from resource", "arn", arn, "tags", keys)
+		_, err := api.RemoveTagsFromResource(
+			ctx,
+			&svcsdk.RemoveTagsFromResourceInput{
+				ResourceName: &arn,
+				TagKeys:      keys,
+			},
+		)
+		metrics.RecordAPICall("UPDATE", "RemoveTagsFromResource", err)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE(jaypipes): According to the RDS API documentation, adding a tag
+	// with a new value overwrites any existing tag with the same key. So, we
+	// don't need to do anything to "update" a Tag. Simply including it in the
+	// AddTagsToResource call is enough.
+	for _, tags := range batches(toAdd, r.batchSize) {
+		rlog.Debug("adding tags to resource", "arn", arn, "tags", tags)
+		_, err := api.AddTagsToResource(
+			ctx,
+			&svcsdk.AddTagsToResourceInput{
+				ResourceName: &arn,
+				Tags:         sdkTags(tags),
+			},
+		)
+		metrics.RecordAPICall("UPDATE", "AddTagsToResource", err)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batches splits the supplied items into batches of at most size items.
+func batches[T any](items []T, size int) [][]T {
+	var res [][]T
+	for len(items) > size {
+		res = append(res, items[:size])
+		items = items[size:]
+	}
+	if len(items) > 0 {
+		res = append(res, items)
+	}
+	return res
+}
+
+// sdkTags returns the RDS API shape of the supplied tags.
+func sdkTags(tags []*svcapitypes.Tag) []svcsdktypes.Tag {
+	res := make([]svcsdktypes.Tag, len(tags))
+	for i := range tags {
+		res[i] = svcsdktypes.Tag{
+			Key:   tags[i].Key,
+			Value: tags[i].Value,
+		}
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	flag "github.com/spf13/pflag"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+)
+
+const (
+	flagDefaultTags    = "default-tags"
+	flagIgnoredTagKeys = "ignored-tag-keys"
+)
+
+// TagConfig contains the controller flags of the tag reconciler.
+type TagConfig struct {
+	DefaultTags    []string
+	IgnoredTagKeys []string
+}
+
+// BindFlags defines the tag reconciler flags.
+func (cfg *TagConfig) BindFlags() {
+	flag.StringSliceVar(
+		&cfg.DefaultTags, flagDefaultTags,
+		[]string{},
+		"Tags added to every resource that doesn't have a tag with the same key, as key=value"+
+			" pairs. Unlike the resource tags, they aren't written to the spec of the resources."+
+			" Comma-separated, can be repeated.",
+	)
+	flag.StringSliceVar(
+		&cfg.IgnoredTagKeys, flagIgnoredTagKeys,
+		[]string{},
+		"Patterns of the keys of the tags the controller never adds, changes or removes, like the"+
+			" tags added by AWS Backup, e.g. awsbackup:*. A '*' matches any sequence of characters."+
+			" Keys starting with aws: are always ignored. Comma-separated, can be repeated.",
+	)
+}
+
+// NewTagReconciler validates the flags and returns the TagReconciler they
+// configure.
+func (cfg *TagConfig) NewTagReconciler() (*TagReconciler, error) {
+	defaultTags := []*svcapitypes.Tag{}
+	for _, pair := range cfg.DefaultTags {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf(
+				"invalid value for flag '%s': %q isn't a key=value pair", flagDefaultTags, pair,
+			)
+		}
+		defaultTags = append(defaultTags, &svcapitypes.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(value),
+		})
+	}
+	r, err := NewTagReconciler(TagReconcilerOptions{
+		DefaultTags:    defaultTags,
+		IgnoredTagKeys: cfg.IgnoredTagKeys,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"invalid value for flags '%s' and '%s': %v", flagDefaultTags, flagIgnoredTagKeys, err,
+		)
+	}
+	return r, nil
+}
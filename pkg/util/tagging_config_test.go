@@ -0,0 +1,59 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+func TestTagConfigNewTagReconciler(t *testing.T) {
+	cfg := util.TagConfig{IgnoredTagKeys: []string{"awsbackup:*", "finops/cost-center"}}
+	r, err := cfg.NewTagReconciler()
+	require.NoError(t, err)
+	assert.True(t, r.Ignored("awsbackup:backup-plan"))
+	assert.True(t, r.Ignored("finops/cost-center"))
+	assert.True(t, r.Ignored("aws:cloudformation:stack-name"))
+	assert.False(t, r.Ignored("team"))
+
+	cfg = util.TagConfig{IgnoredTagKeys: []string{"awsbackup:*", ""}}
+	_, err = cfg.NewTagReconciler()
+	assert.ErrorContains(t, err, "ignored-tag-keys")
+}
+
+func TestTagConfigNewTagReconciler_DefaultTags(t *testing.T) {
+	cfg := util.TagConfig{
+		DefaultTags:    []string{"team=data", "cost-center="},
+		IgnoredTagKeys: []string{"awsbackup:*"},
+	}
+	r, err := cfg.NewTagReconciler()
+	require.NoError(t, err)
+	desired := map[string]string{}
+	for _, tag := range r.Desired([]*svcapitypes.Tag{{Key: aws.String("team"), Value: aws.String("web")}}) {
+		desired[*tag.Key] = *tag.Value
+	}
+	assert.Equal(t, map[string]string{"team": "web", "cost-center": ""}, desired)
+
+	for _, tags := range [][]string{{"team"}, {"=data"}, {"awsbackup:plan=daily"}} {
+		cfg = util.TagConfig{DefaultTags: tags, IgnoredTagKeys: []string{"awsbackup:*"}}
+		_, err = cfg.NewTagReconciler()
+		assert.ErrorContains(t, err, "default-tags", tags)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package util_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/rds"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/rds-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/rdsapi/fake"
+	"github.com/aws-controllers-k8s/rds-controller/pkg/util"
+)
+
+const testARN = "arn:aws:rds:us-west-2:111111111111:db:db"
+
+// apiCalls records the API calls reported to it.
+type apiCalls []string
+
+func (c *apiCalls) RecordAPICall(opType string, opID string, err error) {
+	*c = append(*c, fmt.Sprintf("%s %s %v", opType, opID, err != nil))
+}
+
+func tag(key, value string) *svcapitypes.Tag {
+	return &svcapitypes.Tag{Key: aws.String(key), Value: aws.String(value)}
+}
+
+// tagMap returns the tags of the supplied resource of the fake by key.
+func tagMap(api *fake.RDS, arn string) map[string]string {
+	res := map[string]string{}
+	for _, t := range api.Tags[arn] {
+		res[*t.Key] = *t.Value
+	}
+	return res
+}
+
+func newTagReconciler(t *testing.T, opts util.TagReconcilerOptions) *util.TagReconciler {
+	r, err := util.NewTagReconciler(opts)
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewTagReconciler_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opts util.TagReconcilerOptions
+	}{
+		{"negative batch size", util.TagReconcilerOptions{BatchSize: -1}},
+		{"empty ignored pattern", util.TagReconcilerOptions{IgnoredTagKeys: []string{""}}},
+		{"default tag without key", util.TagReconcilerOptions{
+			DefaultTags: []*svcapitypes.Tag{{Value: aws.String("v")}},
+		}},
+		{"ignored default tag", util.TagReconcilerOptions{
+			DefaultTags: []*svcapitypes.Tag{tag("aws:owner", "me")},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := util.NewTagReconciler(tt.opts)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTagReconciler_Ignored(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{
+		IgnoredTagKeys: []string{"awsbackup*", "*/managed-by", "exact.key"},
+	})
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"aws:cloudformation:stack-name", true},
+		{"awsbackup:backup-plan", true},
+		{"team/managed-by", true},
+		{"example.com/team/managed-by", true},
+		{"exact.key", true},
+		{"exactXkey", false},
+		{"exact.key.suffix", false},
+		{"team", false},
+		{"AWS:upper", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, r.Ignored(tt.key))
+		})
+	}
+	assert.True(t, util.NewDefaultTagReconciler().Ignored("aws:backup:source-resource"))
+	assert.False(t, util.NewDefaultTagReconciler().Ignored("awsbackup:backup-plan"))
+}
+
+func TestTagReconciler_Desired(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{
+		DefaultTags:    []*svcapitypes.Tag{tag("owner", "platform"), tag("env", "prod")},
+		IgnoredTagKeys: []string{"backup:*"},
+	})
+	got := r.Desired([]*svcapitypes.Tag{
+		tag("env", "dev"), tag("backup:plan", "daily"), tag("aws:x", "y"), nil,
+	})
+	assert.Equal(t, []*svcapitypes.Tag{tag("env", "dev"), tag("owner", "platform")}, got)
+	assert.Equal(t, []*svcapitypes.Tag{tag("owner", "platform"), tag("env", "prod")}, r.Desired(nil))
+}
+
+func TestTagReconciler_Compare(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{
+		DefaultTags:    []*svcapitypes.Tag{tag("owner", "platform")},
+		IgnoredTagKeys: []string{"backup:*"},
+	})
+	tests := []struct {
+		name     string
+		desired  []*svcapitypes.Tag
+		latest   []*svcapitypes.Tag
+		wantDiff bool
+	}{
+		{
+			name:    "same tags in another order",
+			desired: []*svcapitypes.Tag{tag("a", "1"), tag("b", "2")},
+			latest:  []*svcapitypes.Tag{tag("owner", "platform"), tag("b", "2"), tag("a", "1")},
+		},
+		{
+			name:    "ignored tags on either side",
+			desired: []*svcapitypes.Tag{tag("a", "1"), tag("backup:wanted", "x")},
+			latest: []*svcapitypes.Tag{
+				tag("a", "1"), tag("owner", "platform"), tag("aws:cloudformation:stack-name", "s"),
+				tag("backup:plan", "daily"),
+			},
+		},
+		{
+			name:     "missing default tag",
+			desired:  []*svcapitypes.Tag{tag("a", "1")},
+			latest:   []*svcapitypes.Tag{tag("a", "1")},
+			wantDiff: true,
+		},
+		{
+			name:     "overridden default tag",
+			desired:  []*svcapitypes.Tag{tag("owner", "data")},
+			latest:   []*svcapitypes.Tag{tag("owner", "platform")},
+			wantDiff: true,
+		},
+		{
+			name:     "extra tag",
+			desired:  nil,
+			latest:   []*svcapitypes.Tag{tag("owner", "platform"), tag("a", "1")},
+			wantDiff: true,
+		},
+		{
+			name:     "different value",
+			desired:  []*svcapitypes.Tag{tag("a", "1")},
+			latest:   []*svcapitypes.Tag{tag("owner", "platform"), tag("a", "2")},
+			wantDiff: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			r.Compare(delta, tt.desired, tt.latest)
+			assert.Equal(t, tt.wantDiff, delta.DifferentAt("Spec.Tags"))
+			assert.Equal(t, !tt.wantDiff, r.Equal(tt.desired, tt.latest))
+		})
+	}
+}
+
+func TestTagReconciler_Get(t *testing.T) {
+	api := fake.New()
+	api.Tags[testARN] = []svcsdktypes.Tag{
+		{Key: aws.String("a"), Value: aws.String("1")},
+		{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("s")},
+	}
+	calls := &apiCalls{}
+
+	tags, err := util.NewDefaultTagReconciler().Get(context.Background(), api, calls, testARN)
+	require.NoError(t, err)
+	// Ignored tags are reported: they are only left out of comparisons.
+	assert.Equal(t, []*svcapitypes.Tag{tag("a", "1"), tag("aws:cloudformation:stack-name", "s")}, tags)
+	assert.Equal(t, testARN, *api.CallsTo("ListTagsForResource")[0].(*svcsdk.ListTagsForResourceInput).ResourceName)
+	assert.Equal(t, apiCalls{"GET ListTagsForResource false"}, *calls)
+
+	api.Errors["ListTagsForResource"] = fake.NewAPIError("AccessDenied", "denied")
+	_, err = util.NewDefaultTagReconciler().Get(context.Background(), api, calls, testARN)
+	assert.Error(t, err)
+	assert.Equal(t, "GET ListTagsForResource true", (*calls)[1])
+}
+
+func TestTagReconciler_Sync(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{
+		DefaultTags:    []*svcapitypes.Tag{tag("owner", "platform")},
+		IgnoredTagKeys: []string{"backup:*"},
+	})
+	api := fake.New()
+	api.Tags[testARN] = []svcsdktypes.Tag{
+		{Key: aws.String("keep"), Value: aws.String("1")},
+		{Key: aws.String("change"), Value: aws.String("old")},
+		{Key: aws.String("remove"), Value: aws.String("1")},
+		{Key: aws.String("backup:plan"), Value: aws.String("daily")},
+		{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("s")},
+	}
+	latest, err := r.Get(context.Background(), api, &apiCalls{}, testARN)
+	require.NoError(t, err)
+	calls := &apiCalls{}
+
+	err = r.Sync(context.Background(), api, calls, testARN, []*svcapitypes.Tag{
+		tag("keep", "1"), tag("change", "new"), tag("add", "1"), tag("backup:wanted", "x"),
+	}, latest)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"keep":                          "1",
+		"change":                        "new",
+		"add":                           "1",
+		"owner":                         "platform",
+		"backup:plan":                   "daily",
+		"aws:cloudformation:stack-name": "s",
+	}, tagMap(api, testARN))
+	assert.Equal(t, apiCalls{
+		"UPDATE RemoveTagsFromResource false",
+		"UPDATE AddTagsToResource false",
+	}, *calls)
+	removeInput := api.CallsTo("RemoveTagsFromResource")[0].(*svcsdk.RemoveTagsFromResourceInput)
+	assert.Equal(t, testARN, *removeInput.ResourceName)
+	assert.Equal(t, []string{"remove"}, removeInput.TagKeys)
+
+	// Nothing is called once the tags are in sync.
+	latest, err = r.Get(context.Background(), api, &apiCalls{}, testARN)
+	require.NoError(t, err)
+	*calls = nil
+	require.NoError(t, r.Sync(context.Background(), api, calls, testARN, []*svcapitypes.Tag{
+		tag("keep", "1"), tag("change", "new"), tag("add", "1"),
+	}, latest))
+	assert.Empty(t, *calls)
+}
+
+func TestTagReconciler_SyncBatches(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{BatchSize: 2})
+	api := fake.New()
+	var latest, desired []*svcapitypes.Tag
+	for i := 0; i < 5; i++ {
+		key := fmt.Sprintf("old-%d", i)
+		api.Tags[testARN] = append(api.Tags[testARN], svcsdktypes.Tag{Key: aws.String(key), Value: aws.String("v")})
+		latest = append(latest, tag(key, "v"))
+		desired = append(desired, tag(fmt.Sprintf("new-%d", i), "v"))
+	}
+
+	require.NoError(t, r.Sync(context.Background(), api, &apiCalls{}, testARN, desired, latest))
+	removes := api.CallsTo("RemoveTagsFromResource")
+	adds := api.CallsTo("AddTagsToResource")
+	require.Len(t, removes, 3)
+	require.Len(t, adds, 3)
+	for i, want := range []int{2, 2, 1} {
+		assert.Len(t, removes[i].(*svcsdk.RemoveTagsFromResourceInput).TagKeys, want)
+		assert.Len(t, adds[i].(*svcsdk.AddTagsToResourceInput).Tags, want)
+	}
+	keys := []string{}
+	for key := range tagMap(api, testARN) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	assert.Equal(t, []string{"new-0", "new-1", "new-2", "new-3", "new-4"}, keys)
+}
+
+func TestTagReconciler_SyncStopsOnError(t *testing.T) {
+	api := fake.New()
+	api.Errors["RemoveTagsFromResource"] = fake.NewAPIError("InvalidParameterValue", "bad key")
+	calls := &apiCalls{}
+
+	err := util.NewDefaultTagReconciler().Sync(
+		context.Background(), api, calls, testARN,
+		[]*svcapitypes.Tag{tag("add", "1")},
+		[]*svcapitypes.Tag{tag("remove", "1")},
+	)
+	assert.Error(t, err)
+	assert.Equal(t, apiCalls{"UPDATE RemoveTagsFromResource true"}, *calls)
+	assert.Empty(t, api.CallsTo("AddTagsToResource"))
+}
+
+func TestTagReconciler_ForAnnotations(t *testing.T) {
+	r := newTagReconciler(t, util.TagReconcilerOptions{IgnoredTagKeys: []string{"awsbackup:*"}})
+	assert.Same(t, r, r.ForAnnotations(nil))
+
+	annotated := r.ForAnnotations(map[string]string{
+		svcapitypes.IgnoredTagKeysAnnotation: "finops/*, team",
+	})
+	assert.True(t, annotated.Ignored("awsbackup:backup-plan"))
+	assert.True(t, annotated.Ignored("finops/cost-center"))
+	assert.True(t, annotated.Ignored("team"))
+	// The annotation doesn't change the shared reconciler.
+	assert.False(t, r.Ignored("finops/cost-center"))
+
+	api := fake.New()
+	api.Tags[testARN] = []svcsdktypes.Tag{
+		{Key: aws.String("finops/cost-center"), Value: aws.String("42")},
+		{Key: aws.String("awsbackup:backup-plan"), Value: aws.String("daily")},
+		{Key: aws.String("stale"), Value: aws.String("1")},
+	}
+	latest, err := annotated.Get(context.Background(), api, &apiCalls{}, testARN)
+	require.NoError(t, err)
+	require.NoError(t, annotated.Sync(context.Background(), api, &apiCalls{}, testARN, nil, latest))
+	assert.Equal(t, map[string]string{
+		"finops/cost-center":    "42",
+		"awsbackup:backup-plan": "daily",
+	}, tagMap(api, testARN))
+}
@@ -0,0 +1,3 @@
+	compareSource(delta, a, b)
+	compareSwitchover(delta, a, b)
+	compareDeleteSourceAfterSwitchover(delta, a, b)
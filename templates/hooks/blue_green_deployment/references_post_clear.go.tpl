@@ -0,0 +1,3 @@
+	if ko.Spec.SourceDBClusterRef != nil || ko.Spec.SourceDBInstanceRef != nil {
+		ko.Spec.Source = nil
+	}
@@ -0,0 +1,7 @@
+	rm.setARN(ko)
+	if !blueGreenDeploymentSettled(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		msg := blueGreenDeploymentStatusMessage(&resource{ko})
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+	}
@@ -0,0 +1 @@
+	setDeleteBlueGreenDeploymentInput(r, input)
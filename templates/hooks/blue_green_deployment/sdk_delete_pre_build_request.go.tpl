@@ -0,0 +1,3 @@
+	if blueGreenDeploymentDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
@@ -1,5 +1,3 @@
-    compareTags(delta, a, b)
-
     // Handle special case for StorageType field for Aurora engines
     // When StorageType is set to "aurora" (default), the API doesn't return it
     if isAuroraEngine(b.ko.Spec.Engine) && (a.ko.Spec.StorageType != nil && *a.ko.Spec.StorageType == "aurora" && b.ko.Spec.StorageType == nil) {
@@ -29,3 +27,7 @@
     }
 
     compareSecretReferenceChanges(delta, a, b)
+    comparePendingReboot(delta, a, b)
+    compareActionGenerations(delta, a, b)
+    compareActivationState(delta, a, b)
+    compareRoleAssociations(delta, a, b)
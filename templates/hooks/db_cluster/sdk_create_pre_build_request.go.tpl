@@ -1,3 +1,4 @@
+    initActionGenerations(desired)
     // if request has SnapshotIdentifier spec, create request will call RestoreDBClusterFromSnapshotWithContext
     // instead of normal create api
     if desired.ko.Spec.SnapshotIdentifier != nil {
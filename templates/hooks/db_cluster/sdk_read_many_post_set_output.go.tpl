@@ -1,18 +1,15 @@
-	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-        resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-        tags, err := rm.getTags(ctx, *resourceARN)
-        if err != nil {
-            return nil, err
-        }
-        ko.Spec.Tags = tags
-	}
-	if !clusterAvailable(&resource{ko}) {
+	if !clusterAvailable(&resource{ko}) && !stoppedAsDesired(r, &resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
+	setPendingRebootCondition(&resource{ko})
+	if err := rm.syncMasterUserSecret(ctx, ko); err != nil {
+		return nil, err
+	}
+	rm.syncConnectionDetails(ctx, ko)
 	clearAuroraAllocatedStorage(ko)
 	if len(r.ko.Spec.VPCSecurityGroupIDs) > 0 {
 		// If the desired resource has security groups specified then update the spec of the latest resource with the
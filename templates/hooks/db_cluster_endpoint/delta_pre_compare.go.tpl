@@ -1 +0,0 @@
-    compareTags(delta, a, b)
@@ -0,0 +1,6 @@
+	if len(ko.Spec.ExcludedMemberSelector) > 0 {
+		ko.Spec.ExcludedMembers = nil
+	}
+	if len(ko.Spec.StaticMemberSelector) > 0 {
+		ko.Spec.StaticMembers = nil
+	}
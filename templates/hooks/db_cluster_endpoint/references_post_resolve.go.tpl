@@ -0,0 +1,5 @@
+	if fieldHasReferences, err := rm.resolveMemberSelectors(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
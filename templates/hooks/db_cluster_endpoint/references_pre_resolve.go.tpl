@@ -0,0 +1,3 @@
+	if err := validateMemberSelectors(ko); err != nil {
+		return &resource{ko}, true, err
+	}
@@ -1,3 +1,3 @@
-	if err = rm.syncParameters(ctx, desired, nil); err != nil {
+	if _, err = rm.syncParameters(ctx, desired, nil); err != nil {
 		return nil, err
 	}
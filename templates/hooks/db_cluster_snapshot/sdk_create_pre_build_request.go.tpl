@@ -0,0 +1,8 @@
+    if err := validateCopySource(desired); err != nil {
+        return nil, err
+    }
+    // if request has SourceDBClusterSnapshotARN spec, create request will call CopyDBClusterSnapshot
+    // instead of normal create api
+    if desired.ko.Spec.SourceDBClusterSnapshotARN != nil {
+        return rm.copyDBClusterSnapshot(ctx, desired)
+    }
@@ -1,13 +1,17 @@
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
+		sharedAccounts, err := rm.getSharedAccounts(ctx, ko.Spec.DBClusterSnapshotIdentifier)
 		if err != nil {
 			return nil, err
 		}
-		ko.Spec.Tags = tags
+		ko.Spec.SharedAccounts = sharedAccounts
+	}
+	if ko.Spec.SourceDBClusterSnapshotARN != nil {
+		// RDS returns the DB cluster of the source snapshot of a copy, which
+		// isn't the source of this resource.
+		ko.Spec.DBClusterIdentifier = r.ko.Spec.DBClusterIdentifier
 	}
 	if !clusterSnapshotAvailable(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
-		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, clusterSnapshotProgressMessage(&resource{ko}), nil)
 	}
@@ -6,5 +6,5 @@
 	if !clusterSnapshotAvailable(latest) {
 		msg := "DB cluster snapshot cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
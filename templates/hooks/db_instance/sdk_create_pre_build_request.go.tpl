@@ -1,8 +1,17 @@
+    initActionGenerations(desired)
+    if err := validateRestoreSource(desired); err != nil {
+        return nil, err
+    }
     // if request has DBSnapshotIdentifier spec, create request will call RestoreDBInstanceFromDBSnapshotWithContext
     // instead of normal create api
     if desired.ko.Spec.DBSnapshotIdentifier != nil {
         return rm.restoreDbInstanceFromDbSnapshot(ctx, desired)
     }
+    // if request has RestoreTime or UseLatestRestorableTime spec, create request will call RestoreDBInstanceToPointInTime
+    // instead of normal create api
+    if restoringToPointInTime(desired) {
+        return rm.restoreDbInstanceToPointInTime(ctx, desired)
+    }
     // if request has SourceDBInstanceIdentifier spec, create request will call CreateDBInstanceReadReplicaWithContext
     // instead of normal create api
     if desired.ko.Spec.SourceDBInstanceIdentifier != nil {
@@ -2,7 +2,7 @@
 {{ $SDKAPI := .SDKAPI }}
 
 {{/* Maintain operations here */}}
-{{ range $operationName := Each "RestoreDBInstanceFromDBSnapshot" "CreateDBInstanceReadReplica" }}
+{{ range $operationName := Each "RestoreDBInstanceFromDBSnapshot" "RestoreDBInstanceToPointInTime" "CreateDBInstanceReadReplica" }}
 
 {{- $operation := (index $SDKAPI.API.Operations $operationName)}}
 
@@ -14,7 +14,7 @@
 
 
 {{/* Some operations have custom structure */}}
-{{- if (eq $operationName "RestoreDBInstanceFromDBSnapshot") }}
+{{- if (or (eq $operationName "RestoreDBInstanceFromDBSnapshot") (eq $operationName "RestoreDBInstanceToPointInTime")) }}
 
 // new{{ $inputShapeName }} returns a {{ $inputShapeName }} object 
 // with each the field set by the corresponding configuration's fields.
@@ -9,7 +9,7 @@
 	if instanceCreating(latest) {
 		msg := "DB instance is currently being created"
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
-		return &resource{res}, requeueWaitUntilCanModify(latest)
+		return &resource{res}, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if instanceHasTerminalStatus(latest) {
 		msg := "DB instance is in '"+*latest.ko.Status.DBInstanceStatus+"' status"
@@ -17,13 +17,86 @@
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionTrue, nil, nil)
 		return &resource{res}, nil
 	}
-	if !instanceAvailable(latest) && !needStorageUpdate(latest, delta) {
+	if delta.DifferentAt(activationStateDeltaPath) && desired.ko.Spec.DBClusterIdentifier != nil {
+		return nil, errStopClusterMember
+	}
+	if instanceStopped(latest) {
+		if delta.DifferentAt(activationStateDeltaPath) {
+			if err = rm.startDBInstance(ctx, latest); err != nil {
+				return nil, err
+			}
+			msg := "DB instance is being started"
+			ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+			return &resource{res}, nil
+		}
+		if delta.DifferentAt("Spec.Tags") {
+			if err = rm.syncTags(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		if !delta.DifferentExcept("Spec.Tags") {
+			return &resource{res}, nil
+		}
+		msg := msgCannotModifyWhileStopped
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, requeueWaitWhileStopped
+	}
+	// A storage-full DB instance can have its storage modified, but a
+	// requested reboot waits for it to be available again.
+	if !instanceAvailable(latest) &&
+		(!needStorageUpdate(latest, delta) || delta.DifferentAt(restartGenerationDeltaPath)) {
 		msg := "DB instance cannot be modifed while in '" + *latest.ko.Status.DBInstanceStatus + "' status"
 		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
-		return &resource{res}, requeueWaitUntilCanModify(latest)
+		return &resource{res}, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
 		}
 	}
+	if delta.DifferentAt(roleAssociationsDeltaPath) {
+		// Roles are associated and disassociated with their own API calls,
+		// so there is nothing left to modify when only they and the tags
+		// differ.
+		if err = rm.syncRoleAssociations(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+		if !delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath) {
+			return &resource{res}, nil
+		}
+	}
+	if delta.DifferentAt(activationStateDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, activationStateDeltaPath, pendingRebootDeltaPath) {
+		// A stopped DB instance can't be modified, so it is only stopped
+		// once there is nothing else to change. Parameter changes pending a
+		// reboot take effect when it is started again.
+		if err = rm.stopDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		msg := "DB instance is being stopped"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
+	if delta.DifferentAt(restartGenerationDeltaPath) {
+		// A requested reboot runs on its own, ahead of any other change, so
+		// that each restart generation results in one RebootDBInstance call.
+		if err = rm.rebootDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		res.Status.LastRestartGeneration = desired.ko.Spec.RestartGeneration
+		msg := restartingMessage(desired.ko.Spec.RestartGeneration)
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
+	if delta.DifferentAt(pendingRebootDeltaPath) &&
+		!delta.DifferentExcept("Spec.Tags", roleAssociationsDeltaPath, pendingRebootDeltaPath) {
+		// Only reboot once there is nothing else to modify. Otherwise the
+		// ModifyDBInstance call below would fail because the instance is
+		// rebooting, and the changes it makes may need a reboot themselves.
+		if err = rm.rebootDBInstance(ctx, latest); err != nil {
+			return nil, err
+		}
+		msg := "DB instance is rebooting to apply pending parameter group changes"
+		ackcondition.SetSynced(&resource{res}, corev1.ConditionFalse, &msg, nil)
+		return &resource{res}, nil
+	}
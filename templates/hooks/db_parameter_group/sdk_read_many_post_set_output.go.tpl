@@ -17,5 +17,5 @@
 			return nil, err
 		}
 		ko.Spec.ParameterOverrides = params
-		ko.Status.ParameterOverrideStatuses = paramStatuses
+		setParameterOverrideStatuses(ko, paramStatuses)
 	}
@@ -2,4 +2,14 @@
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else {
+		if err := rm.setTargetGroupFields(ctx, ko); err != nil {
+			return nil, err
+		}
+		// RDS checks the connection to new targets asynchronously. Keep
+		// requeueing until it's done so that the target health is current.
+		if id := registeringTarget(ko.Status.Targets); id != nil {
+			msg := "DB proxy target '" + *id + "' is being registered"
+			ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
+		}
 	}
\ No newline at end of file
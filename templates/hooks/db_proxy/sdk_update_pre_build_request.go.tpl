@@ -6,7 +6,7 @@
 	if proxyCreating(latest) {
 		msg := "DB proxy is currently being created"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
 	}
 	if proxyHasTerminalStatus(latest) {
 		msg := "DB proxy is in '"+*latest.ko.Status.Status+"' status"
@@ -17,5 +17,15 @@
 	if !proxyAvailable(latest) {
 		msg := "DB proxy cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if targetGroupChanged(delta) {
+		if err = rm.syncTargetGroup(ctx, desired, latest, delta); err != nil {
+			return nil, err
+		}
+		if onlyTargetGroupChanged(delta) {
+			// Requeue to read the registered targets and their health.
+			ackcondition.SetSynced(desired, corev1.ConditionFalse, nil, nil)
+			return desired, nil
+		}
 	}
\ No newline at end of file
@@ -0,0 +1,8 @@
+	// RDS provisions the DNS name of the endpoint asynchronously, so the
+	// endpoint stays in 'creating' status for a few minutes.
+	if endpointCreating(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
@@ -0,0 +1,7 @@
+	// ModifyDBProxyEndpoint puts the endpoint in 'modifying' status. Requeue
+	// to find the current status and set the Synced condition accordingly.
+	if !endpointAvailable(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
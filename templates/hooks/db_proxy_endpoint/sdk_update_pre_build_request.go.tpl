@@ -0,0 +1,27 @@
+	if endpointDeleting(latest) {
+		msg := "DB proxy endpoint is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if endpointHasTerminalStatus(latest) {
+		msg := "DB proxy endpoint is in '" + *latest.ko.Status.Status + "' status"
+		ackcondition.SetTerminal(desired, corev1.ConditionTrue, &msg, nil)
+		ackcondition.SetSynced(desired, corev1.ConditionTrue, nil, nil)
+		return desired, nil
+	}
+	if !endpointAvailable(latest) {
+		msg := "DB proxy endpoint cannot be modified while in '" + *latest.ko.Status.Status + "' status"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if err = immutableFieldChanged(delta); err != nil {
+		return nil, err
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !delta.DifferentAt("Spec.VPCSecurityGroupIDs") {
+		return desired, nil
+	}
@@ -0,0 +1 @@
+	compareSharedAccounts(delta, a, b)
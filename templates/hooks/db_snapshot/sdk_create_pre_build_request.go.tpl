@@ -0,0 +1,8 @@
+    if err := validateCopySource(desired); err != nil {
+        return nil, err
+    }
+    // if request has SourceDBSnapshotARN spec, create request will call CopyDBSnapshot
+    // instead of normal create api
+    if desired.ko.Spec.SourceDBSnapshotARN != nil {
+        return rm.copyDBSnapshot(ctx, desired)
+    }
@@ -1,13 +1,17 @@
 	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
-		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
-		tags, err := rm.getTags(ctx, *resourceARN)
+		sharedAccounts, err := rm.getSharedAccounts(ctx, ko.Spec.DBSnapshotIdentifier)
 		if err != nil {
 			return nil, err
 		}
-		ko.Spec.Tags = tags
+		ko.Spec.SharedAccounts = sharedAccounts
+	}
+	if ko.Spec.SourceDBSnapshotARN != nil {
+		// RDS returns the DB instance of the source snapshot of a copy, which
+		// isn't the source of this resource.
+		ko.Spec.DBInstanceIdentifier = r.ko.Spec.DBInstanceIdentifier
 	}
 	if !snapshotAvailable(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
-		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, snapshotProgressMessage(&resource{ko}), nil)
 	}
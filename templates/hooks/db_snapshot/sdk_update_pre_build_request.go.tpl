@@ -1,3 +1,6 @@
+	if err = immutableFieldChanged(delta); err != nil {
+		return nil, err
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return nil, err
@@ -6,5 +9,16 @@
 	if !snapshotAvailable(latest) {
 		msg := "DB instance cannot be modifed while in '" + *latest.ko.Status.Status + "' status"
 		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
-		return desired, requeueWaitUntilCanModify(latest)
+		return desired, rm.requeueWaitUntilCanModify(ctx, latest)
+	}
+	if delta.DifferentAt("Spec.SharedAccounts") {
+		if err = rm.syncSharedAccounts(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	// ModifyDBSnapshot only upgrades the engine version or option group of a
+	// DB snapshot, so there's nothing left to do if only the tags or the
+	// shared accounts changed.
+	if !delta.DifferentExcept("Spec.Tags", "Spec.SharedAccounts") {
+		return desired, nil
 	}
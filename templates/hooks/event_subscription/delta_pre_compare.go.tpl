@@ -0,0 +1,2 @@
+	compareStringSets(delta, "Spec.EventCategories", a.ko.Spec.EventCategories, b.ko.Spec.EventCategories)
+	compareStringSets(delta, "Spec.SourceIDs", a.ko.Spec.SourceIDs, b.ko.Spec.SourceIDs)
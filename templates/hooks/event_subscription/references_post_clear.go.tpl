@@ -0,0 +1,3 @@
+	if len(ko.Spec.SourceRefs) > 0 {
+		ko.Spec.SourceIDs = nil
+	}
@@ -0,0 +1,8 @@
+	// RDS validates the SNS topic asynchronously, so the subscription stays
+	// in 'creating' status for a little while.
+	if subscriptionCreating(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+		return &resource{ko}, nil
+	}
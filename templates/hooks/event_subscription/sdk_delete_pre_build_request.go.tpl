@@ -0,0 +1,3 @@
+	if subscriptionDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
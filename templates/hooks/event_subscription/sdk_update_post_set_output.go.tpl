@@ -0,0 +1,11 @@
+	// The new source identifiers are added on a later reconciliation, once
+	// the subscription is active again. Keep the desired ones in the spec.
+	ko.Spec.SourceIDs = desired.ko.Spec.SourceIDs
+	// ModifyEventSubscription puts the subscription in 'modifying' status.
+	// Requeue to find the current status and set the Synced condition
+	// accordingly.
+	if !subscriptionActive(&resource{ko}) {
+		// Setting resource synced condition to false will trigger a requeue of
+		// the resource. No need to return a requeue error here.
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	}
@@ -0,0 +1,32 @@
+	if subscriptionDeleting(latest) {
+		msg := "event subscription is currently being deleted"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitWhileDeleting
+	}
+	if subscriptionBusy(latest) {
+		msg := "event subscription cannot be modified while in '" + *latest.ko.Status.Status + "' status"
+		ackcondition.SetSynced(desired, corev1.ConditionFalse, &msg, nil)
+		return desired, requeueWaitUntilCanModify(latest)
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err = rm.syncTags(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	// ModifyEventSubscription can't change the source identifiers of the
+	// subscription. The ones that are no longer desired are removed before
+	// the subscription is modified, since they may be of the previous source
+	// type, and the new ones are added once the subscription is active.
+	if delta.DifferentAt("Spec.SourceIDs") {
+		if err = rm.removeSourceIDs(ctx, desired, latest); err != nil {
+			return nil, err
+		}
+	}
+	if !subscriptionAttributesChanged(delta) {
+		if delta.DifferentAt("Spec.SourceIDs") {
+			if err = rm.addSourceIDs(ctx, desired, latest); err != nil {
+				return nil, err
+			}
+		}
+		return desired, nil
+	}
@@ -0,0 +1,2 @@
+	comparePrimaryDBCluster(delta, a, b)
+	compareRemovedMembers(delta, a, b)
@@ -1,10 +1,21 @@
 	updatedko := desired.ko.DeepCopy()
 	updatedko.Status = latest.ko.Status
+	if failoverInProgress(latest) {
+		msg := failoverProgressMessage(latest)
+		ackcondition.SetSynced(&resource{updatedko}, corev1.ConditionFalse, &msg, nil)
+		return &resource{updatedko}, requeueWaitWhileChangingPrimary
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		if err = rm.syncTags(ctx, desired, latest); err != nil {
 			return &resource{updatedko}, err
 		}
 	}
+	if delta.DifferentAt(primaryDBClusterDeltaPath) || delta.DifferentAt(removedMembersDeltaPath) {
+		// Membership changes run on their own. Any other modification is
+		// picked up by a later reconciliation, once the global cluster is
+		// available again.
+		return rm.updateMembership(ctx, &resource{updatedko}, latest, delta)
+	}
 	if !delta.DifferentExcept("Spec.Tags") {
 		return &resource{updatedko}, nil
-	}
\ No newline at end of file
+	}
@@ -0,0 +1,3 @@
+	if err = rm.syncOptions(ctx, desired, nil); err != nil {
+		return nil, err
+	}
@@ -0,0 +1,13 @@
+	if ko.Status.ACKResourceMetadata != nil && ko.Status.ACKResourceMetadata.ARN != nil {
+		resourceARN := (*string)(ko.Status.ACKResourceMetadata.ARN)
+		tags, err := rm.getTags(ctx, *resourceARN)
+		if err != nil {
+			return nil, err
+		}
+		ko.Spec.Tags = tags
+	}
+	// The generated code above only reads the first option group in the
+	// response, which is the one named in the request.
+	ko.Spec.Options = observedOptions(
+		resp.OptionGroupsList[0].Options, ko.Spec.Options,
+	)
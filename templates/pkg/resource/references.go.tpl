@@ -0,0 +1,139 @@
+{{ template "boilerplate" }}
+
+package {{ .CRD.Names.Snake }}
+
+{{- /*
+Overrides the code-generator's references.go template to add hook points for
+references the references config can't express, like a reference whose kind
+depends on another field or a label selector. The hook code resolves and
+clears them with functions from non-generated files of the resource package:
+
+* references_post_clear runs in ClearResolvedReferences, with the copy of the
+  resource's ko in scope.
+* references_pre_resolve runs in ResolveReferences after the reference fields
+  are validated and before any reference is resolved, with ctx, apiReader,
+  ko and resourceHasReferences in scope.
+* references_post_resolve runs in ResolveReferences after the generated
+  references are resolved, with ctx, apiReader, ko and resourceHasReferences
+  in scope.
+
+Keep the rest of this file in step with the upstream template when upgrading
+the code-generator.
+*/}}
+{{- $postClearHook := Hook .CRD "references_post_clear" }}
+{{- $preResolveHook := Hook .CRD "references_pre_resolve" }}
+{{- $postResolveHook := Hook .CRD "references_post_resolve" }}
+
+import (
+	"context"
+{{- if .CRD.HasReferenceFields }}
+	"fmt"
+{{- end }}
+
+{{- if .CRD.HasReferenceFields }}
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+{{- end }}
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+{{ range $referencedServiceName := .CRD.ReferencedServiceNames -}}
+{{- if not (eq $referencedServiceName $.ServicePackageName) -}}
+	{{ $referencedServiceName }}apitypes "github.com/aws-controllers-k8s/{{ $referencedServiceName }}-controller/apis/{{ $.APIVersion }}"
+{{ end -}}
+{{- end -}}
+{{- if .CRD.HasReferenceFields }}
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+{{- end }}
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/{{ .ServicePackageName }}-controller/apis/{{ .APIVersion }}"
+)
+
+{{- range $referencedResourceName := .CRD.GetReferencedResourceNames }}
+{{- $referencedResource := index $.CRD.ReferencedResources $referencedResourceName }}
+{{- if not (eq $referencedResource.ServiceName $.ServicePackageName) }}
+
+// +kubebuilder:rbac:groups={{ $referencedResource.APIGroup }},resources={{ ToLower $referencedResource.Plural }},verbs=get;list
+// +kubebuilder:rbac:groups={{ $referencedResource.APIGroup }},resources={{ ToLower $referencedResource.Plural }}/status,verbs=get;list
+{{- end }}
+{{- end }}
+
+// ClearResolvedReferences removes any reference values that were made
+// concrete in the spec. It returns a copy of the input AWSResource which
+// contains the original *Ref values, but none of their respective concrete
+// values.
+func (rm *resourceManager) ClearResolvedReferences(res acktypes.AWSResource) acktypes.AWSResource {
+	ko := rm.concreteResource(res).ko.DeepCopy()
+{{ range $fieldName, $field := .CRD.Fields }}
+{{- if $field.HasReference }}
+{{ GoCodeClearResolvedReferences $field "ko" 1 }}
+{{ end -}}
+{{- end }}
+{{- if $postClearHook }}
+{{ $postClearHook }}
+{{ end }}
+	return &resource{ko}
+}
+
+// ResolveReferences finds if there are any Reference field(s) present
+// inside AWSResource passed in the parameter and attempts to resolve those
+// reference field(s) into their respective target field(s). It returns a
+// copy of the input AWSResource with resolved reference(s), a boolean which
+// is set to true if the resource contains any references (regardless of if
+// they are resolved successfully) and an error if the passed AWSResource's
+// reference field(s) could not be resolved.
+func (rm *resourceManager) ResolveReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+{{- if or .CRD.HasReferenceFields $preResolveHook $postResolveHook }}
+	ko := rm.concreteResource(res).ko
+
+	resourceHasReferences := false
+	err := validateReferenceFields(ko)
+{{- if $preResolveHook }}
+{{ $preResolveHook }}
+{{- end }}
+{{- range $fieldName, $field := .CRD.Fields }}
+{{- if $field.HasReference }}
+	if fieldHasReferences, err := rm.resolveReferenceFor{{ $field.FieldPathWithUnderscore }}(ctx, apiReader, ko); err != nil {
+		return &resource{ko}, (resourceHasReferences || fieldHasReferences), err
+	} else {
+		resourceHasReferences = resourceHasReferences || fieldHasReferences
+	}
+{{ end -}}
+{{- end }}
+{{- if $postResolveHook }}
+{{ $postResolveHook }}
+{{ end }}
+	return &resource{ko}, resourceHasReferences, err
+{{- else }}
+	return res, false, nil
+{{- end }}
+}
+
+// validateReferenceFields validates the reference field and corresponding
+// identifier field.
+func validateReferenceFields(ko *svcapitypes.{{ .CRD.Names.Camel }}) error {
+{{ range $fieldName, $field := .CRD.Fields -}}
+{{ if $field.HasReference -}}
+{{ GoCodeReferenceFieldValidation $field "ko" 1 -}}
+{{ end -}}
+{{ end -}}
+	return nil
+}
+{{- range $fieldName, $field := .CRD.Fields }}
+{{- if $field.HasReference }}
+
+{{ GoCodeResolveReference $field "ko" 1 }}
+{{- $referencedResourceName := $field.FieldConfig.References.Resource }}
+{{- if not (index $.ReferencedResourcesSeen $referencedResourceName) }}
+
+{{ template "read_referenced_resource_and_validate" $field }}
+{{- end }}
+{{- end }}
+{{- end }}